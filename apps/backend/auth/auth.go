@@ -0,0 +1,50 @@
+// Package auth authenticates gRPC callers with bearer JWTs issued by an OIDC provider, or with
+// API tokens for service accounts and automation, and carries the resulting actor on the request
+// context.
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"sourcestream/backend/models"
+)
+
+// Identity is the verified subject of a bearer token.
+type Identity struct {
+	Issuer  string
+	Subject string
+	// CorporateID is taken from the configured identity claim.
+	CorporateID string
+	Email       string
+}
+
+// Actor is the authenticated caller of an RPC.
+type Actor struct {
+	Identity Identity
+	// User is the caller's users row. It is nil only for RPCs that unregistered callers may
+	// invoke, such as RegisterContributor.
+	User *models.User
+	// Token is the API token the caller authenticated with, or nil for an OIDC login. The caller
+	// is then limited to the token's scopes.
+	Token *models.APIToken
+}
+
+// HasScope reports whether the actor may use scope. Callers that did not authenticate with an
+// API token have every scope.
+func (a *Actor) HasScope(scope string) bool {
+	return a.Token == nil || slices.Contains(a.Token.Scopes, scope)
+}
+
+type actorKey struct{}
+
+// NewContext returns a copy of ctx carrying the actor.
+func NewContext(ctx context.Context, actor *Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// FromContext returns the actor carried by ctx, if any.
+func FromContext(ctx context.Context) (*Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(*Actor)
+	return actor, ok && actor != nil
+}
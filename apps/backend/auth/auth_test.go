@@ -0,0 +1,417 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"sourcestream/backend/models"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/repository"
+)
+
+const testIssuer = "https://idp.example.com"
+
+type signingKey struct {
+	kid string
+	key *rsa.PrivateKey
+}
+
+func newSigningKey(t *testing.T, kid string) signingKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return signingKey{kid: kid, key: key}
+}
+
+func writeJWKS(t *testing.T, path string, keys ...signingKey) {
+	t.Helper()
+
+	var set jose.JSONWebKeySet
+	for _, k := range keys {
+		set.Keys = append(set.Keys, jose.JSONWebKey{Key: &k.key.PublicKey, KeyID: k.kid, Algorithm: string(jose.RS256), Use: "sig"})
+	}
+
+	data, err := json.Marshal(set)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+func sign(t *testing.T, k signingKey, claims map[string]any) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: k.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", k.kid))
+	require.NoError(t, err)
+
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	require.NoError(t, err)
+
+	return token
+}
+
+func validClaims(now time.Time) map[string]any {
+	return map[string]any{
+		"iss":                testIssuer,
+		"sub":                "00u1",
+		"aud":                "sourcestream",
+		"exp":                now.Add(time.Hour).Unix(),
+		"iat":                now.Unix(),
+		"preferred_username": "jdoe",
+		"email":              "jdoe@example.com",
+	}
+}
+
+func newTestVerifier(t *testing.T, keys ...signingKey) (*Verifier, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, keys...)
+
+	keySet, err := NewKeySet("", path, time.Hour)
+	require.NoError(t, err)
+
+	return NewVerifier(keySet, testIssuer, "sourcestream", "preferred_username"), path
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	key := newSigningKey(t, "k1")
+	verifier, _ := newTestVerifier(t, key)
+	now := time.Now()
+
+	identity, err := verifier.Verify(context.Background(), sign(t, key, validClaims(now)))
+	require.NoError(t, err)
+	assert.Equal(t, "jdoe", identity.CorporateID)
+	assert.Equal(t, "00u1", identity.Subject)
+	assert.Equal(t, "jdoe@example.com", identity.Email)
+
+	tests := map[string]func(map[string]any){
+		"expired":        func(c map[string]any) { c["exp"] = now.Add(-time.Hour).Unix() },
+		"no expiry":      func(c map[string]any) { delete(c, "exp") },
+		"wrong issuer":   func(c map[string]any) { c["iss"] = "https://evil.example.com" },
+		"wrong audience": func(c map[string]any) { c["aud"] = "another-app" },
+		"no identity":    func(c map[string]any) { delete(c, "preferred_username") },
+	}
+
+	for name, mutate := range tests {
+		claims := validClaims(now)
+		mutate(claims)
+
+		_, err := verifier.Verify(context.Background(), sign(t, key, claims))
+		assert.ErrorIs(t, err, ErrInvalidToken, name)
+	}
+
+	_, err = verifier.Verify(context.Background(), sign(t, newSigningKey(t, "k1"), validClaims(now)))
+	assert.ErrorIs(t, err, ErrInvalidToken, "signed by an unknown key with a known kid")
+}
+
+func TestVerifier_PicksUpRotatedKeys(t *testing.T) {
+	oldKey := newSigningKey(t, "old")
+	verifier, path := newTestVerifier(t, oldKey)
+
+	clock := time.Now()
+	verifier.keys.now = func() time.Time { return clock }
+
+	_, err := verifier.Verify(context.Background(), sign(t, oldKey, validClaims(time.Now())))
+	require.NoError(t, err)
+
+	newKey := newSigningKey(t, "new")
+	writeJWKS(t, path, oldKey, newKey)
+
+	// Unknown key IDs reload the set once the minimum refresh interval has passed
+	clock = clock.Add(minRefreshInterval)
+
+	_, err = verifier.Verify(context.Background(), sign(t, newKey, validClaims(time.Now())))
+	assert.NoError(t, err)
+}
+
+// jwksServer serves a key set containing key, or fails while failing is set. It counts the
+// requests it receives and holds each until release is closed, when one is given.
+func jwksServer(t *testing.T, key signingKey, release chan struct{}) (*httptest.Server, *atomic.Bool, *atomic.Int32) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, key)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var (
+		failing atomic.Bool
+		hits    atomic.Int32
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+
+		if release != nil {
+			<-release
+		}
+
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &failing, &hits
+}
+
+func TestKeySet_RetriesFailedLoadAfterInterval(t *testing.T) {
+	srv, failing, hits := jwksServer(t, newSigningKey(t, "k1"), nil)
+	failing.Store(true)
+
+	keySet, err := NewKeySet(srv.URL, "", time.Hour)
+	require.NoError(t, err)
+
+	clock := time.Now()
+	keySet.now = func() time.Time { return clock }
+
+	_, err = keySet.Lookup(context.Background(), "k1")
+	assert.Error(t, err)
+
+	_, err = keySet.Lookup(context.Background(), "k1")
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), hits.Load(), "a failed load is not retried at once")
+
+	failing.Store(false)
+	clock = clock.Add(minRetryInterval)
+
+	keys, err := keySet.Lookup(context.Background(), "k1")
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+	assert.Equal(t, int32(2), hits.Load())
+}
+
+func TestKeySet_SharesReloadWithoutHoldingLock(t *testing.T) {
+	release := make(chan struct{})
+	srv, _, hits := jwksServer(t, newSigningKey(t, "k1"), release)
+
+	keySet, err := NewKeySet(srv.URL, "", time.Hour)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, 5)
+
+	for range 5 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := keySet.Lookup(context.Background(), "k1")
+			errs <- err
+		}()
+	}
+
+	require.Eventually(t, func() bool { return hits.Load() == 1 }, time.Second, time.Millisecond)
+
+	// A caller that gives up is not held up by the reload in flight
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = keySet.Lookup(ctx, "k1")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(1), hits.Load())
+}
+
+type fakeUsers map[string]*models.User
+
+func (f fakeUsers) GetUserByID(_ context.Context, id string) (*models.User, error) {
+	for _, user := range f {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+
+	return nil, repository.ErrUserNotFound
+}
+
+func (f fakeUsers) GetUserByCorporateID(_ context.Context, corporateID string) (*models.User, error) {
+	if user, ok := f[corporateID]; ok {
+		return user, nil
+	}
+
+	return nil, repository.ErrUserNotFound
+}
+
+func TestAuthenticator_UnaryInterceptor(t *testing.T) {
+	key := newSigningKey(t, "k1")
+	verifier, _ := newTestVerifier(t, key)
+
+	users := fakeUsers{"jdoe": {ID: "user-1", CorporateID: "jdoe", IsActive: true}}
+	interceptor := newAuthenticator(verifier, users, fakeTokens{}).UnaryInterceptor()
+
+	call := func(token, method string) (*Actor, error) {
+		return callInterceptor(interceptor, token, method)
+	}
+
+	token := sign(t, key, validClaims(time.Now()))
+
+	actor, err := call(token, pb.RequestService_GetRequests_FullMethodName)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", actor.User.ID)
+
+	_, err = call("", pb.RequestService_GetRequests_FullMethodName)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = call("not-a-jwt", pb.RequestService_GetRequests_FullMethodName)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	users["jdoe"].IsActive = false
+	_, err = call(token, pb.RequestService_GetRequests_FullMethodName)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	delete(users, "jdoe")
+	_, err = call(token, pb.RequestService_GetRequests_FullMethodName)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Unregistered callers may still register themselves
+	actor, err = call(token, pb.UserService_RegisterContributor_FullMethodName)
+	require.NoError(t, err)
+	assert.Nil(t, actor.User)
+	assert.Equal(t, "jdoe", actor.Identity.CorporateID)
+
+	// Health checks need no credentials
+	actor, err = call("", healthpb.Health_Check_FullMethodName)
+	require.NoError(t, err)
+	assert.Nil(t, actor)
+}
+
+type fakeTokens map[string]*models.APIToken // hash -> token
+
+func (f fakeTokens) GetAPITokenByHash(_ context.Context, hash string) (*models.APIToken, error) {
+	if token, ok := f[hash]; ok {
+		return token, nil
+	}
+
+	return nil, repository.ErrAPITokenNotFound
+}
+
+func (f fakeTokens) TouchAPIToken(_ context.Context, id string) error {
+	for _, token := range f {
+		if token.ID == id {
+			now := time.Now()
+			token.LastUsedAt = &now
+		}
+	}
+
+	return nil
+}
+
+func callInterceptor(interceptor grpc.UnaryServerInterceptor, token, method string) (*Actor, error) {
+	ctx := context.Background()
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	var actor *Actor
+
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ any) (any, error) {
+		actor, _ = FromContext(ctx)
+		return nil, nil
+	})
+
+	return actor, err
+}
+
+func TestAuthenticator_APITokens(t *testing.T) {
+	key := newSigningKey(t, "k1")
+	verifier, _ := newTestVerifier(t, key)
+
+	bot := &models.User{ID: "bot-1", CorporateID: "svc-ci", IsActive: true, IsServiceAccount: true}
+	users := fakeUsers{"svc-ci": bot}
+
+	secret, prefix, err := GenerateAPIToken()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, APITokenPrefix))
+	assert.True(t, strings.HasPrefix(secret, prefix))
+
+	token := &models.APIToken{
+		ID: "token-1", UserID: "bot-1", Scopes: []string{ScopeRequestsWrite},
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	tokens := fakeTokens{HashAPIToken(secret): token}
+	interceptor := newAuthenticator(verifier, users, tokens).UnaryInterceptor()
+	method := pb.RequestService_SubmitPullRequestApproval_FullMethodName
+
+	actor, err := callInterceptor(interceptor, secret, method)
+	require.NoError(t, err)
+	assert.Equal(t, "bot-1", actor.User.ID)
+	assert.Equal(t, "svc-ci", actor.Identity.CorporateID)
+	assert.True(t, actor.HasScope(ScopeRequestsWrite))
+	assert.False(t, actor.HasScope(ScopeCatalogRead))
+	assert.NotNil(t, token.LastUsedAt)
+
+	_, err = callInterceptor(interceptor, APITokenPrefix+"unknown", method)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	bot.IsActive = false
+	_, err = callInterceptor(interceptor, secret, method)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	bot.IsActive = true
+
+	token.ExpiresAt = time.Now().Add(-time.Minute)
+	_, err = callInterceptor(interceptor, secret, method)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	revoked := time.Now()
+	token.ExpiresAt = time.Now().Add(time.Hour)
+	token.RevokedAt = &revoked
+	_, err = callInterceptor(interceptor, secret, method)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Service accounts cannot sign in with a JWT for their corporate ID
+	claims := validClaims(time.Now())
+	claims["preferred_username"] = "svc-ci"
+	_, err = callInterceptor(interceptor, sign(t, key, claims), method)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestDisabledInterceptor(t *testing.T) {
+	interceptor := DisabledInterceptor()
+
+	_, err := callInterceptor(interceptor, "", pb.UserService_CreateAPIToken_FullMethodName)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = callInterceptor(interceptor, APITokenPrefix+"secret", pb.RequestService_SubmitPullRequestApproval_FullMethodName)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	actor, err := callInterceptor(interceptor, "", pb.RequestService_SubmitPullRequestApproval_FullMethodName)
+	require.NoError(t, err)
+	assert.Nil(t, actor)
+}
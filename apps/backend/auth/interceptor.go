@@ -0,0 +1,246 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"sourcestream/backend/config"
+	"sourcestream/backend/models"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/repository"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UserLookup maps verified identities to users.
+type UserLookup interface {
+	GetUserByID(ctx context.Context, id string) (*models.User, error)
+	GetUserByCorporateID(ctx context.Context, corporateID string) (*models.User, error)
+}
+
+// TokenLookup finds API tokens by the hash of their secret and records their use.
+type TokenLookup interface {
+	GetAPITokenByHash(ctx context.Context, hash string) (*models.APIToken, error)
+	TouchAPIToken(ctx context.Context, id string) error
+}
+
+// unregisteredMethods may be called by authenticated callers that have no users row yet.
+var unregisteredMethods = map[string]bool{
+	pb.UserService_RegisterContributor_FullMethodName: true,
+}
+
+// publicMethods may be called without credentials, so that probes and load balancers can check
+// the server's health.
+var publicMethods = map[string]bool{
+	healthpb.Health_Check_FullMethodName: true,
+	healthpb.Health_List_FullMethodName:  true,
+	healthpb.Health_Watch_FullMethodName: true,
+}
+
+// credentialMethods manage service accounts and API tokens, which only authentication honours.
+var credentialMethods = map[string]bool{
+	pb.UserService_CreateServiceAccount_FullMethodName: true,
+	pb.UserService_CreateAPIToken_FullMethodName:       true,
+	pb.UserService_ListAPITokens_FullMethodName:        true,
+	pb.UserService_RevokeAPIToken_FullMethodName:       true,
+}
+
+// DisabledInterceptor returns the unary server interceptor installed when authentication is
+// disabled. Every caller would be trusted, so it refuses the methods that manage service accounts
+// and API tokens, and calls that present an API token, whose scopes could not be enforced.
+func DisabledInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if credentialMethods[info.FullMethod] {
+			return nil, status.Error(codes.FailedPrecondition,
+				"authentication is disabled; set AUTH_ISSUER to manage service accounts and API tokens")
+		}
+
+		if raw, err := bearerToken(ctx); err == nil && strings.HasPrefix(raw, APITokenPrefix) {
+			return nil, status.Error(codes.Unauthenticated, "API tokens are not accepted while authentication is disabled")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// IsPublicMethod reports whether method may be called without credentials. Calls to public
+// methods carry no Actor.
+func IsPublicMethod(method string) bool {
+	return publicMethods[method]
+}
+
+// Authenticator provides gRPC interceptors that require a valid bearer token on every call
+// and put the caller's Actor on the context. Bearer tokens are either JWTs from the OIDC provider
+// or API tokens, which start with APITokenPrefix.
+type Authenticator struct {
+	verifier *Verifier
+	users    UserLookup
+	tokens   TokenLookup
+	now      func() time.Time
+}
+
+// NewAuthenticator creates an Authenticator from the auth configuration.
+func NewAuthenticator(cfg *config.AuthConfig, users UserLookup, tokens TokenLookup) (*Authenticator, error) {
+	keys, err := NewKeySet(cfg.JWKSURL, cfg.JWKSFile, cfg.JWKSRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAuthenticator(NewVerifier(keys, cfg.Issuer, cfg.Audience, cfg.IdentityClaim), users, tokens), nil
+}
+
+func newAuthenticator(verifier *Verifier, users UserLookup, tokens TokenLookup) *Authenticator {
+	return &Authenticator{verifier: verifier, users: users, tokens: tokens, now: time.Now}
+}
+
+// UnaryInterceptor returns the unary server interceptor.
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns the stream server interceptor.
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate verifies the call's bearer token and resolves the caller's user.
+func (a *Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if IsPublicMethod(method) {
+		return ctx, nil
+	}
+
+	raw, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(raw, APITokenPrefix) {
+		actor, err := a.authenticateAPIToken(ctx, raw)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewContext(ctx, actor), nil
+	}
+
+	identity, err := a.verifier.Verify(ctx, raw)
+	if errors.Is(err, ErrInvalidToken) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err != nil {
+		log.Printf("auth: failed to verify token: %v", err)
+		return nil, status.Error(codes.Unavailable, "unable to verify credentials")
+	}
+
+	actor := &Actor{Identity: *identity}
+
+	user, err := a.users.GetUserByCorporateID(ctx, identity.CorporateID)
+
+	switch {
+	case errors.Is(err, repository.ErrUserNotFound):
+		if !unregisteredMethods[method] {
+			return nil, status.Error(codes.PermissionDenied, "caller is not a registered contributor")
+		}
+	case err != nil:
+		log.Printf("auth: failed to look up user %s: %v", identity.CorporateID, err)
+		return nil, status.Error(codes.Internal, "failed to look up caller")
+	case !user.IsActive:
+		return nil, status.Error(codes.PermissionDenied, "caller account is deactivated")
+	case user.IsServiceAccount:
+		return nil, status.Error(codes.PermissionDenied, "service accounts must authenticate with an API token")
+	default:
+		actor.User = user
+	}
+
+	return NewContext(ctx, actor), nil
+}
+
+// authenticateAPIToken checks an API token secret and resolves the user it acts as.
+func (a *Authenticator) authenticateAPIToken(ctx context.Context, secret string) (*Actor, error) {
+	token, err := a.tokens.GetAPITokenByHash(ctx, HashAPIToken(secret))
+	if errors.Is(err, repository.ErrAPITokenNotFound) {
+		return nil, status.Error(codes.Unauthenticated, "invalid API token")
+	}
+
+	if err != nil {
+		log.Printf("auth: failed to look up API token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to look up caller")
+	}
+
+	if token.RevokedAt != nil {
+		return nil, status.Error(codes.Unauthenticated, "API token has been revoked")
+	}
+
+	if !a.now().Before(token.ExpiresAt) {
+		return nil, status.Error(codes.Unauthenticated, "API token has expired")
+	}
+
+	user, err := a.users.GetUserByID(ctx, token.UserID)
+	if err != nil {
+		log.Printf("auth: failed to look up user %s of API token %s: %v", token.UserID, token.ID, err)
+		return nil, status.Error(codes.Internal, "failed to look up caller")
+	}
+
+	if !user.IsActive {
+		return nil, status.Error(codes.PermissionDenied, "caller account is deactivated")
+	}
+
+	if err := a.tokens.TouchAPIToken(ctx, token.ID); err != nil {
+		log.Printf("auth: failed to record use of API token %s: %v", token.ID, err)
+	}
+
+	return &Actor{
+		Identity: Identity{Subject: token.ID, CorporateID: user.CorporateID, Email: user.Email},
+		User:     user,
+		Token:    token,
+	}, nil
+}
+
+// bearerToken extracts the token from the authorization metadata, which the REST gateway
+// populates from the Authorization header.
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return "", status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+
+	return token, nil
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+// minRefreshInterval limits how often an unknown key ID forces a reload of the key set.
+const minRefreshInterval = time.Minute
+
+// minRetryInterval limits how often a key set that is missing or due for refresh is reloaded
+// after a failed attempt, so that an unreachable issuer is not hit by every request.
+const minRetryInterval = 5 * time.Second
+
+// KeySet is a JWKS loaded from a URL or file. It is reloaded once it is older than the
+// refresh interval, and early when a token is signed with a key it does not contain, so
+// that issuer key rotation is picked up without a restart. Concurrent lookups share a single
+// reload, which runs without holding the lock.
+type KeySet struct {
+	url     string
+	file    string
+	refresh time.Duration
+	client  *http.Client
+	now     func() time.Time
+
+	mu       sync.Mutex
+	keys     *jose.JSONWebKeySet
+	loadedAt time.Time
+	// attemptedAt and err record the last reload, whether or not it succeeded
+	attemptedAt time.Time
+	err         error
+	// loading is closed when the reload in flight finishes; it is nil when none is
+	loading chan struct{}
+}
+
+// NewKeySet creates a KeySet for the given source. Exactly one of url and file is used;
+// url takes precedence.
+func NewKeySet(url, file string, refresh time.Duration) (*KeySet, error) {
+	if url == "" && file == "" {
+		return nil, fmt.Errorf("a JWKS URL or file is required")
+	}
+
+	return &KeySet{
+		url:     url,
+		file:    file,
+		refresh: refresh,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		now:     time.Now,
+	}, nil
+}
+
+// Lookup returns the keys matching kid, or every key when kid is empty. When no key matches,
+// the set is reloaded (at most once per minRefreshInterval) and searched again. A set that
+// fails to reload stays in use until a reload succeeds.
+func (k *KeySet) Lookup(ctx context.Context, kid string) ([]jose.JSONWebKey, error) {
+	if k.due() {
+		if err := k.reload(ctx, minRetryInterval); err != nil && !k.loaded() {
+			return nil, err
+		}
+	}
+
+	keys := k.match(kid)
+	if len(keys) == 0 {
+		if err := k.reload(ctx, minRefreshInterval); err != nil {
+			return nil, err
+		}
+
+		keys = k.match(kid)
+	}
+
+	return keys, nil
+}
+
+// due reports whether the set has not been loaded or is older than the refresh interval.
+func (k *KeySet) due() bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return k.keys == nil || k.now().Sub(k.loadedAt) >= k.refresh
+}
+
+func (k *KeySet) loaded() bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return k.keys != nil
+}
+
+func (k *KeySet) match(kid string) []jose.JSONWebKey {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.keys == nil {
+		return nil
+	}
+
+	if kid == "" {
+		return k.keys.Keys
+	}
+
+	return k.keys.Key(kid)
+}
+
+// reload loads the set again unless the last attempt was less than minInterval ago, in which
+// case it returns that attempt's error. A caller that finds a reload in flight waits for it
+// rather than starting another. The reload outlives a caller that gives up waiting, so that the
+// callers sharing it are not failed by one cancellation.
+func (k *KeySet) reload(ctx context.Context, minInterval time.Duration) error {
+	k.mu.Lock()
+
+	if k.loading == nil {
+		if k.now().Sub(k.attemptedAt) < minInterval {
+			err := k.err
+			k.mu.Unlock()
+
+			return err
+		}
+
+		k.loading = make(chan struct{})
+		go k.load(context.WithoutCancel(ctx), k.loading)
+	}
+
+	loading := k.loading
+	k.mu.Unlock()
+
+	select {
+	case <-loading:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return k.err
+}
+
+// load fetches the key set and records the attempt, then closes done. On failure the previously
+// loaded keys stay in use.
+func (k *KeySet) load(ctx context.Context, done chan struct{}) {
+	keys, err := k.fetch(ctx)
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.attemptedAt = k.now()
+	k.err = err
+
+	if err == nil {
+		k.keys = keys
+		k.loadedAt = k.attemptedAt
+	}
+
+	k.loading = nil
+	close(done)
+}
+
+func (k *KeySet) fetch(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	data, err := k.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load JWKS: %w", err)
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	return &keys, nil
+}
+
+func (k *KeySet) read(ctx context.Context) ([]byte, error) {
+	if k.url == "" {
+		return os.ReadFile(k.file)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", k.url, resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
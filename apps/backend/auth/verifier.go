@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+// clockSkew is the leeway allowed when checking exp, nbf and iat.
+const clockSkew = time.Minute
+
+// signatureAlgorithms are the JWS algorithms accepted from the issuer.
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// ErrInvalidToken is returned for tokens that are malformed, unsigned by a known key,
+// expired, or issued for another issuer or audience.
+var ErrInvalidToken = errors.New("invalid token")
+
+// Verifier checks bearer JWTs against an issuer's key set.
+type Verifier struct {
+	keys          *KeySet
+	issuer        string
+	audience      string
+	identityClaim string
+	now           func() time.Time
+}
+
+// NewVerifier creates a Verifier for the given issuer. audience may be empty to accept any audience.
+func NewVerifier(keys *KeySet, issuer, audience, identityClaim string) *Verifier {
+	return &Verifier{
+		keys:          keys,
+		issuer:        issuer,
+		audience:      audience,
+		identityClaim: identityClaim,
+		now:           time.Now,
+	}
+}
+
+// Verify validates a raw token and returns the identity it asserts.
+func (v *Verifier) Verify(ctx context.Context, raw string) (*Identity, error) {
+	token, err := jwt.ParseSigned(raw, signatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	keys, err := v.keys.Lookup(ctx, token.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		claims jwt.Claims
+		extra  map[string]any
+	)
+
+	verified := false
+
+	for _, key := range keys {
+		if err := token.Claims(key.Key, &claims, &extra); err == nil {
+			verified = true
+			break
+		}
+	}
+
+	if !verified {
+		return nil, fmt.Errorf("%w: signature does not match any issuer key", ErrInvalidToken)
+	}
+
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("%w: missing exp claim", ErrInvalidToken)
+	}
+
+	expected := jwt.Expected{Issuer: v.issuer, Time: v.now()}
+	if v.audience != "" {
+		expected.AnyAudience = jwt.Audience{v.audience}
+	}
+
+	if err := claims.ValidateWithLeeway(expected, clockSkew); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	corporateID, _ := extra[v.identityClaim].(string)
+	if corporateID == "" {
+		return nil, fmt.Errorf("%w: missing %s claim", ErrInvalidToken, v.identityClaim)
+	}
+
+	email, _ := extra["email"].(string)
+
+	return &Identity{
+		Issuer:      claims.Issuer,
+		Subject:     claims.Subject,
+		CorporateID: corporateID,
+		Email:       email,
+	}, nil
+}
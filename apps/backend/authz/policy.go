@@ -0,0 +1,188 @@
+// Package authz enforces a declarative, per-method authorization policy on gRPC calls made by
+// actors that the auth package has authenticated.
+package authz
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"sourcestream/backend/auth"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/repository"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Policy maps full gRPC method names to the rule that guards them. Methods without a rule are
+// denied, and users with the admin role are allowed everything.
+type Policy map[string]Rule
+
+// DefaultPolicy is the authorization policy for the SourceStream services.
+func DefaultPolicy() Policy {
+	ospo := HasRole(RoleOSPOAdmin)
+	admin := HasRole(RoleAdmin)
+
+	return Policy{
+		// Users
+		pb.UserService_RegisterContributor_FullMethodName:  AnyIdentity(),
+		pb.UserService_GetContributor_FullMethodName:       Registered(),
+		pb.UserService_GetUserProfile_FullMethodName:       AnyOf(SelfCorporateID("corporate_id"), ospo),
+		pb.UserService_OffboardUser_FullMethodName:         admin,
+		pb.UserService_GetOffboardingReport_FullMethodName: ospo,
+		pb.UserService_SetDepartmentLead_FullMethodName:    admin,
+
+		// Departments, teams and managers
+		pb.UserService_SetDepartmentChampion_FullMethodName: ospo,
+		pb.UserService_ListDepartments_FullMethodName:       Registered(),
+		pb.UserService_CreateTeam_FullMethodName:            admin,
+		pb.UserService_GetTeam_FullMethodName:               Registered(),
+		pb.UserService_ListTeams_FullMethodName:             Registered(),
+		pb.UserService_AddTeamMember_FullMethodName:         admin,
+		pb.UserService_RemoveTeamMember_FullMethodName:      admin,
+		pb.UserService_SetManager_FullMethodName:            admin,
+		pb.UserService_ImportOrgChart_FullMethodName:        admin,
+
+		// Admin user directory
+		pb.UserService_ListUsers_FullMethodName:      admin,
+		pb.UserService_SearchUsers_FullMethodName:    admin,
+		pb.UserService_UpdateUser_FullMethodName:     admin,
+		pb.UserService_SetUserRole_FullMethodName:    admin,
+		pb.UserService_DeactivateUser_FullMethodName: admin,
+		pb.UserService_ReactivateUser_FullMethodName: admin,
+
+		// Service accounts and API tokens
+		pb.UserService_CreateServiceAccount_FullMethodName: admin,
+		pb.UserService_CreateAPIToken_FullMethodName:       admin,
+		pb.UserService_ListAPITokens_FullMethodName:        admin,
+		pb.UserService_RevokeAPIToken_FullMethodName:       admin,
+
+		// GitHub account verification
+		pb.UserService_StartGithubVerification_FullMethodName:    Self("user_id"),
+		pb.UserService_CompleteGithubVerification_FullMethodName: Self("user_id"),
+
+		// Projects and the approved projects catalog
+		pb.ProjectService_GetAuthoredProjects_FullMethodName:      AnyOf(Self("user_id"), ospo),
+		pb.ProjectService_GetContributedProjects_FullMethodName:   AnyOf(Self("user_id"), ospo),
+		pb.ProjectService_GetApprovedProjects_FullMethodName:      AnyOf(Self("user_id"), ospo),
+		pb.ProjectService_CreateProject_FullMethodName:            Self("owner_id"),
+		pb.ProjectService_GetApprovedProjectsList_FullMethodName:  Registered(),
+		pb.ProjectService_ListRecertificationTasks_FullMethodName: ospo,
+		pb.ProjectService_CompleteRecertification_FullMethodName:  ospo,
+		pb.ProjectService_AddProjectContributor_FullMethodName:    ProjectRole("project_id", ProjectRoleOwner, ProjectRoleMaintainer),
+		pb.ProjectService_RemoveProjectContributor_FullMethodName: ProjectRole("project_id", ProjectRoleOwner, ProjectRoleMaintainer),
+
+		// Requests and the contribution ledger
+		pb.RequestService_SubmitProjectRequest_FullMethodName:                Self("requester_id"),
+		pb.RequestService_SubmitPullRequestApproval_FullMethodName:           Self("requester_id"),
+		pb.RequestService_SubmitAccessRequest_FullMethodName:                 Self("requester_id"),
+		pb.RequestService_SubmitContributionPermissionRequest_FullMethodName: Self("requester_id"),
+		pb.RequestService_GetRequests_FullMethodName:                         AnyOf(Self("user_id"), ospo),
+		pb.RequestService_RecordContribution_FullMethodName:                  AnyOf(Requester("request_id"), ospo),
+		pb.RequestService_ImportContributions_FullMethodName:                 ospo,
+		pb.RequestService_ListContributions_FullMethodName:                   AnyOf(SelfRequired("user_id"), ospo),
+		pb.RequestService_GetContributionReport_FullMethodName:               ospo,
+		pb.RequestService_ListPullRequestEvents_FullMethodName:               AnyOf(Requester("request_id"), ospo),
+
+		// Approval rules
+		pb.RequestService_CreateApprovalRule_FullMethodName:       ospo,
+		pb.RequestService_UpdateApprovalRule_FullMethodName:       ospo,
+		pb.RequestService_ListApprovalRules_FullMethodName:        ospo,
+		pb.RequestService_DryRunPolicy_FullMethodName:             ospo,
+		pb.RequestService_ListAutoApprovedRequests_FullMethodName: ospo,
+	}
+}
+
+// Authorizer enforces a Policy, and the Scopes of callers that authenticated with an API token.
+type Authorizer struct {
+	policy  Policy
+	scopes  Scopes
+	lookups Lookups
+}
+
+// repositoryLookups resolves rule relationships from the database.
+type repositoryLookups struct {
+	*repository.ProjectRepository
+	*repository.RequestRepository
+}
+
+// NewAuthorizer creates an Authorizer for the default policy backed by the database.
+func NewAuthorizer(db *sql.DB) *Authorizer {
+	return newAuthorizer(DefaultPolicy(), DefaultScopes(), repositoryLookups{
+		ProjectRepository: repository.NewProjectRepository(db),
+		RequestRepository: repository.NewRequestRepository(db),
+	})
+}
+
+func newAuthorizer(policy Policy, scopes Scopes, lookups Lookups) *Authorizer {
+	return &Authorizer{policy: policy, scopes: scopes, lookups: lookups}
+}
+
+// Authorize checks a call against the policy. It returns a PermissionDenied status with the
+// reason when the rule refuses access.
+func (a *Authorizer) Authorize(ctx context.Context, method string, req proto.Message) error {
+	if auth.IsPublicMethod(method) {
+		return nil
+	}
+
+	actor, ok := auth.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "call is not authenticated")
+	}
+
+	// Scopes limit API tokens of admins too
+	if actor.Token != nil {
+		scope, ok := a.scopes[method]
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "%s cannot be called with an API token", method)
+		}
+
+		if !actor.HasScope(scope) {
+			return status.Errorf(codes.PermissionDenied, "API token lacks the %s scope", scope)
+		}
+	}
+
+	if actor.User != nil && actor.User.Role == RoleAdmin {
+		return nil
+	}
+
+	rule, ok := a.policy[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "no authorization policy for %s", method)
+	}
+
+	err := rule.Check(ctx, a.lookups, actor, req)
+
+	var denial *Denial
+	if errors.As(err, &denial) {
+		return status.Error(codes.PermissionDenied, denial.Reason)
+	}
+
+	if err != nil {
+		log.Printf("authz: failed to evaluate policy for %s: %v", method, err)
+		return status.Error(codes.Internal, "failed to evaluate authorization policy")
+	}
+
+	return nil
+}
+
+// UnaryInterceptor returns a unary server interceptor enforcing the policy. It must run after
+// the authentication interceptor.
+func (a *Authorizer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "unexpected request type %T", req)
+		}
+
+		if err := a.Authorize(ctx, info.FullMethod, msg); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,125 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"sourcestream/backend/auth"
+	"sourcestream/backend/models"
+	pb "sourcestream/backend/pb"
+)
+
+type fakeLookups struct {
+	projectRoles map[string]string // projectID/userID -> role
+	requesters   map[string]string // requestID -> requester ID
+}
+
+func (f fakeLookups) GetContributorRole(_ context.Context, projectID, userID string) (string, error) {
+	return f.projectRoles[projectID+"/"+userID], nil
+}
+
+func (f fakeLookups) GetRequestRequesterID(_ context.Context, requestID string) (string, error) {
+	return f.requesters[requestID], nil
+}
+
+func actor(id, role string) *auth.Actor {
+	return &auth.Actor{
+		Identity: auth.Identity{CorporateID: "corp-" + id},
+		User:     &models.User{ID: id, CorporateID: "corp-" + id, Role: role, IsActive: true},
+	}
+}
+
+func TestDefaultPolicy_CoversEveryMethod(t *testing.T) {
+	policy := DefaultPolicy()
+	services := pb.File_user_service_proto.Services()
+
+	for i := 0; i < services.Len(); i++ {
+		service := services.Get(i)
+		for j := 0; j < service.Methods().Len(); j++ {
+			method := fmt.Sprintf("/%s/%s", service.FullName(), service.Methods().Get(j).Name())
+			assert.Contains(t, policy, method)
+		}
+	}
+}
+
+func TestDefaultScopes_AreKnown(t *testing.T) {
+	policy := DefaultPolicy()
+
+	for method, scope := range DefaultScopes() {
+		assert.Contains(t, policy, method)
+		assert.True(t, auth.IsScope(scope), "%s: unknown scope %s", method, scope)
+	}
+}
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	authorizer := newAuthorizer(DefaultPolicy(), DefaultScopes(), fakeLookups{
+		projectRoles: map[string]string{"proj-1/alice": ProjectRoleOwner, "proj-1/bob": ProjectRoleContributor},
+		requesters:   map[string]string{"req-1": "alice"},
+	})
+
+	alice := actor("alice", RoleContributor)
+	bob := actor("bob", RoleContributor)
+	ospo := actor("olga", RoleOSPOAdmin)
+	admin := actor("ada", RoleAdmin)
+	unregistered := &auth.Actor{Identity: auth.Identity{CorporateID: "corp-new"}}
+	bot := actor("bot", RoleContributor)
+	bot.Token = &models.APIToken{Scopes: []string{auth.ScopeRequestsWrite, auth.ScopeCatalogRead}}
+	adminToken := actor("ada", RoleAdmin)
+	adminToken.Token = &models.APIToken{Scopes: []string{auth.ScopeCatalogRead}}
+
+	tests := []struct {
+		name   string
+		actor  *auth.Actor
+		method string
+		req    proto.Message
+		want   codes.Code
+	}{
+		{"own requests", alice, pb.RequestService_GetRequests_FullMethodName, &pb.GetRequestsRequest{UserId: "alice"}, codes.OK},
+		{"own requests by default", alice, pb.RequestService_GetRequests_FullMethodName, &pb.GetRequestsRequest{}, codes.OK},
+		{"another user's requests", bob, pb.RequestService_GetRequests_FullMethodName, &pb.GetRequestsRequest{UserId: "alice"}, codes.PermissionDenied},
+		{"ospo sees any requests", ospo, pb.RequestService_GetRequests_FullMethodName, &pb.GetRequestsRequest{UserId: "alice"}, codes.OK},
+		{"catalog management", alice, pb.ProjectService_CompleteRecertification_FullMethodName, &pb.CompleteRecertificationRequest{}, codes.PermissionDenied},
+		{"catalog management by ospo", ospo, pb.ProjectService_CompleteRecertification_FullMethodName, &pb.CompleteRecertificationRequest{}, codes.OK},
+		{"owner manages contributors", alice, pb.ProjectService_AddProjectContributor_FullMethodName, &pb.AddProjectContributorRequest{ProjectId: "proj-1"}, codes.OK},
+		{"contributor cannot manage contributors", bob, pb.ProjectService_AddProjectContributor_FullMethodName, &pb.AddProjectContributorRequest{ProjectId: "proj-1"}, codes.PermissionDenied},
+		{"stranger cannot manage contributors", bob, pb.ProjectService_RemoveProjectContributor_FullMethodName, &pb.RemoveProjectContributorRequest{ProjectId: "proj-2"}, codes.PermissionDenied},
+		{"requester reads pull request events", alice, pb.RequestService_ListPullRequestEvents_FullMethodName, &pb.ListPullRequestEventsRequest{RequestId: "req-1"}, codes.OK},
+		{"others cannot read pull request events", bob, pb.RequestService_ListPullRequestEvents_FullMethodName, &pb.ListPullRequestEventsRequest{RequestId: "req-1"}, codes.PermissionDenied},
+		{"ledger listing needs a user filter", alice, pb.RequestService_ListContributions_FullMethodName, &pb.ListContributionsRequest{}, codes.PermissionDenied},
+		{"submitting on behalf of another user", bob, pb.RequestService_SubmitAccessRequest_FullMethodName, &pb.SubmitAccessRequestRequest{RequesterId: "alice"}, codes.PermissionDenied},
+		{"admin is allowed everything", admin, pb.RequestService_GetContributionReport_FullMethodName, &pb.GetContributionReportRequest{}, codes.OK},
+		{"unregistered caller registers", unregistered, pb.UserService_RegisterContributor_FullMethodName, &pb.RegisterContributorRequest{}, codes.OK},
+		{"unregistered caller cannot browse", unregistered, pb.ProjectService_GetApprovedProjectsList_FullMethodName, &pb.GetApprovedProjectsListRequest{}, codes.PermissionDenied},
+		{"token submits pull request approval", bot, pb.RequestService_SubmitPullRequestApproval_FullMethodName, &pb.SubmitPullRequestApprovalRequest{RequesterId: "bot"}, codes.OK},
+		{"token reads the catalog", bot, pb.ProjectService_GetApprovedProjectsList_FullMethodName, &pb.GetApprovedProjectsListRequest{}, codes.OK},
+		{"token without the scope", bot, pb.RequestService_GetRequests_FullMethodName, &pb.GetRequestsRequest{}, codes.PermissionDenied},
+		{"token still needs the policy", bot, pb.RequestService_SubmitAccessRequest_FullMethodName, &pb.SubmitAccessRequestRequest{RequesterId: "alice"}, codes.PermissionDenied},
+		{"admin token is limited to its scopes", adminToken, pb.RequestService_GetContributionReport_FullMethodName, &pb.GetContributionReportRequest{}, codes.PermissionDenied},
+		{"tokens cannot manage tokens", adminToken, pb.UserService_CreateAPIToken_FullMethodName, &pb.CreateAPITokenRequest{}, codes.PermissionDenied},
+		{"unknown method", alice, "/backend.UserService/DeleteEverything", &pb.GetContributorRequest{}, codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		ctx := auth.NewContext(context.Background(), tt.actor)
+		err := authorizer.Authorize(ctx, tt.method, tt.req)
+
+		assert.Equal(t, tt.want, status.Code(err), tt.name)
+
+		if tt.want == codes.PermissionDenied {
+			assert.NotEmpty(t, status.Convert(err).Message(), tt.name)
+		}
+	}
+
+	err := authorizer.Authorize(context.Background(), pb.RequestService_GetRequests_FullMethodName, &pb.GetRequestsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Health checks need no credentials
+	err = authorizer.Authorize(context.Background(), healthpb.Health_Check_FullMethodName, &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+}
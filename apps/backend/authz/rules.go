@@ -0,0 +1,266 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"sourcestream/backend/auth"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// User roles. The database default for new users is contributor; the proto documents the
+// same base role as user.
+const (
+	RoleContributor = "contributor"
+	RoleUser        = "user"
+	RoleOSPOAdmin   = "ospo_admin"
+	RoleAdmin       = "admin"
+)
+
+// IsRole reports whether role is a known user role.
+func IsRole(role string) bool {
+	return role == RoleContributor || role == RoleUser || role == RoleOSPOAdmin || role == RoleAdmin
+}
+
+// Project roles from project_contributors, plus owner for the project's owner.
+const (
+	ProjectRoleOwner       = "owner"
+	ProjectRoleMaintainer  = "maintainer"
+	ProjectRoleContributor = "contributor"
+)
+
+// Lookups resolves the relationships that rules check.
+type Lookups interface {
+	// GetContributorRole returns the user's role on the project, or "" if none.
+	GetContributorRole(ctx context.Context, projectID, userID string) (string, error)
+	// GetRequestRequesterID returns the requester of the request, or "" if it does not exist.
+	GetRequestRequesterID(ctx context.Context, requestID string) (string, error)
+}
+
+// Rule decides whether an actor may make a call. A rule returns a *Denial when access is
+// refused and any other error when it could not decide.
+type Rule interface {
+	Check(ctx context.Context, lookups Lookups, actor *auth.Actor, req proto.Message) error
+	// String describes the rule for policy listings.
+	String() string
+}
+
+// Denial explains why a rule refused access.
+type Denial struct {
+	Reason string
+}
+
+func (d *Denial) Error() string {
+	return d.Reason
+}
+
+func deny(format string, args ...any) error {
+	return &Denial{Reason: fmt.Sprintf(format, args...)}
+}
+
+type anyIdentity struct{}
+
+// AnyIdentity allows any authenticated caller, including one without a users row.
+func AnyIdentity() Rule { return anyIdentity{} }
+
+func (anyIdentity) Check(context.Context, Lookups, *auth.Actor, proto.Message) error {
+	return nil
+}
+
+func (anyIdentity) String() string { return "any authenticated identity" }
+
+type registered struct{}
+
+// Registered allows any registered user.
+func Registered() Rule { return registered{} }
+
+func (registered) Check(_ context.Context, _ Lookups, actor *auth.Actor, _ proto.Message) error {
+	if actor.User == nil {
+		return deny("caller is not a registered contributor")
+	}
+
+	return nil
+}
+
+func (registered) String() string { return "registered user" }
+
+type hasRole struct {
+	roles []string
+}
+
+// HasRole allows users holding one of the roles.
+func HasRole(roles ...string) Rule { return hasRole{roles: roles} }
+
+func (r hasRole) Check(_ context.Context, _ Lookups, actor *auth.Actor, _ proto.Message) error {
+	if actor.User == nil || !slices.Contains(r.roles, actor.User.Role) {
+		return deny("requires role %s", joinOr(r.roles))
+	}
+
+	return nil
+}
+
+func (r hasRole) String() string { return "role " + joinOr(r.roles) }
+
+type self struct {
+	field    string
+	required bool
+}
+
+// Self allows a call whose user ID field is the caller's ID or empty (meaning the caller).
+func Self(field string) Rule { return self{field: field} }
+
+// SelfRequired is Self where an empty field is refused, for filters where empty means everyone.
+func SelfRequired(field string) Rule { return self{field: field, required: true} }
+
+func (r self) Check(_ context.Context, _ Lookups, actor *auth.Actor, req proto.Message) error {
+	if actor.User == nil {
+		return deny("caller is not a registered contributor")
+	}
+
+	value := stringField(req, r.field)
+	if value == actor.User.ID || (value == "" && !r.required) {
+		return nil
+	}
+
+	return deny("%s must be the caller", r.field)
+}
+
+func (r self) String() string { return r.field + " is the caller" }
+
+type selfCorporateID struct {
+	field string
+}
+
+// SelfCorporateID allows a call whose corporate ID field is the caller's corporate ID.
+func SelfCorporateID(field string) Rule { return selfCorporateID{field: field} }
+
+func (r selfCorporateID) Check(_ context.Context, _ Lookups, actor *auth.Actor, req proto.Message) error {
+	if value := stringField(req, r.field); value == "" || value == actor.Identity.CorporateID {
+		return nil
+	}
+
+	return deny("%s must be the caller", r.field)
+}
+
+func (r selfCorporateID) String() string { return r.field + " is the caller" }
+
+type projectRole struct {
+	field string
+	roles []string
+}
+
+// ProjectRole allows users holding one of the roles on the project named by the field.
+func ProjectRole(field string, roles ...string) Rule { return projectRole{field: field, roles: roles} }
+
+func (r projectRole) Check(ctx context.Context, lookups Lookups, actor *auth.Actor, req proto.Message) error {
+	if actor.User == nil {
+		return deny("caller is not a registered contributor")
+	}
+
+	role, err := lookups.GetContributorRole(ctx, stringField(req, r.field), actor.User.ID)
+	if err != nil {
+		return fmt.Errorf("failed to look up project role: %w", err)
+	}
+
+	if !slices.Contains(r.roles, role) {
+		return deny("requires project role %s", joinOr(r.roles))
+	}
+
+	return nil
+}
+
+func (r projectRole) String() string { return "project role " + joinOr(r.roles) + " on " + r.field }
+
+type requester struct {
+	field string
+}
+
+// Requester allows the requester of the request named by the field.
+func Requester(field string) Rule { return requester{field: field} }
+
+func (r requester) Check(ctx context.Context, lookups Lookups, actor *auth.Actor, req proto.Message) error {
+	if actor.User == nil {
+		return deny("caller is not a registered contributor")
+	}
+
+	requesterID, err := lookups.GetRequestRequesterID(ctx, stringField(req, r.field))
+	if err != nil {
+		return fmt.Errorf("failed to look up request: %w", err)
+	}
+
+	if requesterID == "" || requesterID != actor.User.ID {
+		return deny("only the requester may access this request")
+	}
+
+	return nil
+}
+
+func (r requester) String() string { return "requester of " + r.field }
+
+type anyOf struct {
+	rules []Rule
+}
+
+// AnyOf allows a call that any of the rules allows. When all deny, the first denial is reported.
+func AnyOf(rules ...Rule) Rule { return anyOf{rules: rules} }
+
+func (r anyOf) Check(ctx context.Context, lookups Lookups, actor *auth.Actor, req proto.Message) error {
+	var first error
+
+	for _, rule := range r.rules {
+		err := rule.Check(ctx, lookups, actor, req)
+		if err == nil {
+			return nil
+		}
+
+		var denial *Denial
+		if !errors.As(err, &denial) {
+			return err
+		}
+
+		if first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
+
+func (r anyOf) String() string {
+	names := make([]string, len(r.rules))
+	for i, rule := range r.rules {
+		names[i] = rule.String()
+	}
+
+	return joinOr(names)
+}
+
+// stringField returns the named string field of a request, or "" if it has none.
+func stringField(req proto.Message, name string) string {
+	fd := req.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return ""
+	}
+
+	return req.ProtoReflect().Get(fd).String()
+}
+
+func joinOr(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	}
+
+	out := items[0]
+	for _, item := range items[1 : len(items)-1] {
+		out += ", " + item
+	}
+
+	return out + " or " + items[len(items)-1]
+}
@@ -0,0 +1,49 @@
+package authz
+
+import (
+	"sourcestream/backend/auth"
+	pb "sourcestream/backend/pb"
+)
+
+// Scopes maps full gRPC method names to the API token scope needed to call them. Methods without
+// a scope cannot be called with an API token at all, which keeps token management, offboarding
+// and policy administration to people who signed in.
+type Scopes map[string]string
+
+// DefaultScopes is the API token scope map for the SourceStream services.
+func DefaultScopes() Scopes {
+	return Scopes{
+		// Users and the organization
+		pb.UserService_GetContributor_FullMethodName:  auth.ScopeUsersRead,
+		pb.UserService_GetUserProfile_FullMethodName:  auth.ScopeUsersRead,
+		pb.UserService_ListDepartments_FullMethodName: auth.ScopeUsersRead,
+		pb.UserService_GetTeam_FullMethodName:         auth.ScopeUsersRead,
+		pb.UserService_ListTeams_FullMethodName:       auth.ScopeUsersRead,
+		pb.UserService_ListUsers_FullMethodName:       auth.ScopeUsersRead,
+		pb.UserService_SearchUsers_FullMethodName:     auth.ScopeUsersRead,
+
+		// Projects and the approved projects catalog
+		pb.ProjectService_GetAuthoredProjects_FullMethodName:      auth.ScopeProjectsRead,
+		pb.ProjectService_GetContributedProjects_FullMethodName:   auth.ScopeProjectsRead,
+		pb.ProjectService_GetApprovedProjects_FullMethodName:      auth.ScopeProjectsRead,
+		pb.ProjectService_CreateProject_FullMethodName:            auth.ScopeProjectsWrite,
+		pb.ProjectService_AddProjectContributor_FullMethodName:    auth.ScopeProjectsWrite,
+		pb.ProjectService_RemoveProjectContributor_FullMethodName: auth.ScopeProjectsWrite,
+		pb.ProjectService_GetApprovedProjectsList_FullMethodName:  auth.ScopeCatalogRead,
+		pb.ProjectService_ListRecertificationTasks_FullMethodName: auth.ScopeCatalogRead,
+		pb.ProjectService_CompleteRecertification_FullMethodName:  auth.ScopeCatalogWrite,
+
+		// Requests and the contribution ledger
+		pb.RequestService_SubmitProjectRequest_FullMethodName:                auth.ScopeRequestsWrite,
+		pb.RequestService_SubmitPullRequestApproval_FullMethodName:           auth.ScopeRequestsWrite,
+		pb.RequestService_SubmitAccessRequest_FullMethodName:                 auth.ScopeRequestsWrite,
+		pb.RequestService_SubmitContributionPermissionRequest_FullMethodName: auth.ScopeRequestsWrite,
+		pb.RequestService_GetRequests_FullMethodName:                         auth.ScopeRequestsRead,
+		pb.RequestService_ListPullRequestEvents_FullMethodName:               auth.ScopeRequestsRead,
+		pb.RequestService_ListAutoApprovedRequests_FullMethodName:            auth.ScopeRequestsRead,
+		pb.RequestService_RecordContribution_FullMethodName:                  auth.ScopeContributionsWrite,
+		pb.RequestService_ImportContributions_FullMethodName:                 auth.ScopeContributionsWrite,
+		pb.RequestService_ListContributions_FullMethodName:                   auth.ScopeRequestsRead,
+		pb.RequestService_GetContributionReport_FullMethodName:               auth.ScopeRequestsRead,
+	}
+}
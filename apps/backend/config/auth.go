@@ -0,0 +1,35 @@
+package config
+
+import "time"
+
+// AuthConfig holds settings for verifying the bearer JWTs presented by callers.
+type AuthConfig struct {
+	// Issuer is the expected iss claim. Authentication is disabled when it is empty.
+	Issuer string
+	// Audience, when set, must appear in the aud claim.
+	Audience string
+	// JWKSURL and JWKSFile locate the issuer's signing keys; the URL takes precedence.
+	JWKSURL  string
+	JWKSFile string
+	// JWKSRefresh is how long a loaded key set is used before it is reloaded.
+	JWKSRefresh time.Duration
+	// IdentityClaim names the claim that holds the caller's corporate ID.
+	IdentityClaim string
+}
+
+// NewAuthConfig builds an AuthConfig from environment variables with defaults.
+func NewAuthConfig() *AuthConfig {
+	return &AuthConfig{
+		Issuer:        getEnv("AUTH_ISSUER", ""),
+		Audience:      getEnv("AUTH_AUDIENCE", ""),
+		JWKSURL:       getEnv("AUTH_JWKS_URL", ""),
+		JWKSFile:      getEnv("AUTH_JWKS_FILE", ""),
+		JWKSRefresh:   getEnvAsDuration("AUTH_JWKS_REFRESH", time.Hour),
+		IdentityClaim: getEnv("AUTH_IDENTITY_CLAIM", "preferred_username"),
+	}
+}
+
+// Enabled reports whether callers must authenticate.
+func (c *AuthConfig) Enabled() bool {
+	return c.Issuer != ""
+}
@@ -20,6 +20,9 @@ type DatabaseConfig struct {
 	Password string
 	DBName   string
 	SSLMode  string
+	// StatementTimeout makes the server cancel any statement running longer; zero disables it.
+	// Statements are also cancelled when the call that issued them is.
+	StatementTimeout time.Duration
 }
 
 // NewDatabaseConfig builds a DatabaseConfig from environment variables with defaults.
@@ -31,13 +34,22 @@ func NewDatabaseConfig() *DatabaseConfig {
 		Password: getEnv("DB_PASSWORD", "password"),
 		DBName:   getEnv("DB_NAME", "sourcestream"),
 		SSLMode:  getEnv("DB_SSLMODE", "disable"),
+
+		StatementTimeout: getEnvAsDuration("DB_STATEMENT_TIMEOUT", 30*time.Second),
 	}
 }
 
 // ConnectionString returns a lib/pq connection string from the config.
 func (c *DatabaseConfig) ConnectionString() string {
-	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+	conn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		c.Host, c.Port, c.User, c.Password, c.DBName, c.SSLMode)
+
+	// lib/pq sends settings it does not know as run-time parameters of each connection
+	if c.StatementTimeout > 0 {
+		conn += fmt.Sprintf(" statement_timeout=%d", c.StatementTimeout.Milliseconds())
+	}
+
+	return conn
 }
 
 // NewDatabase opens and verifies a PostgreSQL connection using environment configuration.
@@ -80,7 +92,7 @@ func NewDatabase() (*sql.DB, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	log.Printf("Successfully connected to PostgreSQL database: %s", config.DBName)
+	log.Printf("Successfully connected to PostgreSQL database: %s (statement timeout %s)", config.DBName, config.StatementTimeout)
 	log.Printf("Connection pool configured: MaxOpenConns=%d, MaxIdleConns=%d, MaxLifetime=30m, MaxIdleTime=5m",
 		50, 10)
 
@@ -0,0 +1,22 @@
+package config
+
+import "time"
+
+// LifecycleConfig holds settings for shutting the servers down.
+type LifecycleConfig struct {
+	// DrainDelay is how long the server keeps serving after SIGTERM while reporting not ready, so
+	// that load balancers stop sending it new requests first.
+	DrainDelay time.Duration
+	// ShutdownTimeout bounds how long in-flight requests and background workers get to finish
+	// before they are cut off.
+	ShutdownTimeout time.Duration
+}
+
+// NewLifecycleConfig builds a LifecycleConfig from environment variables with defaults. Their sum
+// should stay below the pod's termination grace period.
+func NewLifecycleConfig() *LifecycleConfig {
+	return &LifecycleConfig{
+		DrainDelay:      getEnvAsDuration("SHUTDOWN_DRAIN_DELAY", 5*time.Second),
+		ShutdownTimeout: getEnvAsDuration("SHUTDOWN_TIMEOUT", 20*time.Second),
+	}
+}
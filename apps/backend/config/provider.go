@@ -0,0 +1,19 @@
+package config
+
+// ProviderConfig holds settings for the Git hosting provider used to read upstream data.
+type ProviderConfig struct {
+	// Kind is one of github, gitlab, gitea or fake.
+	Kind string
+	// BaseURL is the API root; empty uses the public github.com or gitlab.com API.
+	BaseURL string
+	Token   string
+}
+
+// NewProviderConfig builds a ProviderConfig from environment variables with defaults.
+func NewProviderConfig() *ProviderConfig {
+	return &ProviderConfig{
+		Kind:    getEnv("GIT_PROVIDER", "github"),
+		BaseURL: getEnv("GIT_PROVIDER_URL", ""),
+		Token:   getEnv("GIT_PROVIDER_TOKEN", ""),
+	}
+}
@@ -0,0 +1,15 @@
+package config
+
+// SCIMConfig holds the settings for the SCIM provisioning endpoint.
+type SCIMConfig struct {
+	// Token is the bearer token the identity provider presents. The endpoint is disabled when it
+	// is empty.
+	Token string
+}
+
+// NewSCIMConfig builds a SCIMConfig from environment variables.
+func NewSCIMConfig() *SCIMConfig {
+	return &SCIMConfig{
+		Token: getEnv("SCIM_BEARER_TOKEN", ""),
+	}
+}
@@ -0,0 +1,23 @@
+package config
+
+// GithubVerificationConfig holds settings for verifying that users own their GitHub accounts
+// through the OAuth device flow.
+type GithubVerificationConfig struct {
+	// Kind is github or fake; verification is disabled when it is empty.
+	Kind string
+	// ClientID is the GitHub OAuth app's client ID; the app must have device flow enabled.
+	ClientID string
+	// OAuthURL and APIURL are the GitHub web and API roots; empty uses github.com.
+	OAuthURL string
+	APIURL   string
+}
+
+// NewGithubVerificationConfig builds a GithubVerificationConfig from environment variables.
+func NewGithubVerificationConfig() *GithubVerificationConfig {
+	return &GithubVerificationConfig{
+		Kind:     getEnv("GITHUB_VERIFICATION", ""),
+		ClientID: getEnv("GITHUB_OAUTH_CLIENT_ID", ""),
+		OAuthURL: getEnv("GITHUB_OAUTH_URL", ""),
+		APIURL:   getEnv("GITHUB_API_URL", ""),
+	}
+}
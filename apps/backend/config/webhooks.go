@@ -0,0 +1,23 @@
+package config
+
+// WebhookConfig holds the shared secrets used to verify inbound webhook deliveries.
+type WebhookConfig struct {
+	// Secrets maps a source (github, gitlab or gitea) to its secret. Sources without a
+	// secret do not accept deliveries.
+	Secrets map[string]string
+	// GitLabURL is the web address of the GitLab instance sending system hooks, which name
+	// projects by path only.
+	GitLabURL string
+}
+
+// NewWebhookConfig builds a WebhookConfig from environment variables.
+func NewWebhookConfig() *WebhookConfig {
+	return &WebhookConfig{
+		Secrets: map[string]string{
+			"github": getEnv("WEBHOOK_SECRET_GITHUB", ""),
+			"gitlab": getEnv("WEBHOOK_SECRET_GITLAB", ""),
+			"gitea":  getEnv("WEBHOOK_SECRET_GITEA", ""),
+		},
+		GitLabURL: getEnv("WEBHOOK_GITLAB_URL", "https://gitlab.com"),
+	}
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+// RecertificationConfig holds settings for the approved project re-certification job.
+type RecertificationConfig struct {
+	// Interval is how often the job scans the catalog.
+	Interval time.Duration
+	// LeadTime is how far ahead of the next review date a re-certification task is opened.
+	LeadTime time.Duration
+}
+
+// NewRecertificationConfig builds a RecertificationConfig from environment variables with defaults.
+func NewRecertificationConfig() *RecertificationConfig {
+	return &RecertificationConfig{
+		Interval: getEnvAsDuration("RECERTIFICATION_INTERVAL", time.Hour),
+		LeadTime: getEnvAsDuration("RECERTIFICATION_LEAD_TIME", 30*24*time.Hour),
+	}
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil && duration > 0 {
+			return duration
+		}
+	}
+
+	return defaultValue
+}
+
+// PullRequestPollConfig holds settings for the upstream pull request status poller.
+type PullRequestPollConfig struct {
+	// Interval is how often tracked pull requests are checked upstream.
+	Interval time.Duration
+	// BatchSize is the maximum number of pull requests checked per pass.
+	BatchSize int
+}
+
+// NewPullRequestPollConfig builds a PullRequestPollConfig from environment variables with defaults.
+func NewPullRequestPollConfig() *PullRequestPollConfig {
+	return &PullRequestPollConfig{
+		Interval:  getEnvAsDuration("PR_POLL_INTERVAL", 15*time.Minute),
+		BatchSize: getEnvAsInt("PR_POLL_BATCH_SIZE", 100),
+	}
+}
@@ -0,0 +1,67 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"sourcestream/backend/workers"
+)
+
+// Database checks that the database answers a ping.
+func Database(db *sql.DB) Check {
+	return func(ctx context.Context) (string, error) {
+		if err := db.PingContext(ctx); err != nil {
+			return "", fmt.Errorf("database ping failed: %w", err)
+		}
+
+		return "", nil
+	}
+}
+
+// Migrations checks that the schema_migrations table records the expected schema version.
+func Migrations(db *sql.DB, expected int) Check {
+	return func(ctx context.Context) (string, error) {
+		var version int
+
+		err := db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&version)
+		if err != nil {
+			return "", fmt.Errorf("failed to read schema version: %w", err)
+		}
+
+		detail := fmt.Sprintf("schema version %d, expected %d", version, expected)
+
+		if version < expected {
+			return detail, fmt.Errorf("schema is behind: apply migrations %d to %d", version+1, expected)
+		}
+
+		return detail, nil
+	}
+}
+
+// WorkerState is implemented by the background workers.
+type WorkerState interface {
+	State() workers.State
+}
+
+// Worker checks that a background worker is still running. A failed last pass is reported in
+// the detail without failing the check, since the worker retries on its next pass.
+func Worker(w WorkerState) Check {
+	return func(context.Context) (string, error) {
+		state := w.State()
+
+		if !state.Running {
+			return "", fmt.Errorf("worker is not running")
+		}
+
+		switch {
+		case state.LastRun.IsZero():
+			return "first pass in progress", nil
+		case state.LastError != nil:
+			return fmt.Sprintf("last pass at %s failed: %v", state.LastRun.Format(time.RFC3339), state.LastError), nil
+		default:
+			return fmt.Sprintf("last pass at %s", state.LastRun.Format(time.RFC3339)), nil
+		}
+	}
+}
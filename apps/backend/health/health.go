@@ -0,0 +1,233 @@
+// Package health reports whether the backend is alive and ready to serve, over HTTP for
+// Kubernetes probes and over the gRPC health protocol (grpc.health.v1) for gRPC clients and
+// load balancers.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Check statuses.
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// Report statuses.
+const (
+	StatusReady    = "ready"
+	StatusNotReady = "not_ready"
+)
+
+const (
+	// checkTimeout bounds each check, so one hanging dependency cannot stall a probe.
+	checkTimeout = 2 * time.Second
+	// watchInterval is how often Run re-evaluates the gRPC serving status.
+	watchInterval = 5 * time.Second
+	// serverCheck names the report entry for the process itself not serving.
+	serverCheck = "server"
+)
+
+// Check reports on one dependency. It returns a human-readable detail, and an error when the
+// dependency is not usable.
+type Check func(ctx context.Context) (string, error)
+
+// CheckResult is the outcome of one check.
+type CheckResult struct {
+	Status     string `json:"status"`
+	Detail     string `json:"detail,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+// Report is the outcome of a readiness evaluation. It is ready when the process is serving and
+// every check passed.
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+type namedCheck struct {
+	name     string
+	check    Check
+	services []string
+}
+
+// Checker runs readiness checks and publishes their outcome as the serving status of the gRPC
+// services.
+type Checker struct {
+	serving  func() bool
+	services []string
+	checks   []namedCheck
+	grpc     *health.Server
+}
+
+// New creates a Checker for the named gRPC services. serving reports whether the process has
+// started and is not shutting down. Every service is NOT_SERVING until Run first evaluates the
+// checks.
+func New(serving func() bool, services ...string) *Checker {
+	c := &Checker{serving: serving, services: services, grpc: health.NewServer()}
+
+	c.grpc.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	for _, service := range services {
+		c.grpc.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	return c
+}
+
+// Add registers a readiness check. A failing check makes the process not ready, and the gRPC
+// services listed NOT_SERVING; with no services listed, every service depends on it.
+func (c *Checker) Add(name string, check Check, services ...string) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check, services: services})
+}
+
+// GRPCServer returns the grpc.health.v1 Health service to register on the gRPC server.
+func (c *Checker) GRPCServer() healthpb.HealthServer {
+	return c.grpc
+}
+
+// Check runs every check concurrently and reports the outcome.
+func (c *Checker) Check(ctx context.Context) Report {
+	report, _ := c.evaluate(ctx)
+	return report
+}
+
+// evaluate runs the checks and also returns which gRPC services have a failing dependency.
+func (c *Checker) evaluate(ctx context.Context) (Report, map[string]bool) {
+	results := make([]CheckResult, len(c.checks))
+
+	var wg sync.WaitGroup
+
+	for i, nc := range c.checks {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			results[i] = run(ctx, nc.check)
+		}()
+	}
+
+	wg.Wait()
+
+	report := Report{Status: StatusReady, Checks: make(map[string]CheckResult, len(c.checks))}
+	failing := make(map[string]bool)
+
+	if !c.serving() {
+		report.Status = StatusNotReady
+		report.Checks[serverCheck] = CheckResult{Status: StatusFail, Error: "the server is starting or shutting down"}
+	}
+
+	for i, nc := range c.checks {
+		report.Checks[nc.name] = results[i]
+
+		if results[i].Status == StatusOK {
+			continue
+		}
+
+		report.Status = StatusNotReady
+
+		services := nc.services
+		if len(services) == 0 {
+			services = c.services
+		}
+
+		for _, service := range services {
+			failing[service] = true
+		}
+	}
+
+	return report, failing
+}
+
+func run(ctx context.Context, check Check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	start := time.Now()
+	detail, err := check(ctx)
+
+	result := CheckResult{Status: StatusOK, Detail: detail, DurationMS: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// Run re-evaluates the checks every few seconds and updates the gRPC serving status until ctx is
+// cancelled.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		c.publish(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) publish(ctx context.Context) {
+	report, failing := c.evaluate(ctx)
+
+	// After Shutdown, the health server ignores status updates
+	c.grpc.SetServingStatus("", servingStatus(report.Status == StatusReady))
+
+	for _, service := range c.services {
+		c.grpc.SetServingStatus(service, servingStatus(c.serving() && !failing[service]))
+	}
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+// Shutdown reports every gRPC service NOT_SERVING from now on, so clients move away before the
+// server stops.
+func (c *Checker) Shutdown() {
+	c.grpc.Shutdown()
+}
+
+// Healthz is the liveness endpoint. It answers 200 whenever the process can serve HTTP at all,
+// without checking dependencies: restarting the pod would not fix a database outage.
+func (c *Checker) Healthz(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": StatusOK})
+}
+
+// Readyz is the readiness endpoint. It answers 200 when ready and 503 otherwise, with the
+// Report as the body.
+func (c *Checker) Readyz(w http.ResponseWriter, r *http.Request) {
+	report := c.Check(r.Context())
+
+	code := http.StatusOK
+	if report.Status != StatusReady {
+		code = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, code, report)
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,93 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"sourcestream/backend/workers"
+)
+
+type fakeWorker workers.State
+
+func (f fakeWorker) State() workers.State { return workers.State(f) }
+
+func passing(context.Context) (string, error) { return "fine", nil }
+
+func failing(context.Context) (string, error) { return "", errors.New("down") }
+
+func TestChecker_Readyz(t *testing.T) {
+	var serving atomic.Bool
+
+	c := New(serving.Load, "svc.A")
+	c.Add("database", passing)
+	c.Add("worker:sync", Worker(fakeWorker{Running: true, LastRun: time.Now(), LastError: errors.New("upstream timeout")}))
+
+	readyz := func() (int, Report) {
+		rec := httptest.NewRecorder()
+		c.Readyz(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+		var report Report
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+
+		return rec.Code, report
+	}
+
+	code, report := readyz()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, StatusFail, report.Checks[serverCheck].Status)
+
+	serving.Store(true)
+
+	code, report = readyz()
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StatusReady, report.Status)
+	assert.Equal(t, "fine", report.Checks["database"].Detail)
+	assert.Contains(t, report.Checks["worker:sync"].Detail, "upstream timeout")
+
+	c.Add("migrations", failing)
+
+	code, report = readyz()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, StatusNotReady, report.Status)
+	assert.Equal(t, "down", report.Checks["migrations"].Error)
+
+	rec := httptest.NewRecorder()
+	c.Healthz(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestChecker_GRPCStatus(t *testing.T) {
+	serving := true
+	c := New(func() bool { return serving }, "svc.A", "svc.B")
+	c.Add("database", passing)
+	c.Add("worker:b", Worker(fakeWorker{}), "svc.B")
+
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := c.GRPCServer().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+
+		return resp.GetStatus()
+	}
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status("svc.A"))
+
+	c.publish(context.Background())
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status("svc.A"))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status("svc.B"))
+
+	c.Shutdown()
+	c.publish(context.Background())
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status("svc.A"))
+}
@@ -0,0 +1,253 @@
+// Package lifecycle runs the backend's servers and background workers, and shuts them down in
+// order when the process is asked to stop.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"sourcestream/backend/config"
+
+	"google.golang.org/grpc"
+)
+
+// Manager starts servers and workers, reports readiness, and on SIGINT or SIGTERM shuts down:
+// it marks the process not ready, waits out the drain delay (SIGTERM only), stops the servers
+// gracefully in the reverse of the order they were added, stops the workers, and finally runs the
+// closers, e.g. to close the database pool. A second signal ends the drain and stops the servers
+// and workers without waiting.
+type Manager struct {
+	drainDelay      time.Duration
+	shutdownTimeout time.Duration
+	servers         []server
+	workers         []func(ctx context.Context)
+	closers         []closer
+	stopping        []func()
+	stopOnce        sync.Once
+	ready           atomic.Bool
+}
+
+type server struct {
+	name     string
+	serve    func() error
+	shutdown func(ctx context.Context) error
+}
+
+type closer struct {
+	name  string
+	close func() error
+}
+
+// New creates a Manager from the lifecycle configuration.
+func New(cfg *config.LifecycleConfig) *Manager {
+	return &Manager{drainDelay: cfg.DrainDelay, shutdownTimeout: cfg.ShutdownTimeout}
+}
+
+// Ready reports whether the process is serving and not shutting down.
+func (m *Manager) Ready() bool {
+	return m.ready.Load()
+}
+
+// AddGRPCServer serves s on lis. At shutdown it stops accepting calls and waits for in-flight
+// calls to finish, cancelling any still running at the deadline.
+func (m *Manager) AddGRPCServer(name string, s *grpc.Server, lis net.Listener) {
+	m.servers = append(m.servers, server{
+		name:  name,
+		serve: func() error { return s.Serve(lis) },
+		shutdown: func(ctx context.Context) error {
+			done := make(chan struct{})
+
+			go func() {
+				s.GracefulStop()
+				close(done)
+			}()
+
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				s.Stop()
+				return ctx.Err()
+			}
+		},
+	})
+}
+
+// AddHTTPServer serves s. At shutdown it stops accepting requests and waits for in-flight
+// requests to finish, closing the connections still open at the deadline.
+func (m *Manager) AddHTTPServer(name string, s *http.Server) {
+	m.servers = append(m.servers, server{
+		name: name,
+		serve: func() error {
+			if err := s.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+
+			return nil
+		},
+		shutdown: func(ctx context.Context) error {
+			if err := s.Shutdown(ctx); err != nil {
+				_ = s.Close()
+				return err
+			}
+
+			return nil
+		},
+	})
+}
+
+// AddWorker runs a background worker until its context is cancelled at shutdown.
+func (m *Manager) AddWorker(run func(ctx context.Context)) {
+	m.workers = append(m.workers, run)
+}
+
+// OnStopping registers a function to run as soon as shutdown begins, before the drain delay,
+// e.g. to report not serving to health checks.
+func (m *Manager) OnStopping(fn func()) {
+	m.stopping = append(m.stopping, fn)
+}
+
+// AddCloser registers a function to run once servers and workers have stopped.
+func (m *Manager) AddCloser(name string, fn func() error) {
+	m.closers = append(m.closers, closer{name: name, close: fn})
+}
+
+// Run starts everything and blocks until a signal arrives, ctx is cancelled or a server fails,
+// then shuts down. It returns the error of the server that failed, if any.
+func (m *Manager) Run(ctx context.Context) error {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	defer signal.Stop(signals)
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	var workers sync.WaitGroup
+
+	for _, run := range m.workers {
+		workers.Add(1)
+
+		go func() {
+			defer workers.Done()
+			run(workerCtx)
+		}()
+	}
+
+	failed := make(chan error, len(m.servers))
+
+	for _, s := range m.servers {
+		go func() {
+			if err := s.serve(); err != nil {
+				log.Printf("%s server failed: %v", s.name, err)
+				failed <- err
+			}
+		}()
+	}
+
+	m.ready.Store(true)
+
+	var (
+		serveErr error
+		drain    time.Duration
+	)
+
+	select {
+	case sig := <-signals:
+		log.Printf("received %s, shutting down", sig)
+
+		if sig == syscall.SIGTERM {
+			drain = m.drainDelay
+		}
+	case <-ctx.Done():
+		log.Printf("shutting down")
+	case serveErr = <-failed:
+	}
+
+	// A further signal skips the drain and the graceful stops
+	forceCtx, force := context.WithCancel(context.Background())
+	defer force()
+
+	go func() {
+		select {
+		case sig := <-signals:
+			log.Printf("received %s again, stopping immediately", sig)
+			force()
+		case <-forceCtx.Done():
+		}
+	}()
+
+	m.stopServing()
+
+	if drain > 0 {
+		log.Printf("draining for %s before stopping servers", drain)
+
+		select {
+		case <-time.After(drain):
+		case <-forceCtx.Done():
+		}
+	}
+
+	m.shutdown(forceCtx, stopWorkers, &workers)
+
+	return serveErr
+}
+
+// shutdown stops the servers, then the workers, then runs the closers, all within the shutdown
+// timeout except the closers. Cancelling forceCtx cuts the timeout short.
+func (m *Manager) shutdown(forceCtx context.Context, stopWorkers context.CancelFunc, workers *sync.WaitGroup) {
+	ctx, cancel := context.WithTimeout(forceCtx, m.shutdownTimeout)
+	defer cancel()
+
+	for i := len(m.servers) - 1; i >= 0; i-- {
+		s := m.servers[i]
+		if err := s.shutdown(ctx); err != nil {
+			log.Printf("%s server did not stop gracefully: %v", s.name, err)
+		} else {
+			log.Printf("%s server stopped", s.name)
+		}
+	}
+
+	stopWorkers()
+
+	stopped := make(chan struct{})
+
+	go func() {
+		workers.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		log.Printf("background workers stopped")
+	case <-ctx.Done():
+		log.Printf("background workers did not stop before the shutdown timeout")
+	}
+
+	for i := len(m.closers) - 1; i >= 0; i-- {
+		c := m.closers[i]
+		if err := c.close(); err != nil {
+			log.Printf("failed to close %s: %v", c.name, err)
+		}
+	}
+}
+
+// stopServing marks the process not ready and runs the OnStopping functions, once.
+func (m *Manager) stopServing() {
+	m.ready.Store(false)
+
+	m.stopOnce.Do(func() {
+		for _, fn := range m.stopping {
+			fn()
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package lifecycle
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"sourcestream/backend/config"
+)
+
+func TestManager_ShutsDownInOrder(t *testing.T) {
+	m := New(&config.LifecycleConfig{ShutdownTimeout: 5 * time.Second})
+
+	var (
+		mu     sync.Mutex
+		events []string
+	)
+
+	record := func(event string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		events = append(events, event)
+	}
+
+	m.AddCloser("database", func() error {
+		record("database closed")
+		return nil
+	})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	m.AddGRPCServer("gRPC", grpc.NewServer(), lis)
+
+	m.AddHTTPServer("REST", &http.Server{Addr: "127.0.0.1:0", ReadHeaderTimeout: time.Second})
+
+	// Record each server's shutdown
+	for i, s := range m.servers {
+		m.servers[i].shutdown = func(ctx context.Context) error {
+			err := s.shutdown(ctx)
+			record(s.name + " stopped")
+
+			return err
+		}
+	}
+
+	m.OnStopping(func() { record("stopping") })
+
+	started := make(chan struct{})
+
+	m.AddWorker(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		record("worker stopped")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() { done <- m.Run(ctx) }()
+
+	<-started
+	assert.Eventually(t, m.Ready, time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	assert.False(t, m.Ready())
+	assert.Equal(t, []string{"stopping", "REST stopped", "gRPC stopped", "worker stopped", "database closed"}, events)
+}
+
+func TestManager_SecondSignalEndsDrain(t *testing.T) {
+	m := New(&config.LifecycleConfig{DrainDelay: time.Minute, ShutdownTimeout: time.Minute})
+
+	closed := false
+	m.AddCloser("database", func() error {
+		closed = true
+		return nil
+	})
+
+	done := make(chan error)
+
+	go func() { done <- m.Run(context.Background()) }()
+
+	require.Eventually(t, m.Ready, time.Second, 10*time.Millisecond)
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGTERM))
+	require.Eventually(t, func() bool { return !m.Ready() }, time.Second, 10*time.Millisecond)
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGTERM))
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("a second SIGTERM did not end the drain")
+	}
+
+	assert.True(t, closed)
+}
+
+func TestManager_ReturnsServerFailure(t *testing.T) {
+	m := New(&config.LifecycleConfig{ShutdownTimeout: time.Second})
+
+	closed := false
+	m.AddCloser("database", func() error {
+		closed = true
+		return nil
+	})
+
+	m.AddHTTPServer("REST", &http.Server{Addr: "127.0.0.1:-1", ReadHeaderTimeout: time.Second})
+
+	err := m.Run(context.Background())
+	require.Error(t, err)
+	assert.True(t, closed)
+}
@@ -8,14 +8,34 @@ import (
 	"net/http"
 	"time"
 
+	"sourcestream/backend/auth"
+	"sourcestream/backend/authz"
 	"sourcestream/backend/config"
+	"sourcestream/backend/health"
+	"sourcestream/backend/lifecycle"
+	"sourcestream/backend/migrations"
 	pb "sourcestream/backend/pb"
+	"sourcestream/backend/provider"
+	"sourcestream/backend/repository"
+	"sourcestream/backend/rpcerror"
+	"sourcestream/backend/scim"
 	"sourcestream/backend/services"
+	"sourcestream/backend/validation"
+	"sourcestream/backend/webhooks"
+	"sourcestream/backend/workers"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
+// grpcEndpoint is the address the REST gateway reaches the gRPC server at.
+const grpcEndpoint = "localhost:50051"
+
+// openAPIPath serves the OpenAPI document describing the REST gateway.
+const openAPIPath = "/swagger/user_service.swagger.json"
+
 func main() {
 	// Initialize database connection
 	db, err := config.NewDatabase()
@@ -23,12 +43,26 @@ func main() {
 		log.Fatalf("failed to connect to database: %v", err)
 	}
 
-	defer func() { _ = db.Close() }()
+	// Initialize Git hosting provider
+	gitProvider, err := provider.New(config.NewProviderConfig())
+	if err != nil {
+		log.Fatalf("failed to configure git provider: %v", err)
+	}
+
+	// Verify GitHub usernames through the OAuth device flow when configured
+	accountVerifier, err := provider.NewAccountVerifier(config.NewGithubVerificationConfig())
+	if err != nil {
+		log.Fatalf("failed to configure GitHub verification: %v", err)
+	}
+
+	if accountVerifier == nil {
+		log.Printf("GITHUB_VERIFICATION is not set; GitHub usernames are not verified")
+	}
 
-	// Create service instances with database
-	userService := services.NewUserService(db)
-	projectService := services.NewProjectService(db)
-	requestService := services.NewRequestService(db)
+	// Create service instances with database and git provider
+	userService := services.NewUserService(db, gitProvider, accountVerifier)
+	projectService := services.NewProjectService(db, gitProvider)
+	requestService := services.NewRequestService(db, gitProvider, accountVerifier != nil)
 
 	// Start gRPC server
 	// #nosec G102 -- binding to all interfaces is expected in container/K8s environments
@@ -37,53 +71,131 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	// Errors are converted to status codes first, so that cancelled and timed out calls report
+	// Canceled or DeadlineExceeded wherever they fail
+	unaryInterceptors := []grpc.UnaryServerInterceptor{rpcerror.UnaryInterceptor()}
+	streamInterceptors := []grpc.StreamServerInterceptor{rpcerror.StreamInterceptor()}
+
+	// Authenticate callers with bearer JWTs and API tokens when an issuer is configured
+	authConfig := config.NewAuthConfig()
+	if authConfig.Enabled() {
+		authenticator, err := auth.NewAuthenticator(authConfig, repository.NewUserRepository(db), repository.NewAPITokenRepository(db))
+		if err != nil {
+			log.Fatalf("failed to configure authentication: %v", err)
+		}
+
+		unaryInterceptors = append(unaryInterceptors, authenticator.UnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, authenticator.StreamInterceptor())
+	} else {
+		log.Printf("WARNING: AUTH_ISSUER is not set; authentication and authorization are disabled and caller-supplied user IDs are trusted")
+
+		// Service accounts and API tokens are refused rather than silently ignored
+		unaryInterceptors = append(unaryInterceptors, auth.DisabledInterceptor())
+	}
+
+	// Requests are validated before authorization, so that policy lookups only see well-formed IDs
+	unaryInterceptors = append(unaryInterceptors, validation.DefaultRules().UnaryInterceptor())
+	if authConfig.Enabled() {
+		unaryInterceptors = append(unaryInterceptors, authz.NewAuthorizer(db).UnaryInterceptor())
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 
 	// Register all services
 	pb.RegisterUserServiceServer(grpcServer, userService)
 	pb.RegisterProjectServiceServer(grpcServer, projectService)
 	pb.RegisterRequestServiceServer(grpcServer, requestService)
 
-	log.Printf("gRPC server listening at %v", lis.Addr())
+	// The lifecycle manager runs the servers and workers and shuts them down in order on SIGTERM
+	app := lifecycle.New(config.NewLifecycleConfig())
+	app.AddCloser("database", db.Close)
+	app.AddGRPCServer("gRPC", grpcServer, lis)
 
-	// Start gRPC server in goroutine
-	go func() {
-		if err := grpcServer.Serve(lis); err != nil {
-			log.Fatalf("failed to serve gRPC: %v", err)
-		}
-	}()
+	log.Printf("gRPC server listening at %v", lis.Addr())
 
-	// Start gRPC Gateway (REST) server
-	// Create cancellable context (reserved for future graceful shutdown)
-	_, cancel := context.WithCancel(context.Background())
+	// Background workers
+	recertificationWorker := workers.NewRecertificationWorker(db, config.NewRecertificationConfig())
+	app.AddWorker(recertificationWorker.Run)
+
+	pullRequestWorker := workers.NewPullRequestStatusWorker(db, gitProvider, config.NewPullRequestPollConfig())
+	app.AddWorker(pullRequestWorker.Run)
+
+	// Health checks back the /healthz and /readyz probes and the gRPC health service. Every
+	// service needs the database; the workers only affect the service whose data they maintain
+	checker := health.New(app.Ready,
+		pb.UserService_ServiceDesc.ServiceName,
+		pb.ProjectService_ServiceDesc.ServiceName,
+		pb.RequestService_ServiceDesc.ServiceName,
+	)
+	checker.Add("database", health.Database(db))
+	checker.Add("migrations", health.Migrations(db, migrations.Version()))
+	checker.Add("worker:recertification", health.Worker(recertificationWorker), pb.ProjectService_ServiceDesc.ServiceName)
+	checker.Add("worker:pull_request_status", health.Worker(pullRequestWorker), pb.RequestService_ServiceDesc.ServiceName)
+
+	healthpb.RegisterHealthServer(grpcServer, checker.GRPCServer())
+	app.AddWorker(checker.Run)
+	app.OnStopping(checker.Shutdown)
+
+	// The gateway's connections to the gRPC server are closed when main returns
+	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	// Create gRPC-Gateway mux
+	// Start gRPC Gateway (REST) server. It proxies to the gRPC server over the loopback interface,
+	// so REST calls go through the same authentication and authorization interceptors
 	mux := runtime.NewServeMux()
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	for _, register := range []func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error{
+		pb.RegisterUserServiceHandlerFromEndpoint,
+		pb.RegisterProjectServiceHandlerFromEndpoint,
+		pb.RegisterRequestServiceHandlerFromEndpoint,
+	} {
+		if err := register(ctx, mux, grpcEndpoint, dialOpts); err != nil {
+			log.Fatalf("failed to register gRPC-Gateway handler: %v", err)
+		}
+	}
 
-	// For now, skip gRPC-Gateway registration until protobuf files are updated
-	// The gRPC server will still work directly
+	// Serve the probes, inbound webhooks, SCIM provisioning and the OpenAPI document alongside the gateway
+	httpMux := http.NewServeMux()
+	httpMux.HandleFunc("GET /healthz", checker.Healthz)
+	httpMux.HandleFunc("GET /readyz", checker.Readyz)
+	httpMux.HandleFunc("GET "+openAPIPath, serveOpenAPI)
+	httpMux.Handle(webhooks.PathPrefix, webhooks.NewReceiver(db, config.NewWebhookConfig()))
+	httpMux.Handle(scim.PathPrefix, scim.NewServer(db, config.NewSCIMConfig()))
+	httpMux.Handle("/", mux)
 
-	// Create HTTP server with CORS support
+	// Create HTTP server with CORS support. It is stopped before the gRPC server it proxies to
 	httpServer := &http.Server{
 		Addr:         ":8080",
-		Handler:      corsHandler(mux),
+		Handler:      corsHandler(httpMux),
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 15 * time.Second,
 	}
+	app.AddHTTPServer("REST", httpServer)
 
 	log.Printf("REST server listening on :8080")
 
-	if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-		log.Fatalf("failed to serve REST: %v", err)
+	if err := app.Run(ctx); err != nil {
+		log.Fatalf("server failed: %v", err)
 	}
+
+	log.Printf("shutdown complete")
+}
+
+// serveOpenAPI writes the REST gateway's OpenAPI document.
+func serveOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(pb.OpenAPIDocument)
 }
 
 // corsHandler adds CORS headers to support frontend requests
 func corsHandler(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
 		if r.Method == "OPTIONS" {
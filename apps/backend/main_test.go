@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	pb "sourcestream/backend/pb"
 	"sourcestream/backend/config"
+	"sourcestream/backend/provider"
 	"sourcestream/backend/services"
 )
 
@@ -23,7 +24,7 @@ func TestGRPCIntegration(t *testing.T) {
 	defer db.Close()
 
 	// Create service instance
-	userService := services.NewUserService(db)
+	userService := services.NewUserService(db, provider.NewFake(), nil)
 
 	lis, err := net.Listen("tcp", ":0") // Use ephemeral port
 	assert.NoError(t, err)
@@ -46,7 +47,12 @@ func TestGRPCIntegration(t *testing.T) {
 	c := pb.NewUserServiceClient(conn)
 
 	// Test RegisterContributor
-	regReq := &pb.RegisterContributorRequest{CorporateId: "grpc_test_corp", GithubUsername: "grpc_test_user"}
+	regReq := &pb.RegisterContributorRequest{
+		CorporateId:    "grpc_test_corp",
+		GithubUsername: "grpc_test_user",
+		Email:          "grpc_test_corp@example.com",
+		FullName:       "gRPC Test User",
+	}
 	regRes, err := c.RegisterContributor(context.Background(), regReq)
 	assert.NoError(t, err)
 	assert.NotNil(t, regRes)
@@ -19,20 +19,9 @@ CREATE INDEX IF NOT EXISTS idx_users_department
   WHERE department IS NOT NULL;
 
 -- Projects table indexes
--- Index on repository_url for project lookups
-CREATE INDEX IF NOT EXISTS idx_projects_repository_url
-  ON projects(repository_url)
-  WHERE repository_url IS NOT NULL;
-
--- Index on is_active for filtering active projects
-CREATE INDEX IF NOT EXISTS idx_projects_is_active
-  ON projects(is_active)
-  WHERE is_active = TRUE;
-
--- Index on maintainer_contact for contacting maintainers
-CREATE INDEX IF NOT EXISTS idx_projects_maintainer_contact
-  ON projects(maintainer_contact)
-  WHERE maintainer_contact IS NOT NULL;
+-- Index on url for project lookups by repository
+CREATE INDEX IF NOT EXISTS idx_projects_url
+  ON projects(url);
 
 -- Project contributors table indexes
 -- Composite index for efficient contributor lookups
@@ -47,10 +36,9 @@ CREATE INDEX IF NOT EXISTS idx_project_contributors_project_id
 CREATE INDEX IF NOT EXISTS idx_project_contributors_user_id
   ON project_contributors(user_id);
 
--- Index on approved_date for time-range queries
-CREATE INDEX IF NOT EXISTS idx_project_contributors_approved_date
-  ON project_contributors(approved_date)
-  WHERE approved_date IS NOT NULL;
+-- Index on joined_at for time-range queries
+CREATE INDEX IF NOT EXISTS idx_project_contributors_joined_at
+  ON project_contributors(joined_at);
 
 -- Requests table indexes
 -- Index on status for filtering by request state
@@ -58,17 +46,17 @@ CREATE INDEX IF NOT EXISTS idx_requests_status
   ON requests(status)
   WHERE status != 'completed';
 
--- Index on user_id for finding user's requests
-CREATE INDEX IF NOT EXISTS idx_requests_user_id
-  ON requests(user_id);
+-- Index on requester_id for finding user's requests
+CREATE INDEX IF NOT EXISTS idx_requests_requester_id
+  ON requests(requester_id);
 
 -- Index on project_id for finding project's requests
 CREATE INDEX IF NOT EXISTS idx_requests_project_id
   ON requests(project_id);
 
 -- Composite index for efficient request filtering
-CREATE INDEX IF NOT EXISTS idx_requests_user_status
-  ON requests(user_id, status);
+CREATE INDEX IF NOT EXISTS idx_requests_requester_status
+  ON requests(requester_id, status);
 
 -- Index on created_at for time-series queries and sorting
 CREATE INDEX IF NOT EXISTS idx_requests_created_at
@@ -91,19 +79,7 @@ CREATE INDEX IF NOT EXISTS idx_request_comments_user_id
 CREATE INDEX IF NOT EXISTS idx_request_comments_created_at
   ON request_comments(created_at DESC);
 
--- Approved projects table indexes (if exists)
--- Index on user_id for finding user's approved projects
-CREATE INDEX IF NOT EXISTS idx_approved_projects_user_id
-  ON approved_projects(user_id);
-
--- Index on project_id for finding project's approvers
-CREATE INDEX IF NOT EXISTS idx_approved_projects_project_id
-  ON approved_projects(project_id);
-
--- Composite index for efficient approval lookup
-CREATE INDEX IF NOT EXISTS idx_approved_projects_user_project
-  ON approved_projects(user_id, project_id);
-
+-- Approved projects table indexes
 -- Index on approval_date for audit trails
 CREATE INDEX IF NOT EXISTS idx_approved_projects_approval_date
   ON approved_projects(approval_date DESC);
@@ -0,0 +1,61 @@
+-- Migration 005: Periodic re-certification of approved projects
+-- Approved projects carry a review interval and next-review date so that
+-- OSPO admins revisit license and maintenance status on a schedule.
+-- Entries whose review lapses are deactivated automatically.
+
+-- Review schedule columns on the catalog
+ALTER TABLE approved_projects ADD COLUMN review_interval_days INTEGER NOT NULL DEFAULT 365 CHECK (review_interval_days > 0);
+ALTER TABLE approved_projects ADD COLUMN next_review_date TIMESTAMP WITH TIME ZONE;
+ALTER TABLE approved_projects ADD COLUMN last_reviewed_at TIMESTAMP WITH TIME ZONE;
+
+-- Existing entries are due one interval after their original approval
+UPDATE approved_projects
+SET next_review_date = approval_date + (review_interval_days * INTERVAL '1 day')
+WHERE next_review_date IS NULL;
+
+ALTER TABLE approved_projects ALTER COLUMN next_review_date SET NOT NULL;
+ALTER TABLE approved_projects ALTER COLUMN next_review_date SET DEFAULT CURRENT_TIMESTAMP + INTERVAL '365 days';
+
+CREATE INDEX idx_approved_projects_next_review_date ON approved_projects(next_review_date) WHERE is_active = true;
+
+-- Re-certification tasks opened for OSPO admins
+CREATE TABLE recertification_tasks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    approved_project_id UUID NOT NULL REFERENCES approved_projects(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'recertified', 'retired', 'lapsed')),
+    due_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    reviewer_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    notes TEXT,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- At most one open task per approved project
+CREATE UNIQUE INDEX idx_recertification_tasks_open_project
+  ON recertification_tasks(approved_project_id)
+  WHERE status = 'open';
+
+CREATE INDEX idx_recertification_tasks_status ON recertification_tasks(status);
+CREATE INDEX idx_recertification_tasks_due_date ON recertification_tasks(due_date);
+
+CREATE TRIGGER update_recertification_tasks_updated_at BEFORE UPDATE ON recertification_tasks
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Flags raised against requests that need OSPO attention
+-- (e.g. an approved contribution permission whose catalog entry lapsed)
+CREATE TABLE request_flags (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    request_id UUID NOT NULL REFERENCES requests(id) ON DELETE CASCADE,
+    flag_type VARCHAR(50) NOT NULL,
+    reason TEXT,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- At most one unresolved flag of each type per request
+CREATE UNIQUE INDEX idx_request_flags_open_type
+  ON request_flags(request_id, flag_type)
+  WHERE resolved_at IS NULL;
+
+CREATE INDEX idx_request_flags_request_id ON request_flags(request_id);
@@ -0,0 +1,27 @@
+-- Migration 006: Corporate contribution ledger
+-- Records each upstream pull request or commit made under an approved request,
+-- linked to the approving request and the approved project, for OSPO reporting.
+
+CREATE TABLE contributions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    request_id UUID NOT NULL REFERENCES requests(id) ON DELETE CASCADE,
+    approved_project_id UUID REFERENCES approved_projects(id) ON DELETE SET NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('pull_request', 'commit')),
+    url VARCHAR(500) NOT NULL UNIQUE,
+    title VARCHAR(255),
+    outcome VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (outcome IN ('open', 'merged', 'closed')),
+    contributed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    source VARCHAR(20) NOT NULL DEFAULT 'rpc' CHECK (source IN ('rpc', 'import')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_contributions_request_id ON contributions(request_id);
+CREATE INDEX idx_contributions_approved_project_id ON contributions(approved_project_id);
+CREATE INDEX idx_contributions_user_id ON contributions(user_id);
+CREATE INDEX idx_contributions_contributed_at ON contributions(contributed_at DESC);
+
+CREATE TRIGGER update_contributions_updated_at BEFORE UPDATE ON contributions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
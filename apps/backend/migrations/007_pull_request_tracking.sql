@@ -0,0 +1,36 @@
+-- Migration 007: Upstream pull request status tracking
+-- Pull request approval requests store the upstream PR URL in project_url.
+-- A poller checks the PR on the hosting provider and records state changes here.
+
+-- Latest observed upstream state per pull request approval request
+CREATE TABLE pull_request_tracking (
+    request_id UUID PRIMARY KEY REFERENCES requests(id) ON DELETE CASCADE,
+    provider VARCHAR(20) NOT NULL,
+    owner VARCHAR(255) NOT NULL DEFAULT '',
+    repo VARCHAR(255) NOT NULL DEFAULT '',
+    number INTEGER NOT NULL DEFAULT 0,
+    state VARCHAR(20) NOT NULL CHECK (state IN ('open', 'merged', 'closed', 'invalid')),
+    head_sha VARCHAR(64),
+    upstream_updated_at TIMESTAMP WITH TIME ZONE,
+    last_error TEXT,
+    last_checked_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_pull_request_tracking_state ON pull_request_tracking(state);
+
+CREATE TRIGGER update_pull_request_tracking_updated_at BEFORE UPDATE ON pull_request_tracking
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- History of upstream pull request events recorded against a request
+CREATE TABLE pull_request_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    request_id UUID NOT NULL REFERENCES requests(id) ON DELETE CASCADE,
+    event_type VARCHAR(20) NOT NULL CHECK (event_type IN ('opened', 'updated', 'merged', 'closed')),
+    head_sha VARCHAR(64),
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_pull_request_events_request_id ON pull_request_events(request_id, occurred_at);
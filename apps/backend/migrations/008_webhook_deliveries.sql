@@ -0,0 +1,24 @@
+-- Migration 008: Inbound hosting provider webhook deliveries
+-- Each delivery is recorded by its provider delivery ID so that redeliveries
+-- are processed at most once. Failed deliveries may be retried by the provider.
+
+CREATE TABLE webhook_deliveries (
+    source VARCHAR(50) NOT NULL,
+    delivery_id VARCHAR(100) NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'processing' CHECK (status IN ('processing', 'processed', 'failed', 'ignored')),
+    attempts INTEGER NOT NULL DEFAULT 1,
+    last_error TEXT,
+    received_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    processed_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (source, delivery_id)
+);
+
+CREATE INDEX idx_webhook_deliveries_received_at ON webhook_deliveries(received_at DESC);
+
+-- Webhook handlers look up internal projects by repository URL
+CREATE INDEX IF NOT EXISTS idx_projects_url ON projects(url);
+
+-- Contributions can be recorded from pull request webhooks
+ALTER TABLE contributions DROP CONSTRAINT IF EXISTS contributions_source_check;
+ALTER TABLE contributions ADD CONSTRAINT contributions_source_check CHECK (source IN ('rpc', 'import', 'webhook'));
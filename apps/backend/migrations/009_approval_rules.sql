@@ -0,0 +1,44 @@
+-- Migration 009: Attribute-based approval rules
+-- Rules are CEL expressions evaluated against a submitted request and the user, project and
+-- approved project it concerns. Every change to a rule's logic creates a new version so that
+-- past matches can be traced to the exact expression that produced them.
+
+CREATE TABLE approval_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+    description TEXT,
+    current_version INTEGER NOT NULL DEFAULT 1,
+    is_enabled BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_approval_rules_updated_at BEFORE UPDATE ON approval_rules
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE approval_rule_versions (
+    rule_id UUID NOT NULL REFERENCES approval_rules(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    request_type VARCHAR(50), -- NULL applies the rule to every request type
+    expression TEXT NOT NULL,
+    action VARCHAR(30) NOT NULL CHECK (action IN ('require_review', 'route')),
+    target VARCHAR(100) NOT NULL, -- reviewer group or team, e.g. security, legal
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (rule_id, version)
+);
+
+-- Rules that matched a request when it was submitted
+CREATE TABLE request_rule_matches (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    request_id UUID NOT NULL REFERENCES requests(id) ON DELETE CASCADE,
+    rule_id UUID NOT NULL REFERENCES approval_rules(id) ON DELETE CASCADE,
+    rule_version INTEGER NOT NULL,
+    action VARCHAR(30) NOT NULL,
+    target VARCHAR(100) NOT NULL,
+    matched_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (request_id, rule_id)
+);
+
+CREATE INDEX idx_request_rule_matches_request_id ON request_rule_matches(request_id);
+CREATE INDEX idx_request_rule_matches_target ON request_rule_matches(action, target);
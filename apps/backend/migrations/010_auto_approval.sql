@@ -0,0 +1,18 @@
+-- Migration 010: Auto-approval of low-risk contribution permission requests
+-- An approval rule with the auto_approve action approves a matching contribution permission
+-- request at submission time. The rule that approved it is recorded on the request as the
+-- decision reason.
+
+ALTER TABLE approval_rule_versions DROP CONSTRAINT approval_rule_versions_action_check;
+ALTER TABLE approval_rule_versions ADD CONSTRAINT approval_rule_versions_action_check
+    CHECK (action IN ('require_review', 'route', 'auto_approve'));
+
+-- The kind of work a contribution permission covers, one of the approved project's
+-- allowed_contribution_types (e.g. documentation, bug-fix)
+ALTER TABLE requests ADD COLUMN contribution_kind VARCHAR(30);
+ALTER TABLE requests ADD COLUMN decision_reason TEXT;
+ALTER TABLE requests ADD COLUMN auto_approved_rule_id UUID REFERENCES approval_rules(id) ON DELETE SET NULL;
+ALTER TABLE requests ADD COLUMN auto_approved_rule_version INTEGER;
+
+CREATE INDEX idx_requests_auto_approved ON requests(approved_at DESC)
+    WHERE auto_approved_rule_id IS NOT NULL;
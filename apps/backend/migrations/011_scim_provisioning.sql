@@ -0,0 +1,28 @@
+-- Migration 011: SCIM 2.0 provisioning
+-- Users are provisioned by the corporate identity provider. A provisioned user has no GitHub
+-- username until they link one, so github_username becomes optional; the unique constraint
+-- still applies to the usernames that are set.
+
+ALTER TABLE users ALTER COLUMN github_username DROP NOT NULL;
+ALTER TABLE users ADD COLUMN external_id VARCHAR(255) UNIQUE; -- the identity provider's ID for the user
+
+-- Groups pushed by the identity provider
+CREATE TABLE scim_groups (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    display_name VARCHAR(255) NOT NULL UNIQUE,
+    external_id VARCHAR(255) UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_scim_groups_updated_at BEFORE UPDATE ON scim_groups
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE scim_group_members (
+    group_id UUID NOT NULL REFERENCES scim_groups(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    added_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX idx_scim_group_members_user_id ON scim_group_members(user_id);
@@ -0,0 +1,32 @@
+-- Migration 012: Offboarding
+-- Deleting a user used to cascade to the projects they owned and the requests they made.
+-- Users who leave are now offboarded instead: they are deactivated, their projects are transferred
+-- to a successor and their access, pending requests and reviews are cleaned up.
+
+ALTER TABLE projects DROP CONSTRAINT projects_owner_id_fkey;
+ALTER TABLE projects ADD CONSTRAINT projects_owner_id_fkey
+    FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE RESTRICT;
+
+ALTER TABLE requests DROP CONSTRAINT requests_requester_id_fkey;
+ALTER TABLE requests ADD CONSTRAINT requests_requester_id_fkey
+    FOREIGN KEY (requester_id) REFERENCES users(id) ON DELETE RESTRICT;
+
+-- The default successor for offboarded members of a department
+CREATE TABLE department_leads (
+    department VARCHAR(100) PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE offboardings (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    successor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    initiated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    source VARCHAR(20) NOT NULL CHECK (source IN ('admin', 'scim')),
+    reason TEXT,
+    report JSONB NOT NULL, -- what the offboarding changed, see models.OffboardingReport
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_offboardings_user_id ON offboardings(user_id, created_at DESC);
@@ -0,0 +1,57 @@
+-- Migration 013: Teams, departments and managers
+-- Departments and teams become first-class, with team membership and a manager per user, so that
+-- approval rules can route to the requester's manager or their department's OSPO champion and
+-- reports can aggregate by team. users.department keeps holding the department name.
+
+CREATE TABLE departments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+    lead_id UUID REFERENCES users(id) ON DELETE SET NULL, -- default successor when members are offboarded
+    ospo_champion_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_departments_updated_at BEFORE UPDATE ON departments
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO departments (name)
+SELECT DISTINCT department FROM users WHERE department IS NOT NULL AND department <> ''
+UNION
+SELECT department FROM department_leads;
+
+-- Department leads move onto departments
+UPDATE departments d SET lead_id = dl.user_id
+FROM department_leads dl WHERE dl.department = d.name;
+
+DROP TABLE department_leads;
+
+CREATE TABLE teams (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+    department_id UUID REFERENCES departments(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_teams_department_id ON teams(department_id);
+
+CREATE TRIGGER update_teams_updated_at BEFORE UPDATE ON teams
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE team_members (
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX idx_team_members_user_id ON team_members(user_id);
+
+ALTER TABLE users ADD COLUMN manager_id UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE users ADD CONSTRAINT users_manager_not_self CHECK (manager_id <> id);
+
+CREATE INDEX idx_users_manager_id ON users(manager_id);
+
+-- The user a rule targeting requester_manager or department_ospo_champion resolved to
+ALTER TABLE request_rule_matches ADD COLUMN assignee_id UUID REFERENCES users(id) ON DELETE SET NULL;
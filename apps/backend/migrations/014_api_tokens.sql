@@ -0,0 +1,21 @@
+-- Migration 014: Service accounts and API tokens
+-- CI pipelines and bots act as service-account users and authenticate with API tokens instead of
+-- an OIDC login. Only the SHA-256 hash of a token is stored; the secret is shown once at creation.
+
+ALTER TABLE users ADD COLUMN is_service_account BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE api_tokens (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(20) NOT NULL, -- the start of the secret, to recognise it in listings
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_api_tokens_user_id ON api_tokens(user_id, created_at DESC);
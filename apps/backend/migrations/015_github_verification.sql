@@ -0,0 +1,23 @@
+-- Migration 015: GitHub account verification
+-- users.github_username is whatever a user typed at registration. Users now prove they own the
+-- account through the OAuth device flow; github_verified_at records when, and is cleared when the
+-- username changes.
+
+ALTER TABLE users ADD COLUMN github_verified_at TIMESTAMP WITH TIME ZONE;
+
+CREATE TABLE github_verifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    github_username VARCHAR(39) NOT NULL, -- the username being verified
+    device_code TEXT NOT NULL,
+    user_code VARCHAR(20) NOT NULL,
+    verification_uri TEXT NOT NULL,
+    interval_seconds INTEGER NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'verified', 'failed', 'expired')),
+    failure_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_github_verifications_user_id ON github_verifications(user_id, created_at DESC);
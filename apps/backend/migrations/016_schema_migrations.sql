@@ -0,0 +1,15 @@
+-- Migration 016: schema version tracking
+-- Records which migrations have been applied so the backend can refuse readiness while the
+-- schema is behind the version it was built for. Every migration from this one on ends by
+-- recording its own version.
+
+CREATE TABLE schema_migrations (
+    version INTEGER PRIMARY KEY,
+    applied_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- This migration is applied after all of the earlier ones
+INSERT INTO schema_migrations (version)
+SELECT generate_series(1, 15);
+
+INSERT INTO schema_migrations (version) VALUES (16);
@@ -0,0 +1,7 @@
+-- Migration 017: pull requests tracked before their first successful check
+-- A poll that fails before the upstream pull request was ever seen records only its error,
+-- leaving state NULL so the first successful check still records the opened event.
+
+ALTER TABLE pull_request_tracking ALTER COLUMN state DROP NOT NULL;
+
+INSERT INTO schema_migrations (version) VALUES (17);
@@ -0,0 +1,17 @@
+-- Migration 018: approved projects catalog ordering
+-- The catalog is paged by keyset on (name, id) or (approval_date, id). A NULL approval_date
+-- would fall outside the keyset comparison, so the column becomes NOT NULL.
+
+UPDATE approved_projects
+SET approval_date = COALESCE(created_at, CURRENT_TIMESTAMP)
+WHERE approval_date IS NULL;
+
+ALTER TABLE approved_projects ALTER COLUMN approval_date SET NOT NULL;
+
+CREATE INDEX idx_approved_projects_name_id ON approved_projects(name, id);
+CREATE INDEX idx_approved_projects_approval_date_id ON approved_projects(approval_date, id);
+
+-- Superseded by idx_approved_projects_approval_date_id, which serves both directions
+DROP INDEX IF EXISTS idx_approved_projects_approval_date;
+
+INSERT INTO schema_migrations (version) VALUES (18);
@@ -0,0 +1,15 @@
+-- Migration 019: clear GitHub verification device codes once verification completes
+-- A device code is a bearer credential for the pending device flow, and the service must send it
+-- back to GitHub while polling, so it cannot be stored as a hash. It is cleared instead when the
+-- verification completes; pending rows past expires_at keep a code GitHub no longer accepts.
+
+ALTER TABLE github_verifications ALTER COLUMN device_code DROP NOT NULL;
+
+UPDATE github_verifications
+SET device_code = NULL
+WHERE status <> 'pending';
+
+ALTER TABLE github_verifications ADD CONSTRAINT github_verifications_device_code_pending
+    CHECK (status = 'pending' OR device_code IS NULL);
+
+INSERT INTO schema_migrations (version) VALUES (19);
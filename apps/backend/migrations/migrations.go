@@ -0,0 +1,44 @@
+// Package migrations holds the SQL schema migrations and reports the schema version this build
+// of the backend expects. Migrations are applied in file name order; from 016 on, each records
+// its version in the schema_migrations table.
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// Version returns the highest migration version, the schema version the backend expects.
+func Version() int {
+	names, _ := fs.Glob(files, "*.sql")
+
+	latest := 0
+
+	for _, name := range names {
+		if v, ok := fileVersion(name); ok && v > latest {
+			latest = v
+		}
+	}
+
+	return latest
+}
+
+// fileVersion parses the version prefix of a migration file name such as 016_schema_migrations.sql.
+func fileVersion(name string) (int, bool) {
+	prefix, _, ok := strings.Cut(name, "_")
+	if !ok {
+		return 0, false
+	}
+
+	v, err := strconv.Atoi(prefix)
+	if err != nil {
+		return 0, false
+	}
+
+	return v, true
+}
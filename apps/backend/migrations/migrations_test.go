@@ -0,0 +1,168 @@
+package migrations
+
+import (
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion(t *testing.T) {
+	assert.GreaterOrEqual(t, Version(), 16)
+}
+
+func TestMigrations_RecordTheirVersion(t *testing.T) {
+	names, err := fs.Glob(files, "*.sql")
+	require.NoError(t, err)
+
+	for _, name := range names {
+		v, ok := fileVersion(name)
+		require.True(t, ok, "%s has no version prefix", name)
+
+		if v < 16 {
+			continue
+		}
+
+		content, err := fs.ReadFile(files, name)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), fmt.Sprintf("INSERT INTO schema_migrations (version) VALUES (%d);", v), name)
+	}
+}
+
+// Statements that shape the schema, for TestMigrations_IndexExistingColumns. Table bodies end with
+// a closing parenthesis on its own line.
+var (
+	sqlComment    = regexp.MustCompile(`--[^\n]*`)
+	sqlString     = regexp.MustCompile(`'[^']*'`)
+	sqlIdentifier = regexp.MustCompile(`\b[a-z_][a-z0-9_]*\b`)
+	createTable   = regexp.MustCompile(`(?is)CREATE TABLE (?:IF NOT EXISTS )?(\w+)\s*\((.*?)\n\);`)
+	dropTable     = regexp.MustCompile(`(?i)DROP TABLE (?:IF EXISTS )?(\w+)`)
+	alterColumn   = regexp.MustCompile(`(?i)ALTER TABLE (\w+) (ADD|DROP) COLUMN (?:IF (?:NOT )?EXISTS )?(\w+)`)
+	createIndex   = regexp.MustCompile(`(?is)CREATE (?:UNIQUE )?INDEX (?:IF NOT EXISTS )?\w+\s+ON (\w+)\s*\(([^;]*?)\)\s*(?:WHERE ([^;]*))?;`)
+)
+
+// sqlKeywords may appear in partial index predicates.
+var sqlKeywords = map[string]bool{"is": true, "not": true, "null": true, "true": true, "false": true, "and": true, "or": true, "in": true}
+
+// splitTopLevel splits s at the commas outside parentheses.
+func splitTopLevel(s string) []string {
+	var parts []string
+
+	depth, start := 0, 0
+
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+// TestMigrations_IndexExistingColumns replays the tables and columns the migrations create, in
+// order, and checks that every index names a table and columns that exist by then, since
+// setup_db.sh stops at the first failing migration.
+func TestMigrations_IndexExistingColumns(t *testing.T) {
+	names, err := fs.Glob(files, "*.sql")
+	require.NoError(t, err)
+
+	tables := map[string]map[string]bool{}
+
+	for _, name := range names {
+		content, err := fs.ReadFile(files, name)
+		require.NoError(t, err)
+
+		sql := sqlComment.ReplaceAllString(string(content), "")
+
+		type statement struct {
+			pos   int
+			apply func()
+		}
+
+		var statements []statement
+
+		add := func(re *regexp.Regexp, apply func(m []string)) {
+			for _, loc := range re.FindAllStringSubmatchIndex(sql, -1) {
+				m := make([]string, len(loc)/2)
+				for i := range m {
+					if loc[2*i] >= 0 {
+						m[i] = sql[loc[2*i]:loc[2*i+1]]
+					}
+				}
+
+				statements = append(statements, statement{pos: loc[0], apply: func() { apply(m) }})
+			}
+		}
+
+		add(createTable, func(m []string) {
+			columns := map[string]bool{}
+
+			for _, item := range splitTopLevel(m[2]) {
+				fields := strings.Fields(item)
+				if len(fields) == 0 {
+					continue
+				}
+
+				switch strings.ToUpper(fields[0]) {
+				case "PRIMARY", "UNIQUE", "FOREIGN", "CHECK", "CONSTRAINT", "EXCLUDE":
+					continue
+				}
+
+				columns[strings.ToLower(fields[0])] = true
+			}
+
+			tables[strings.ToLower(m[1])] = columns
+		})
+		add(dropTable, func(m []string) { delete(tables, strings.ToLower(m[1])) })
+		add(alterColumn, func(m []string) {
+			columns, ok := tables[strings.ToLower(m[1])]
+			require.True(t, ok, "%s alters unknown table %s", name, m[1])
+			columns[strings.ToLower(m[3])] = strings.EqualFold(m[2], "ADD")
+		})
+		add(createIndex, func(m []string) {
+			table := strings.ToLower(m[1])
+			columns, ok := tables[table]
+			require.True(t, ok, "%s indexes unknown table %s", name, table)
+
+			var used []string
+
+			for _, item := range splitTopLevel(m[2]) {
+				if fields := strings.Fields(item); len(fields) > 0 && !strings.Contains(item, "(") {
+					used = append(used, strings.ToLower(fields[0]))
+				}
+			}
+
+			for _, word := range sqlIdentifier.FindAllString(strings.ToLower(sqlString.ReplaceAllString(m[3], "")), -1) {
+				if !sqlKeywords[word] {
+					used = append(used, word)
+				}
+			}
+
+			for _, column := range used {
+				assert.True(t, columns[column], "%s indexes unknown column %s.%s", name, table, column)
+			}
+		})
+
+		assert.Len(t, statements, len(regexp.MustCompile(`(?i)CREATE TABLE|DROP TABLE|ALTER TABLE \w+ (?:ADD|DROP) COLUMN|CREATE (?:UNIQUE )?INDEX`).FindAllString(sql, -1)),
+			"%s has statements the test cannot parse", name)
+
+		sort.Slice(statements, func(i, j int) bool { return statements[i].pos < statements[j].pos })
+
+		for _, s := range statements {
+			s.apply()
+		}
+	}
+}
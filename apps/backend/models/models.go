@@ -7,16 +7,22 @@ import (
 
 // User represents a user in the system
 type User struct {
-	ID             string    `json:"id" db:"id"`
-	CorporateID    string    `json:"corporate_id" db:"corporate_id"`
-	GithubUsername string    `json:"github_username" db:"github_username"`
-	Email          string    `json:"email" db:"email"`
-	FullName       string    `json:"full_name" db:"full_name"`
-	Department     string    `json:"department" db:"department"`
-	Role           string    `json:"role" db:"role"`
-	IsActive       bool      `json:"is_active" db:"is_active"`
-	CreatedAt      time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at" db:"updated_at"`
+	ID             string  `json:"id" db:"id"`
+	CorporateID    string  `json:"corporate_id" db:"corporate_id"`
+	GithubUsername string  `json:"github_username" db:"github_username"`
+	Email          string  `json:"email" db:"email"`
+	FullName       string  `json:"full_name" db:"full_name"`
+	Department     string  `json:"department" db:"department"`
+	Role           string  `json:"role" db:"role"`
+	IsActive       bool    `json:"is_active" db:"is_active"`
+	ExternalID     string  `json:"external_id" db:"external_id"`
+	ManagerID      *string `json:"manager_id" db:"manager_id"`
+	// IsServiceAccount marks users that automation acts as; they authenticate only with API tokens
+	IsServiceAccount bool `json:"is_service_account" db:"is_service_account"`
+	// GithubVerifiedAt is when the user proved they own GithubUsername, nil if they have not
+	GithubVerifiedAt *time.Time `json:"github_verified_at" db:"github_verified_at"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 // Project represents an open source project
@@ -51,6 +57,8 @@ type Request struct {
 	Role                  string     `json:"role" db:"requested_role"`
 	ApprovedProjectID     *string    `json:"approved_project_id" db:"approved_project_id"`
 	BusinessJustification *string    `json:"business_justification" db:"business_justification"`
+	ContributionKind      string     `json:"contribution_kind" db:"contribution_kind"`
+	DecisionReason        *string    `json:"decision_reason" db:"decision_reason"`
 	ApprovedAt            *time.Time `json:"approved_at" db:"approved_at"`
 	RejectedAt            *time.Time `json:"rejected_at" db:"rejected_at"`
 	RejectionReason       *string    `json:"rejection_reason" db:"rejection_reason"`
@@ -60,18 +68,21 @@ type Request struct {
 
 // ApprovedProject represents a pre-approved open source project for contributions
 type ApprovedProject struct {
-	ID                       string    `json:"id" db:"id"`
-	Name                     string    `json:"name" db:"name"`
-	Description              string    `json:"description" db:"description"`
-	RepositoryURL            string    `json:"repository_url" db:"repository_url"`
-	License                  string    `json:"license" db:"license"`
-	ContributionType         string    `json:"contribution_type" db:"contribution_type"`
-	MaintainerContact        string    `json:"maintainer_contact" db:"maintainer_contact"`
-	ApprovalDate             time.Time `json:"approval_date" db:"approval_date"`
-	IsActive                 bool      `json:"is_active" db:"is_active"`
-	AllowedContributionTypes []string  `json:"allowed_contribution_types" db:"allowed_contribution_types"`
-	CreatedAt                time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt                time.Time `json:"updated_at" db:"updated_at"`
+	ID                       string     `json:"id" db:"id"`
+	Name                     string     `json:"name" db:"name"`
+	Description              string     `json:"description" db:"description"`
+	RepositoryURL            string     `json:"repository_url" db:"repository_url"`
+	License                  string     `json:"license" db:"license"`
+	ContributionType         string     `json:"contribution_type" db:"contribution_type"`
+	MaintainerContact        string     `json:"maintainer_contact" db:"maintainer_contact"`
+	ApprovalDate             time.Time  `json:"approval_date" db:"approval_date"`
+	IsActive                 bool       `json:"is_active" db:"is_active"`
+	AllowedContributionTypes []string   `json:"allowed_contribution_types" db:"allowed_contribution_types"`
+	ReviewIntervalDays       int        `json:"review_interval_days" db:"review_interval_days"`
+	NextReviewDate           time.Time  `json:"next_review_date" db:"next_review_date"`
+	LastReviewedAt           *time.Time `json:"last_reviewed_at" db:"last_reviewed_at"`
+	CreatedAt                time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt                time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 // ProjectContributor represents the many-to-many relationship between users and projects
@@ -98,3 +109,254 @@ type RequestComment struct {
 	FullName       string    `json:"full_name" db:"full_name"`
 	CreatedAt      time.Time `json:"created_at" db:"created_at"`
 }
+
+// RecertificationTask represents a periodic review of an approved project assigned to OSPO admins
+type RecertificationTask struct {
+	ID                string     `json:"id" db:"id"`
+	ApprovedProjectID string     `json:"approved_project_id" db:"approved_project_id"`
+	ProjectName       string     `json:"project_name" db:"project_name"`
+	Status            string     `json:"status" db:"status"`
+	DueDate           time.Time  `json:"due_date" db:"due_date"`
+	ReviewerID        *string    `json:"reviewer_id" db:"reviewer_id"`
+	Notes             *string    `json:"notes" db:"notes"`
+	CompletedAt       *time.Time `json:"completed_at" db:"completed_at"`
+	CreatedAt         time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// RequestFlag represents an issue raised against a request that needs OSPO attention
+type RequestFlag struct {
+	ID         string     `json:"id" db:"id"`
+	RequestID  string     `json:"request_id" db:"request_id"`
+	FlagType   string     `json:"flag_type" db:"flag_type"`
+	Reason     *string    `json:"reason" db:"reason"`
+	ResolvedAt *time.Time `json:"resolved_at" db:"resolved_at"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+// Contribution represents an upstream pull request or commit recorded against an approved request
+type Contribution struct {
+	ID                string     `json:"id" db:"id"`
+	RequestID         string     `json:"request_id" db:"request_id"`
+	ApprovedProjectID *string    `json:"approved_project_id" db:"approved_project_id"`
+	UserID            string     `json:"user_id" db:"user_id"`
+	Kind              string     `json:"kind" db:"kind"`
+	URL               string     `json:"url" db:"url"`
+	Title             string     `json:"title" db:"title"`
+	Outcome           string     `json:"outcome" db:"outcome"`
+	ContributedAt     time.Time  `json:"contributed_at" db:"contributed_at"`
+	ResolvedAt        *time.Time `json:"resolved_at" db:"resolved_at"`
+	Source            string     `json:"source" db:"source"`
+	CreatedAt         time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// ContributionSummary aggregates ledger entries for an approved project over a reporting period
+type ContributionSummary struct {
+	ApprovedProjectID *string `json:"approved_project_id" db:"approved_project_id"`
+	ProjectName       string  `json:"project_name" db:"project_name"`
+	Total             int     `json:"total" db:"total"`
+	Merged            int     `json:"merged" db:"merged"`
+	Closed            int     `json:"closed" db:"closed"`
+	Open              int     `json:"open" db:"open"`
+	Contributors      int     `json:"contributors" db:"contributors"`
+}
+
+// TeamContributionSummary aggregates ledger entries by the contributor's team
+type TeamContributionSummary struct {
+	TeamID       *string `json:"team_id" db:"team_id"`
+	TeamName     string  `json:"team_name" db:"team_name"`
+	Total        int     `json:"total" db:"total"`
+	Merged       int     `json:"merged" db:"merged"`
+	Closed       int     `json:"closed" db:"closed"`
+	Open         int     `json:"open" db:"open"`
+	Contributors int     `json:"contributors" db:"contributors"`
+}
+
+// ContributorGrant is an approved contribution permission or access grant a user currently holds
+type ContributorGrant struct {
+	RequestID   string     `json:"request_id" db:"request_id"`
+	Type        string     `json:"type" db:"type"`
+	ProjectID   string     `json:"project_id" db:"project_id"`
+	ProjectName string     `json:"project_name" db:"project_name"`
+	Role        string     `json:"role" db:"role"`
+	ApprovedAt  *time.Time `json:"approved_at" db:"approved_at"`
+	ExpiresAt   *time.Time `json:"expires_at" db:"expires_at"`
+}
+
+// UserActivityStats counts a user's projects and active contribution permissions
+type UserActivityStats struct {
+	OwnedProjects                 int `json:"owned_projects" db:"owned_projects"`
+	ContributedProjects           int `json:"contributed_projects" db:"contributed_projects"`
+	ActiveContributionPermissions int `json:"active_contribution_permissions" db:"active_contribution_permissions"`
+}
+
+// TrackedPullRequest pairs a pull request approval request with its last observed upstream state
+type TrackedPullRequest struct {
+	RequestID         string     `json:"request_id" db:"request_id"`
+	PullRequestURL    string     `json:"pull_request_url" db:"project_url"`
+	RequestStatus     string     `json:"request_status" db:"status"`
+	ApprovedAt        *time.Time `json:"approved_at" db:"approved_at"`
+	Provider          string     `json:"provider" db:"provider"`
+	Owner             string     `json:"owner" db:"owner"`
+	Repo              string     `json:"repo" db:"repo"`
+	Number            int        `json:"number" db:"number"`
+	State             *string    `json:"state" db:"state"`
+	HeadSHA           *string    `json:"head_sha" db:"head_sha"`
+	UpstreamUpdatedAt *time.Time `json:"upstream_updated_at" db:"upstream_updated_at"`
+	LastError         *string    `json:"last_error" db:"last_error"`
+}
+
+// PullRequestEvent represents an upstream pull request state change recorded against a request
+type PullRequestEvent struct {
+	ID         string    `json:"id" db:"id"`
+	RequestID  string    `json:"request_id" db:"request_id"`
+	EventType  string    `json:"event_type" db:"event_type"`
+	HeadSHA    *string   `json:"head_sha" db:"head_sha"`
+	OccurredAt time.Time `json:"occurred_at" db:"occurred_at"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// ApprovalRule is a versioned CEL approval rule, shown at one of its versions
+type ApprovalRule struct {
+	ID          string    `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	Description string    `json:"description" db:"description"`
+	IsEnabled   bool      `json:"is_enabled" db:"is_enabled"`
+	Version     int       `json:"version" db:"version"`
+	RequestType string    `json:"request_type" db:"request_type"`
+	Expression  string    `json:"expression" db:"expression"`
+	Action      string    `json:"action" db:"action"`
+	Target      string    `json:"target" db:"target"`
+	CreatedBy   *string   `json:"created_by" db:"created_by"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// RequestRuleMatch records an approval rule that matched a request at submission
+type RequestRuleMatch struct {
+	ID          string    `json:"id" db:"id"`
+	RequestID   string    `json:"request_id" db:"request_id"`
+	RuleID      string    `json:"rule_id" db:"rule_id"`
+	RuleVersion int       `json:"rule_version" db:"rule_version"`
+	Action      string    `json:"action" db:"action"`
+	Target      string    `json:"target" db:"target"`
+	AssigneeID  *string   `json:"assignee_id" db:"assignee_id"`
+	MatchedAt   time.Time `json:"matched_at" db:"matched_at"`
+}
+
+// AutoApprovedRequest is a request approved at submission by an auto-approval rule
+type AutoApprovedRequest struct {
+	Request     *Request `json:"request"`
+	RuleID      *string  `json:"rule_id" db:"auto_approved_rule_id"`
+	RuleName    string   `json:"rule_name" db:"rule_name"`
+	RuleVersion int      `json:"rule_version" db:"auto_approved_rule_version"`
+}
+
+// SCIMGroup is a group provisioned by the identity provider
+type SCIMGroup struct {
+	ID          string             `json:"id" db:"id"`
+	DisplayName string             `json:"display_name" db:"display_name"`
+	ExternalID  string             `json:"external_id" db:"external_id"`
+	Members     []*SCIMGroupMember `json:"members"`
+	CreatedAt   time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at" db:"updated_at"`
+}
+
+// SCIMGroupMember is a user's membership of a SCIM group
+type SCIMGroupMember struct {
+	UserID      string `json:"user_id" db:"user_id"`
+	CorporateID string `json:"corporate_id" db:"corporate_id"`
+	FullName    string `json:"full_name" db:"full_name"`
+}
+
+// Offboarding records a departed user's offboarding and what it changed
+type Offboarding struct {
+	ID          string            `json:"id" db:"id"`
+	UserID      string            `json:"user_id" db:"user_id"`
+	SuccessorID *string           `json:"successor_id" db:"successor_id"`
+	InitiatedBy *string           `json:"initiated_by" db:"initiated_by"`
+	Source      string            `json:"source" db:"source"`
+	Reason      string            `json:"reason" db:"reason"`
+	Report      OffboardingReport `json:"report" db:"report"`
+	CreatedAt   time.Time         `json:"created_at" db:"created_at"`
+}
+
+// OffboardingReport lists the projects, access and requests an offboarding changed
+type OffboardingReport struct {
+	TransferredProjects   []OffboardingItem `json:"transferred_projects"`
+	UntransferredProjects []OffboardingItem `json:"untransferred_projects"`
+	RevokedAccess         []OffboardingItem `json:"revoked_access"`
+	CancelledRequests     []OffboardingItem `json:"cancelled_requests"`
+	ReassignedReviews     []OffboardingItem `json:"reassigned_reviews"`
+}
+
+// OffboardingItem is a project or request affected by an offboarding; Detail is the revoked role
+// or the request type
+type OffboardingItem struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// Department is an organizational department. Users belong to it by name through User.Department
+type Department struct {
+	ID             string    `json:"id" db:"id"`
+	Name           string    `json:"name" db:"name"`
+	LeadID         *string   `json:"lead_id" db:"lead_id"`
+	OSPOChampionID *string   `json:"ospo_champion_id" db:"ospo_champion_id"`
+	Members        int       `json:"members" db:"members"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Team is a team of users, optionally within a department
+type Team struct {
+	ID             string    `json:"id" db:"id"`
+	Name           string    `json:"name" db:"name"`
+	DepartmentID   *string   `json:"department_id" db:"department_id"`
+	DepartmentName string    `json:"department_name" db:"department_name"`
+	MemberIDs      []string  `json:"member_ids"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// OrgChartEntry is one user's placement in an HR org chart import. Empty fields are left unchanged
+type OrgChartEntry struct {
+	CorporateID        string `json:"corporate_id"`
+	Department         string `json:"department"`
+	Team               string `json:"team"`
+	ManagerCorporateID string `json:"manager_corporate_id"`
+}
+
+// APIToken is a revocable bearer token that acts as its user with limited scopes. Only the hash of
+// the secret is stored
+type APIToken struct {
+	ID         string     `json:"id" db:"id"`
+	UserID     string     `json:"user_id" db:"user_id"`
+	Name       string     `json:"name" db:"name"`
+	Prefix     string     `json:"prefix" db:"prefix"`
+	TokenHash  string     `json:"-" db:"token_hash"`
+	Scopes     []string   `json:"scopes" db:"scopes"`
+	ExpiresAt  time.Time  `json:"expires_at" db:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at" db:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at" db:"revoked_at"`
+	CreatedBy  *string    `json:"created_by" db:"created_by"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+// GithubVerification is an OAuth device flow challenge proving that a user owns a GitHub account
+type GithubVerification struct {
+	ID              string     `json:"id" db:"id"`
+	UserID          string     `json:"user_id" db:"user_id"`
+	GithubUsername  string     `json:"github_username" db:"github_username"`
+	DeviceCode      string     `json:"-" db:"device_code"` // cleared once the verification completes
+	UserCode        string     `json:"user_code" db:"user_code"`
+	VerificationURI string     `json:"verification_uri" db:"verification_uri"`
+	IntervalSeconds int        `json:"interval_seconds" db:"interval_seconds"`
+	ExpiresAt       time.Time  `json:"expires_at" db:"expires_at"`
+	Status          string     `json:"status" db:"status"` // pending, verified, failed or expired
+	FailureReason   string     `json:"failure_reason" db:"failure_reason"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	CompletedAt     *time.Time `json:"completed_at" db:"completed_at"`
+}
@@ -0,0 +1,137 @@
+// Package offboarding offboards users who leave: it deactivates them, hands their projects and
+// reviews to a successor, revokes their access and cancels their pending requests.
+package offboarding
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"sourcestream/backend/models"
+	"sourcestream/backend/repository"
+)
+
+// ErrInvalidSuccessor is returned when the requested successor cannot take over from the user.
+var ErrInvalidSuccessor = errors.New("invalid successor")
+
+// Store is the persistence the Offboarder needs.
+type Store interface {
+	GetUserByID(ctx context.Context, id string) (*models.User, error)
+	GetDepartmentLead(ctx context.Context, department string) (string, error)
+	Offboard(ctx context.Context, offboarding *models.Offboarding) error
+}
+
+// Request describes an offboarding. SuccessorID is optional; without it the user's department
+// lead takes over.
+type Request struct {
+	UserID      string
+	SuccessorID string
+	InitiatedBy string
+	Source      string
+	Reason      string
+}
+
+// Offboarder offboards users.
+type Offboarder struct {
+	store Store
+}
+
+// repositoryStore reads users and records offboardings in the database.
+type repositoryStore struct {
+	*repository.UserRepository
+	*repository.OrgRepository
+	*repository.OffboardingRepository
+}
+
+// NewOffboarder creates an Offboarder backed by the database.
+func NewOffboarder(db *sql.DB) *Offboarder {
+	return newOffboarder(repositoryStore{
+		UserRepository:        repository.NewUserRepository(db),
+		OrgRepository:         repository.NewOrgRepository(db),
+		OffboardingRepository: repository.NewOffboardingRepository(db),
+	})
+}
+
+func newOffboarder(store Store) *Offboarder {
+	return &Offboarder{store: store}
+}
+
+// Offboard offboards a user and returns what changed. Offboarding a user again, for example after
+// they were reactivated, repeats the clean-up and records a new report.
+func (o *Offboarder) Offboard(ctx context.Context, req Request) (*models.Offboarding, error) {
+	user, err := o.store.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	successorID, err := o.successor(ctx, user, req.SuccessorID)
+	if err != nil {
+		return nil, err
+	}
+
+	offboarding := &models.Offboarding{
+		UserID: user.ID,
+		Source: req.Source,
+		Reason: req.Reason,
+	}
+
+	if successorID != "" {
+		offboarding.SuccessorID = &successorID
+	}
+
+	if req.InitiatedBy != "" {
+		offboarding.InitiatedBy = &req.InitiatedBy
+	}
+
+	if err := o.store.Offboard(ctx, offboarding); err != nil {
+		return nil, fmt.Errorf("failed to offboard user %s: %w", user.CorporateID, err)
+	}
+
+	return offboarding, nil
+}
+
+// successor returns the ID of the user who takes over: the requested successor, which must be
+// another active user, or else the department lead when there is one who can.
+func (o *Offboarder) successor(ctx context.Context, user *models.User, requested string) (string, error) {
+	if requested != "" {
+		if requested == user.ID {
+			return "", fmt.Errorf("%w: a user cannot succeed themselves", ErrInvalidSuccessor)
+		}
+
+		successor, err := o.store.GetUserByID(ctx, requested)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return "", fmt.Errorf("%w: user %s does not exist", ErrInvalidSuccessor, requested)
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		if !successor.IsActive {
+			return "", fmt.Errorf("%w: %s is not active", ErrInvalidSuccessor, successor.CorporateID)
+		}
+
+		return successor.ID, nil
+	}
+
+	if user.Department == "" {
+		return "", nil
+	}
+
+	leadID, err := o.store.GetDepartmentLead(ctx, user.Department)
+	if err != nil || leadID == "" || leadID == user.ID {
+		return "", err
+	}
+
+	lead, err := o.store.GetUserByID(ctx, leadID)
+	if err != nil {
+		return "", err
+	}
+
+	if !lead.IsActive {
+		return "", nil
+	}
+
+	return lead.ID, nil
+}
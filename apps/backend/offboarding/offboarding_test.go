@@ -0,0 +1,110 @@
+package offboarding
+
+import (
+	"context"
+	"testing"
+
+	"sourcestream/backend/models"
+	"sourcestream/backend/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStore struct {
+	users       map[string]*models.User
+	leads       map[string]string
+	offboarding *models.Offboarding
+}
+
+func (f *fakeStore) GetUserByID(_ context.Context, id string) (*models.User, error) {
+	user, ok := f.users[id]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (f *fakeStore) GetDepartmentLead(_ context.Context, department string) (string, error) {
+	return f.leads[department], nil
+}
+
+func (f *fakeStore) Offboard(_ context.Context, offboarding *models.Offboarding) error {
+	f.offboarding = offboarding
+	return nil
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		users: map[string]*models.User{
+			"alice": {ID: "alice", CorporateID: "alice", Department: "Platform", IsActive: true},
+			"bob":   {ID: "bob", CorporateID: "bob", Department: "Platform", IsActive: true},
+			"carol": {ID: "carol", CorporateID: "carol", Department: "Legal", IsActive: true},
+			"dave":  {ID: "dave", CorporateID: "dave", Department: "Platform", IsActive: false},
+			"erin":  {ID: "erin", CorporateID: "erin", IsActive: true},
+		},
+		leads: map[string]string{"Platform": "bob", "Legal": "carol"},
+	}
+}
+
+func TestOffboarder_Successor(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    string
+		successor string
+		leads     map[string]string
+		want      string
+		wantErr   bool
+	}{
+		{name: "requested successor", userID: "alice", successor: "carol", want: "carol"},
+		{name: "department lead", userID: "alice", want: "bob"},
+		{name: "lead leaving", userID: "bob", want: ""},
+		{name: "no department", userID: "erin", want: ""},
+		{name: "inactive lead", userID: "alice", leads: map[string]string{"Platform": "dave"}, want: ""},
+		{name: "self", userID: "alice", successor: "alice", wantErr: true},
+		{name: "inactive successor", userID: "alice", successor: "dave", wantErr: true},
+		{name: "unknown successor", userID: "alice", successor: "zoe", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeStore()
+			if tt.leads != nil {
+				store.leads = tt.leads
+			}
+
+			offboarding, err := newOffboarder(store).Offboard(context.Background(), Request{
+				UserID:      tt.userID,
+				SuccessorID: tt.successor,
+				InitiatedBy: "admin",
+				Source:      repository.OffboardingSourceAdmin,
+			})
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSuccessor)
+				assert.Nil(t, store.offboarding)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Same(t, store.offboarding, offboarding)
+
+			if tt.want == "" {
+				assert.Nil(t, offboarding.SuccessorID)
+			} else {
+				require.NotNil(t, offboarding.SuccessorID)
+				assert.Equal(t, tt.want, *offboarding.SuccessorID)
+			}
+
+			require.NotNil(t, offboarding.InitiatedBy)
+			assert.Equal(t, "admin", *offboarding.InitiatedBy)
+		})
+	}
+}
+
+func TestOffboarder_UnknownUser(t *testing.T) {
+	_, err := newOffboarder(newFakeStore()).Offboard(context.Background(), Request{UserID: "zoe"})
+	assert.ErrorIs(t, err, repository.ErrUserNotFound)
+}
@@ -0,0 +1,9 @@
+package pb
+
+import _ "embed"
+
+// OpenAPIDocument is the OpenAPI (Swagger 2.0) description of the REST gateway, generated by
+// protoc-gen-openapiv2 from proto/user_service.proto and proto/user_service.yaml.
+//
+//go:embed user_service.swagger.json
+var OpenAPIDocument []byte
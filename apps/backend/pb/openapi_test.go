@@ -0,0 +1,58 @@
+package pb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Every RPC has an HTTP rule in proto/user_service.yaml, and so an operation in the document
+func TestOpenAPIDocument_CoversEveryMethod(t *testing.T) {
+	var doc struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+		} `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(OpenAPIDocument, &doc))
+
+	operations := map[string]bool{}
+	for _, methods := range doc.Paths {
+		for _, op := range methods {
+			operations[op.OperationID] = true
+		}
+	}
+
+	services := File_user_service_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		service := services.Get(i)
+		for j := 0; j < service.Methods().Len(); j++ {
+			operation := fmt.Sprintf("%s_%s", service.Name(), service.Methods().Get(j).Name())
+			assert.True(t, operations[operation], "no HTTP rule for %s", operation)
+		}
+	}
+}
+
+func TestGateway_GetContributor(t *testing.T) {
+	mux := runtime.NewServeMux()
+	require.NoError(t, RegisterUserServiceHandlerServer(context.Background(), mux, &mockUserServiceServer{}))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/contributors/jdoe", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"corporateId":"jdoe"`)
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/contributors:register", strings.NewReader(`{"corporateId":"jdoe"}`)))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Mock contributor registered: jdoe")
+}
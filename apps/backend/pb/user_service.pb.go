@@ -134,6 +134,9 @@ type ApprovedProject struct {
 	ApprovalDate             string                 `protobuf:"bytes,8,opt,name=approval_date,json=approvalDate,proto3" json:"approval_date,omitempty"`
 	IsActive                 bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	AllowedContributionTypes []string               `protobuf:"bytes,10,rep,name=allowed_contribution_types,json=allowedContributionTypes,proto3" json:"allowed_contribution_types,omitempty"` // bug-fix, feature, documentation, etc.
+	ReviewIntervalDays       int32                  `protobuf:"varint,11,opt,name=review_interval_days,json=reviewIntervalDays,proto3" json:"review_interval_days,omitempty"`
+	NextReviewDate           string                 `protobuf:"bytes,12,opt,name=next_review_date,json=nextReviewDate,proto3" json:"next_review_date,omitempty"`
+	LastReviewedAt           string                 `protobuf:"bytes,13,opt,name=last_reviewed_at,json=lastReviewedAt,proto3" json:"last_reviewed_at,omitempty"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -238,21 +241,149 @@ func (x *ApprovedProject) GetAllowedContributionTypes() []string {
 	return nil
 }
 
+func (x *ApprovedProject) GetReviewIntervalDays() int32 {
+	if x != nil {
+		return x.ReviewIntervalDays
+	}
+	return 0
+}
+
+func (x *ApprovedProject) GetNextReviewDate() string {
+	if x != nil {
+		return x.NextReviewDate
+	}
+	return ""
+}
+
+func (x *ApprovedProject) GetLastReviewedAt() string {
+	if x != nil {
+		return x.LastReviewedAt
+	}
+	return ""
+}
+
+type RecertificationTask struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApprovedProjectId string                 `protobuf:"bytes,2,opt,name=approved_project_id,json=approvedProjectId,proto3" json:"approved_project_id,omitempty"`
+	ProjectName       string                 `protobuf:"bytes,3,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // open, recertified, retired, lapsed
+	DueDate           string                 `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	ReviewerId        string                 `protobuf:"bytes,6,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Notes             string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	CompletedAt       string                 `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RecertificationTask) Reset() {
+	*x = RecertificationTask{}
+	mi := &file_user_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecertificationTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecertificationTask) ProtoMessage() {}
+
+func (x *RecertificationTask) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecertificationTask.ProtoReflect.Descriptor instead.
+func (*RecertificationTask) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecertificationTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecertificationTask) GetApprovedProjectId() string {
+	if x != nil {
+		return x.ApprovedProjectId
+	}
+	return ""
+}
+
+func (x *RecertificationTask) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *RecertificationTask) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RecertificationTask) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *RecertificationTask) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *RecertificationTask) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *RecertificationTask) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
 type User struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CorporateId    string                 `protobuf:"bytes,1,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"`
-	GithubUsername string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
-	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Department     string                 `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
-	Email          string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	Role           string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"` // user, ospo_admin, admin
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CorporateId      string                 `protobuf:"bytes,1,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"`
+	GithubUsername   string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Department       string                 `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	Email            string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Role             string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"` // user, ospo_admin, admin
+	Id               string                 `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	ManagerId        string                 `protobuf:"bytes,8,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	IsActive         bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsServiceAccount bool                   `protobuf:"varint,11,opt,name=is_service_account,json=isServiceAccount,proto3" json:"is_service_account,omitempty"`
+	GithubVerified   bool                   `protobuf:"varint,12,opt,name=github_verified,json=githubVerified,proto3" json:"github_verified,omitempty"`
+	GithubVerifiedAt string                 `protobuf:"bytes,13,opt,name=github_verified_at,json=githubVerifiedAt,proto3" json:"github_verified_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_user_service_proto_msgTypes[2]
+	mi := &file_user_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -264,7 +395,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[2]
+	mi := &file_user_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,7 +408,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{2}
+	return file_user_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *User) GetCorporateId() string {
@@ -322,25 +453,75 @@ func (x *User) GetRole() string {
 	return ""
 }
 
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *User) GetIsServiceAccount() bool {
+	if x != nil {
+		return x.IsServiceAccount
+	}
+	return false
+}
+
+func (x *User) GetGithubVerified() bool {
+	if x != nil {
+		return x.GithubVerified
+	}
+	return false
+}
+
+func (x *User) GetGithubVerifiedAt() string {
+	if x != nil {
+		return x.GithubVerifiedAt
+	}
+	return ""
+}
+
 type Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // project, pullrequest, access
 	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // pending, approved, rejected
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // pending, in_review, approved, rejected, cancelled; merged or closed once an approved pull request is resolved upstream
 	RequesterId   string                 `protobuf:"bytes,5,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	ProjectName   string                 `protobuf:"bytes,7,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
 	ProjectUrl    string                 `protobuf:"bytes,8,opt,name=project_url,json=projectUrl,proto3" json:"project_url,omitempty"`
 	License       string                 `protobuf:"bytes,9,opt,name=license,proto3" json:"license,omitempty"`
 	Role          string                 `protobuf:"bytes,10,opt,name=role,proto3" json:"role,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,11,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Request) Reset() {
 	*x = Request{}
-	mi := &file_user_service_proto_msgTypes[3]
+	mi := &file_user_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -352,7 +533,7 @@ func (x *Request) String() string {
 func (*Request) ProtoMessage() {}
 
 func (x *Request) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[3]
+	mi := &file_user_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +546,7 @@ func (x *Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Request.ProtoReflect.Descriptor instead.
 func (*Request) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{3}
+	return file_user_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Request) GetId() string {
@@ -438,30 +619,45 @@ func (x *Request) GetRole() string {
 	return ""
 }
 
-// User Service Messages
-type RegisterContributorRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CorporateId    string                 `protobuf:"bytes,1,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"`
-	GithubUsername string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Request) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
 }
 
-func (x *RegisterContributorRequest) Reset() {
-	*x = RegisterContributorRequest{}
-	mi := &file_user_service_proto_msgTypes[4]
+type Contribution struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RequestId         string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ApprovedProjectId string                 `protobuf:"bytes,3,opt,name=approved_project_id,json=approvedProjectId,proto3" json:"approved_project_id,omitempty"`
+	UserId            string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind              string                 `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"` // pull_request, commit
+	Url               string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Title             string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Outcome           string                 `protobuf:"bytes,8,opt,name=outcome,proto3" json:"outcome,omitempty"` // open, merged, closed
+	ContributedAt     string                 `protobuf:"bytes,9,opt,name=contributed_at,json=contributedAt,proto3" json:"contributed_at,omitempty"`
+	ResolvedAt        string                 `protobuf:"bytes,10,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	Source            string                 `protobuf:"bytes,11,opt,name=source,proto3" json:"source,omitempty"` // rpc, import, webhook
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Contribution) Reset() {
+	*x = Contribution{}
+	mi := &file_user_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterContributorRequest) String() string {
+func (x *Contribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterContributorRequest) ProtoMessage() {}
+func (*Contribution) ProtoMessage() {}
 
-func (x *RegisterContributorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[4]
+func (x *Contribution) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,90 +668,115 @@ func (x *RegisterContributorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterContributorRequest.ProtoReflect.Descriptor instead.
-func (*RegisterContributorRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Contribution.ProtoReflect.Descriptor instead.
+func (*Contribution) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RegisterContributorRequest) GetCorporateId() string {
+func (x *Contribution) GetId() string {
 	if x != nil {
-		return x.CorporateId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RegisterContributorRequest) GetGithubUsername() string {
+func (x *Contribution) GetRequestId() string {
 	if x != nil {
-		return x.GithubUsername
+		return x.RequestId
 	}
 	return ""
 }
 
-type RegisterContributorResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Contribution) GetApprovedProjectId() string {
+	if x != nil {
+		return x.ApprovedProjectId
+	}
+	return ""
 }
 
-func (x *RegisterContributorResponse) Reset() {
-	*x = RegisterContributorResponse{}
-	mi := &file_user_service_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Contribution) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *RegisterContributorResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Contribution) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
 }
 
-func (*RegisterContributorResponse) ProtoMessage() {}
+func (x *Contribution) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
 
-func (x *RegisterContributorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[5]
+func (x *Contribution) GetTitle() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Title
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RegisterContributorResponse.ProtoReflect.Descriptor instead.
-func (*RegisterContributorResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{5}
+func (x *Contribution) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
 }
 
-func (x *RegisterContributorResponse) GetMessage() string {
+func (x *Contribution) GetContributedAt() string {
 	if x != nil {
-		return x.Message
+		return x.ContributedAt
 	}
 	return ""
 }
 
-type GetContributorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CorporateId   string                 `protobuf:"bytes,1,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Contribution) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
 }
 
-func (x *GetContributorRequest) Reset() {
-	*x = GetContributorRequest{}
+func (x *Contribution) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ContributionSummary struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ApprovedProjectId string                 `protobuf:"bytes,1,opt,name=approved_project_id,json=approvedProjectId,proto3" json:"approved_project_id,omitempty"`
+	ProjectName       string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Total             int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Merged            int32                  `protobuf:"varint,4,opt,name=merged,proto3" json:"merged,omitempty"`
+	Closed            int32                  `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	Open              int32                  `protobuf:"varint,6,opt,name=open,proto3" json:"open,omitempty"`
+	Contributors      int32                  `protobuf:"varint,7,opt,name=contributors,proto3" json:"contributors,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ContributionSummary) Reset() {
+	*x = ContributionSummary{}
 	mi := &file_user_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContributorRequest) String() string {
+func (x *ContributionSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContributorRequest) ProtoMessage() {}
+func (*ContributionSummary) ProtoMessage() {}
 
-func (x *GetContributorRequest) ProtoReflect() protoreflect.Message {
+func (x *ContributionSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,41 +788,87 @@ func (x *GetContributorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContributorRequest.ProtoReflect.Descriptor instead.
-func (*GetContributorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContributionSummary.ProtoReflect.Descriptor instead.
+func (*ContributionSummary) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetContributorRequest) GetCorporateId() string {
+func (x *ContributionSummary) GetApprovedProjectId() string {
 	if x != nil {
-		return x.CorporateId
+		return x.ApprovedProjectId
 	}
 	return ""
 }
 
-type GetContributorResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	CorporateId      string                 `protobuf:"bytes,1,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"`
-	GithubUsername   string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
-	ApprovedProjects []string               `protobuf:"bytes,3,rep,name=approved_projects,json=approvedProjects,proto3" json:"approved_projects,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *ContributionSummary) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
 }
 
-func (x *GetContributorResponse) Reset() {
-	*x = GetContributorResponse{}
+func (x *ContributionSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ContributionSummary) GetMerged() int32 {
+	if x != nil {
+		return x.Merged
+	}
+	return 0
+}
+
+func (x *ContributionSummary) GetClosed() int32 {
+	if x != nil {
+		return x.Closed
+	}
+	return 0
+}
+
+func (x *ContributionSummary) GetOpen() int32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *ContributionSummary) GetContributors() int32 {
+	if x != nil {
+		return x.Contributors
+	}
+	return 0
+}
+
+type TeamContributionSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // empty for contributors without a team
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Merged        int32                  `protobuf:"varint,4,opt,name=merged,proto3" json:"merged,omitempty"`
+	Closed        int32                  `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	Open          int32                  `protobuf:"varint,6,opt,name=open,proto3" json:"open,omitempty"`
+	Contributors  int32                  `protobuf:"varint,7,opt,name=contributors,proto3" json:"contributors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamContributionSummary) Reset() {
+	*x = TeamContributionSummary{}
 	mi := &file_user_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContributorResponse) String() string {
+func (x *TeamContributionSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContributorResponse) ProtoMessage() {}
+func (*TeamContributionSummary) ProtoMessage() {}
 
-func (x *GetContributorResponse) ProtoReflect() protoreflect.Message {
+func (x *TeamContributionSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -613,53 +880,86 @@ func (x *GetContributorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContributorResponse.ProtoReflect.Descriptor instead.
-func (*GetContributorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TeamContributionSummary.ProtoReflect.Descriptor instead.
+func (*TeamContributionSummary) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetContributorResponse) GetCorporateId() string {
+func (x *TeamContributionSummary) GetTeamId() string {
 	if x != nil {
-		return x.CorporateId
+		return x.TeamId
 	}
 	return ""
 }
 
-func (x *GetContributorResponse) GetGithubUsername() string {
+func (x *TeamContributionSummary) GetTeamName() string {
 	if x != nil {
-		return x.GithubUsername
+		return x.TeamName
 	}
 	return ""
 }
 
-func (x *GetContributorResponse) GetApprovedProjects() []string {
+func (x *TeamContributionSummary) GetTotal() int32 {
 	if x != nil {
-		return x.ApprovedProjects
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-type GetUserProfileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CorporateId   string                 `protobuf:"bytes,1,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TeamContributionSummary) GetMerged() int32 {
+	if x != nil {
+		return x.Merged
+	}
+	return 0
 }
 
-func (x *GetUserProfileRequest) Reset() {
-	*x = GetUserProfileRequest{}
+func (x *TeamContributionSummary) GetClosed() int32 {
+	if x != nil {
+		return x.Closed
+	}
+	return 0
+}
+
+func (x *TeamContributionSummary) GetOpen() int32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *TeamContributionSummary) GetContributors() int32 {
+	if x != nil {
+		return x.Contributors
+	}
+	return 0
+}
+
+// User Service Messages
+type RegisterContributorRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CorporateId    string                 `protobuf:"bytes,1,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"`
+	GithubUsername string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
+	Email          string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	FullName       string                 `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Department     string                 `protobuf:"bytes,5,opt,name=department,proto3" json:"department,omitempty"` // optional
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegisterContributorRequest) Reset() {
+	*x = RegisterContributorRequest{}
 	mi := &file_user_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserProfileRequest) String() string {
+func (x *RegisterContributorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserProfileRequest) ProtoMessage() {}
+func (*RegisterContributorRequest) ProtoMessage() {}
 
-func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *RegisterContributorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -671,39 +971,69 @@ func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterContributorRequest.ProtoReflect.Descriptor instead.
+func (*RegisterContributorRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetUserProfileRequest) GetCorporateId() string {
+func (x *RegisterContributorRequest) GetCorporateId() string {
 	if x != nil {
 		return x.CorporateId
 	}
 	return ""
 }
 
-type GetUserProfileResponse struct {
+func (x *RegisterContributorRequest) GetGithubUsername() string {
+	if x != nil {
+		return x.GithubUsername
+	}
+	return ""
+}
+
+func (x *RegisterContributorRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterContributorRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *RegisterContributorRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+type RegisterContributorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Created       bool                   `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"` // false when the identity was already registered
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserProfileResponse) Reset() {
-	*x = GetUserProfileResponse{}
+func (x *RegisterContributorResponse) Reset() {
+	*x = RegisterContributorResponse{}
 	mi := &file_user_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserProfileResponse) String() string {
+func (x *RegisterContributorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserProfileResponse) ProtoMessage() {}
+func (*RegisterContributorResponse) ProtoMessage() {}
 
-func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *RegisterContributorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -715,42 +1045,53 @@ func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
-func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterContributorResponse.ProtoReflect.Descriptor instead.
+func (*RegisterContributorResponse) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetUserProfileResponse) GetUser() *User {
+func (x *RegisterContributorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RegisterContributorResponse) GetUser() *User {
 	if x != nil {
 		return x.User
 	}
 	return nil
 }
 
-// Project Service Messages
-type GetAuthoredProjectsRequest struct {
+func (x *RegisterContributorResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type GetContributorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	CorporateId   string                 `protobuf:"bytes,1,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAuthoredProjectsRequest) Reset() {
-	*x = GetAuthoredProjectsRequest{}
+func (x *GetContributorRequest) Reset() {
+	*x = GetContributorRequest{}
 	mi := &file_user_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAuthoredProjectsRequest) String() string {
+func (x *GetContributorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAuthoredProjectsRequest) ProtoMessage() {}
+func (*GetContributorRequest) ProtoMessage() {}
 
-func (x *GetAuthoredProjectsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetContributorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -762,55 +1103,5581 @@ func (x *GetAuthoredProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAuthoredProjectsRequest.ProtoReflect.Descriptor instead.
-func (*GetAuthoredProjectsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContributorRequest.ProtoReflect.Descriptor instead.
+func (*GetContributorRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetAuthoredProjectsRequest) GetUserId() string {
+func (x *GetContributorRequest) GetCorporateId() string {
 	if x != nil {
-		return x.UserId
+		return x.CorporateId
 	}
 	return ""
 }
 
-func (x *GetAuthoredProjectsRequest) GetPage() int32 {
+// A contribution permission or access grant held by a contributor
+type ContributorGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                // approving request ID
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                            // contribution_permission, access
+	ProjectId     string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // approved project ID for contribution permissions, project ID for access grants
+	ProjectName   string                 `protobuf:"bytes,4,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"` // project role, for access grants
+	ApprovedAt    string                 `protobuf:"bytes,6,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // next review date of the approved project; empty if the grant does not expire
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContributorGrant) Reset() {
+	*x = ContributorGrant{}
+	mi := &file_user_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContributorGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContributorGrant) ProtoMessage() {}
+
+func (x *ContributorGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[11]
 	if x != nil {
-		return x.Page
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContributorGrant.ProtoReflect.Descriptor instead.
+func (*ContributorGrant) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ContributorGrant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContributorGrant) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ContributorGrant) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ContributorGrant) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *ContributorGrant) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ContributorGrant) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+func (x *ContributorGrant) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type GetContributorResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CorporateId      string                 `protobuf:"bytes,1,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"`
+	GithubUsername   string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
+	ApprovedProjects []*ContributorGrant    `protobuf:"bytes,4,rep,name=approved_projects,json=approvedProjects,proto3" json:"approved_projects,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetContributorResponse) Reset() {
+	*x = GetContributorResponse{}
+	mi := &file_user_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContributorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContributorResponse) ProtoMessage() {}
+
+func (x *GetContributorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContributorResponse.ProtoReflect.Descriptor instead.
+func (*GetContributorResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetContributorResponse) GetCorporateId() string {
+	if x != nil {
+		return x.CorporateId
+	}
+	return ""
+}
+
+func (x *GetContributorResponse) GetGithubUsername() string {
+	if x != nil {
+		return x.GithubUsername
+	}
+	return ""
+}
+
+func (x *GetContributorResponse) GetApprovedProjects() []*ContributorGrant {
+	if x != nil {
+		return x.ApprovedProjects
+	}
+	return nil
+}
+
+type GetUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CorporateId   string                 `protobuf:"bytes,1,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
+	mi := &file_user_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileRequest) ProtoMessage() {}
+
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserProfileRequest) GetCorporateId() string {
+	if x != nil {
+		return x.CorporateId
+	}
+	return ""
+}
+
+type UserStats struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	RequestsByStatus              map[string]int32       `protobuf:"bytes,1,rep,name=requests_by_status,json=requestsByStatus,proto3" json:"requests_by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // pending, approved, rejected, in_review
+	TotalRequests                 int32                  `protobuf:"varint,2,opt,name=total_requests,json=totalRequests,proto3" json:"total_requests,omitempty"`
+	OwnedProjects                 int32                  `protobuf:"varint,3,opt,name=owned_projects,json=ownedProjects,proto3" json:"owned_projects,omitempty"`
+	ContributedProjects           int32                  `protobuf:"varint,4,opt,name=contributed_projects,json=contributedProjects,proto3" json:"contributed_projects,omitempty"`
+	ActiveContributionPermissions int32                  `protobuf:"varint,5,opt,name=active_contribution_permissions,json=activeContributionPermissions,proto3" json:"active_contribution_permissions,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *UserStats) Reset() {
+	*x = UserStats{}
+	mi := &file_user_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStats) ProtoMessage() {}
+
+func (x *UserStats) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
+func (*UserStats) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UserStats) GetRequestsByStatus() map[string]int32 {
+	if x != nil {
+		return x.RequestsByStatus
+	}
+	return nil
+}
+
+func (x *UserStats) GetTotalRequests() int32 {
+	if x != nil {
+		return x.TotalRequests
+	}
+	return 0
+}
+
+func (x *UserStats) GetOwnedProjects() int32 {
+	if x != nil {
+		return x.OwnedProjects
+	}
+	return 0
+}
+
+func (x *UserStats) GetContributedProjects() int32 {
+	if x != nil {
+		return x.ContributedProjects
+	}
+	return 0
+}
+
+func (x *UserStats) GetActiveContributionPermissions() int32 {
+	if x != nil {
+		return x.ActiveContributionPermissions
+	}
+	return 0
+}
+
+type GetUserProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Stats         *UserStats             `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileResponse) Reset() {
+	*x = GetUserProfileResponse{}
+	mi := &file_user_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileResponse) ProtoMessage() {}
+
+func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUserProfileResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetUserProfileResponse) GetStats() *UserStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// Offboarding of users who leave. Projects go to the successor, or to the user's department
+// lead when no successor is given.
+type OffboardUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SuccessorId   string                 `protobuf:"bytes,2,opt,name=successor_id,json=successorId,proto3" json:"successor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffboardUserRequest) Reset() {
+	*x = OffboardUserRequest{}
+	mi := &file_user_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffboardUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffboardUserRequest) ProtoMessage() {}
+
+func (x *OffboardUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffboardUserRequest.ProtoReflect.Descriptor instead.
+func (*OffboardUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OffboardUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OffboardUserRequest) GetSuccessorId() string {
+	if x != nil {
+		return x.SuccessorId
+	}
+	return ""
+}
+
+func (x *OffboardUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type OffboardUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *OffboardingReport     `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffboardUserResponse) Reset() {
+	*x = OffboardUserResponse{}
+	mi := &file_user_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffboardUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffboardUserResponse) ProtoMessage() {}
+
+func (x *OffboardUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffboardUserResponse.ProtoReflect.Descriptor instead.
+func (*OffboardUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OffboardUserResponse) GetReport() *OffboardingReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type GetOffboardingReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOffboardingReportRequest) Reset() {
+	*x = GetOffboardingReportRequest{}
+	mi := &file_user_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOffboardingReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOffboardingReportRequest) ProtoMessage() {}
+
+func (x *GetOffboardingReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOffboardingReportRequest.ProtoReflect.Descriptor instead.
+func (*GetOffboardingReportRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetOffboardingReportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetOffboardingReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *OffboardingReport     `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOffboardingReportResponse) Reset() {
+	*x = GetOffboardingReportResponse{}
+	mi := &file_user_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOffboardingReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOffboardingReportResponse) ProtoMessage() {}
+
+func (x *GetOffboardingReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOffboardingReportResponse.ProtoReflect.Descriptor instead.
+func (*GetOffboardingReportResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetOffboardingReportResponse) GetReport() *OffboardingReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// OffboardingItem is a project or request an offboarding changed. detail is the revoked role or
+// the request type.
+type OffboardingItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffboardingItem) Reset() {
+	*x = OffboardingItem{}
+	mi := &file_user_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffboardingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffboardingItem) ProtoMessage() {}
+
+func (x *OffboardingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffboardingItem.ProtoReflect.Descriptor instead.
+func (*OffboardingItem) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OffboardingItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OffboardingItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OffboardingItem) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type OffboardingReport struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId                string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SuccessorId           string                 `protobuf:"bytes,3,opt,name=successor_id,json=successorId,proto3" json:"successor_id,omitempty"` // empty when no successor was available
+	InitiatedBy           string                 `protobuf:"bytes,4,opt,name=initiated_by,json=initiatedBy,proto3" json:"initiated_by,omitempty"`
+	Source                string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"` // admin, scim
+	Reason                string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	TransferredProjects   []*OffboardingItem     `protobuf:"bytes,7,rep,name=transferred_projects,json=transferredProjects,proto3" json:"transferred_projects,omitempty"`
+	UntransferredProjects []*OffboardingItem     `protobuf:"bytes,8,rep,name=untransferred_projects,json=untransferredProjects,proto3" json:"untransferred_projects,omitempty"` // owned projects left in place without a successor
+	RevokedAccess         []*OffboardingItem     `protobuf:"bytes,9,rep,name=revoked_access,json=revokedAccess,proto3" json:"revoked_access,omitempty"`
+	CancelledRequests     []*OffboardingItem     `protobuf:"bytes,10,rep,name=cancelled_requests,json=cancelledRequests,proto3" json:"cancelled_requests,omitempty"`
+	ReassignedReviews     []*OffboardingItem     `protobuf:"bytes,11,rep,name=reassigned_reviews,json=reassignedReviews,proto3" json:"reassigned_reviews,omitempty"`
+	CreatedAt             string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *OffboardingReport) Reset() {
+	*x = OffboardingReport{}
+	mi := &file_user_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffboardingReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffboardingReport) ProtoMessage() {}
+
+func (x *OffboardingReport) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffboardingReport.ProtoReflect.Descriptor instead.
+func (*OffboardingReport) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OffboardingReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OffboardingReport) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OffboardingReport) GetSuccessorId() string {
+	if x != nil {
+		return x.SuccessorId
+	}
+	return ""
+}
+
+func (x *OffboardingReport) GetInitiatedBy() string {
+	if x != nil {
+		return x.InitiatedBy
+	}
+	return ""
+}
+
+func (x *OffboardingReport) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *OffboardingReport) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OffboardingReport) GetTransferredProjects() []*OffboardingItem {
+	if x != nil {
+		return x.TransferredProjects
+	}
+	return nil
+}
+
+func (x *OffboardingReport) GetUntransferredProjects() []*OffboardingItem {
+	if x != nil {
+		return x.UntransferredProjects
+	}
+	return nil
+}
+
+func (x *OffboardingReport) GetRevokedAccess() []*OffboardingItem {
+	if x != nil {
+		return x.RevokedAccess
+	}
+	return nil
+}
+
+func (x *OffboardingReport) GetCancelledRequests() []*OffboardingItem {
+	if x != nil {
+		return x.CancelledRequests
+	}
+	return nil
+}
+
+func (x *OffboardingReport) GetReassignedReviews() []*OffboardingItem {
+	if x != nil {
+		return x.ReassignedReviews
+	}
+	return nil
+}
+
+func (x *OffboardingReport) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SetDepartmentLeadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    string                 `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDepartmentLeadRequest) Reset() {
+	*x = SetDepartmentLeadRequest{}
+	mi := &file_user_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDepartmentLeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDepartmentLeadRequest) ProtoMessage() {}
+
+func (x *SetDepartmentLeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDepartmentLeadRequest.ProtoReflect.Descriptor instead.
+func (*SetDepartmentLeadRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetDepartmentLeadRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *SetDepartmentLeadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SetDepartmentLeadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDepartmentLeadResponse) Reset() {
+	*x = SetDepartmentLeadResponse{}
+	mi := &file_user_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDepartmentLeadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDepartmentLeadResponse) ProtoMessage() {}
+
+func (x *SetDepartmentLeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDepartmentLeadResponse.ProtoReflect.Descriptor instead.
+func (*SetDepartmentLeadResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetDepartmentLeadResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Admin user directory
+type ListUsersRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Page             int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit            int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Role             string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                                                          // optional filter
+	Department       string                 `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`                                              // optional filter
+	IsActive         *bool                  `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`                           // optional filter
+	IsServiceAccount *bool                  `protobuf:"varint,6,opt,name=is_service_account,json=isServiceAccount,proto3,oneof" json:"is_service_account,omitempty"` // optional filter
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_user_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetIsServiceAccount() bool {
+	if x != nil && x.IsServiceAccount != nil {
+		return *x.IsServiceAccount
+	}
+	return false
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_user_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// SearchUsersRequest matches users whose name, email, GitHub username, department or corporate ID
+// contain every word of the query.
+type SearchUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                // optional filter
+	Department    string                 `protobuf:"bytes,5,opt,name=department,proto3" json:"department,omitempty"`                    // optional filter
+	IsActive      *bool                  `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"` // optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_user_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_user_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// UpdateUserRequest changes the fields that are set; an empty department or GitHub username
+// clears it. The corporate ID cannot be changed.
+type UpdateUserRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FullName       *string                `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3,oneof" json:"full_name,omitempty"`
+	Email          *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Department     *string                `protobuf:"bytes,4,opt,name=department,proto3,oneof" json:"department,omitempty"`
+	GithubUsername *string                `protobuf:"bytes,5,opt,name=github_username,json=githubUsername,proto3,oneof" json:"github_username,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_user_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetFullName() string {
+	if x != nil && x.FullName != nil {
+		return *x.FullName
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetDepartment() string {
+	if x != nil && x.Department != nil {
+		return *x.Department
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetGithubUsername() string {
+	if x != nil && x.GithubUsername != nil {
+		return *x.GithubUsername
+	}
+	return ""
+}
+
+type UpdateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_user_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type SetUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // contributor, user, ospo_admin, admin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleRequest) Reset() {
+	*x = SetUserRoleRequest{}
+	mi := &file_user_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleRequest) ProtoMessage() {}
+
+func (x *SetUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetUserRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type SetUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleResponse) Reset() {
+	*x = SetUserRoleResponse{}
+	mi := &file_user_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleResponse) ProtoMessage() {}
+
+func (x *SetUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetUserRoleResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// DeactivateUserRequest offboards the user, as OffboardUser does.
+type DeactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SuccessorId   string                 `protobuf:"bytes,2,opt,name=successor_id,json=successorId,proto3" json:"successor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_user_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserRequest) ProtoMessage() {}
+
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeactivateUserRequest) GetSuccessorId() string {
+	if x != nil {
+		return x.SuccessorId
+	}
+	return ""
+}
+
+func (x *DeactivateUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Report        *OffboardingReport     `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserResponse) Reset() {
+	*x = DeactivateUserResponse{}
+	mi := &file_user_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserResponse) ProtoMessage() {}
+
+func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *DeactivateUserResponse) GetReport() *OffboardingReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// ReactivateUserRequest lets a deactivated user sign in again. Access revoked when they were
+// offboarded is not restored.
+type ReactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserRequest) Reset() {
+	*x = ReactivateUserRequest{}
+	mi := &file_user_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserRequest) ProtoMessage() {}
+
+func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserResponse) Reset() {
+	*x = ReactivateUserResponse{}
+	mi := &file_user_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserResponse) ProtoMessage() {}
+
+func (x *ReactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Service accounts and API tokens. Service accounts are users that CI pipelines and bots act as;
+// they cannot sign in and authenticate only with API tokens.
+type CreateServiceAccountRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CorporateId    string                 `protobuf:"bytes,1,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"` // unique name, e.g. svc-release-bot
+	FullName       string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Department     string                 `protobuf:"bytes,3,opt,name=department,proto3" json:"department,omitempty"`
+	Email          string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                                         // optional contact address
+	GithubUsername string                 `protobuf:"bytes,5,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"` // optional, for bots that open pull requests
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountRequest) Reset() {
+	*x = CreateServiceAccountRequest{}
+	mi := &file_user_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountRequest) ProtoMessage() {}
+
+func (x *CreateServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateServiceAccountRequest) GetCorporateId() string {
+	if x != nil {
+		return x.CorporateId
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetGithubUsername() string {
+	if x != nil {
+		return x.GithubUsername
+	}
+	return ""
+}
+
+type CreateServiceAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountResponse) Reset() {
+	*x = CreateServiceAccountResponse{}
+	mi := &file_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountResponse) ProtoMessage() {}
+
+func (x *CreateServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateServiceAccountResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// APIToken describes a token; the secret itself is only returned by CreateAPIToken.
+type APIToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"` // first characters of the secret, to recognise it
+	Scopes        []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt     string                 `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIToken) Reset() {
+	*x = APIToken{}
+	mi := &file_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIToken) ProtoMessage() {}
+
+func (x *APIToken) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIToken.ProtoReflect.Descriptor instead.
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *APIToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIToken) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *APIToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIToken) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIToken) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *APIToken) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *APIToken) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+func (x *APIToken) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *APIToken) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`                                       // e.g. requests:write, catalog:read
+	ExpiresInDays int32                  `protobuf:"varint,4,opt,name=expires_in_days,json=expiresInDays,proto3" json:"expires_in_days,omitempty"` // defaults to 90, at most 365
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPITokenRequest) Reset() {
+	*x = CreateAPITokenRequest{}
+	mi := &file_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPITokenRequest) ProtoMessage() {}
+
+func (x *CreateAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateAPITokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAPITokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPITokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPITokenRequest) GetExpiresInDays() int32 {
+	if x != nil {
+		return x.ExpiresInDays
+	}
+	return 0
+}
+
+type CreateAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *APIToken              `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // the bearer token; it cannot be retrieved again
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPITokenResponse) Reset() {
+	*x = CreateAPITokenResponse{}
+	mi := &file_user_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPITokenResponse) ProtoMessage() {}
+
+func (x *CreateAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateAPITokenResponse) GetToken() *APIToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CreateAPITokenResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListAPITokensRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeRevoked bool                   `protobuf:"varint,2,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAPITokensRequest) Reset() {
+	*x = ListAPITokensRequest{}
+	mi := &file_user_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensRequest) ProtoMessage() {}
+
+func (x *ListAPITokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensRequest.ProtoReflect.Descriptor instead.
+func (*ListAPITokensRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListAPITokensRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAPITokensRequest) GetIncludeRevoked() bool {
+	if x != nil {
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+type ListAPITokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*APIToken            `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPITokensResponse) Reset() {
+	*x = ListAPITokensResponse{}
+	mi := &file_user_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensResponse) ProtoMessage() {}
+
+func (x *ListAPITokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensResponse.ProtoReflect.Descriptor instead.
+func (*ListAPITokensResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListAPITokensResponse) GetTokens() []*APIToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RevokeAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TokenId       string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPITokenRequest) Reset() {
+	*x = RevokeAPITokenRequest{}
+	mi := &file_user_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPITokenRequest) ProtoMessage() {}
+
+func (x *RevokeAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RevokeAPITokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeAPITokenRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+type RevokeAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *APIToken              `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPITokenResponse) Reset() {
+	*x = RevokeAPITokenResponse{}
+	mi := &file_user_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPITokenResponse) ProtoMessage() {}
+
+func (x *RevokeAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RevokeAPITokenResponse) GetToken() *APIToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+// GitHub account verification through the OAuth device flow. The user enters user_code at
+// verification_uri while signed in to the GitHub account, then completes the verification.
+type GithubVerification struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GithubUsername  string                 `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
+	UserCode        string                 `protobuf:"bytes,3,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	VerificationUri string                 `protobuf:"bytes,4,opt,name=verification_uri,json=verificationUri,proto3" json:"verification_uri,omitempty"`
+	ExpiresAt       string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	IntervalSeconds int32                  `protobuf:"varint,6,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"` // how often CompleteGithubVerification may be polled
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                                           // pending, verified, failed or expired
+	FailureReason   string                 `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CompletedAt     string                 `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GithubVerification) Reset() {
+	*x = GithubVerification{}
+	mi := &file_user_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GithubVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GithubVerification) ProtoMessage() {}
+
+func (x *GithubVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GithubVerification.ProtoReflect.Descriptor instead.
+func (*GithubVerification) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GithubVerification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GithubVerification) GetGithubUsername() string {
+	if x != nil {
+		return x.GithubUsername
+	}
+	return ""
+}
+
+func (x *GithubVerification) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *GithubVerification) GetVerificationUri() string {
+	if x != nil {
+		return x.VerificationUri
+	}
+	return ""
+}
+
+func (x *GithubVerification) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *GithubVerification) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *GithubVerification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GithubVerification) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *GithubVerification) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+type StartGithubVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // defaults to the caller
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGithubVerificationRequest) Reset() {
+	*x = StartGithubVerificationRequest{}
+	mi := &file_user_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGithubVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGithubVerificationRequest) ProtoMessage() {}
+
+func (x *StartGithubVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGithubVerificationRequest.ProtoReflect.Descriptor instead.
+func (*StartGithubVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StartGithubVerificationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type StartGithubVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verification  *GithubVerification    `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGithubVerificationResponse) Reset() {
+	*x = StartGithubVerificationResponse{}
+	mi := &file_user_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGithubVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGithubVerificationResponse) ProtoMessage() {}
+
+func (x *StartGithubVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGithubVerificationResponse.ProtoReflect.Descriptor instead.
+func (*StartGithubVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StartGithubVerificationResponse) GetVerification() *GithubVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+// CompleteGithubVerificationRequest checks whether the device flow was authorized. A pending
+// verification is returned unchanged until it is; poll again after interval_seconds.
+type CompleteGithubVerificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // defaults to the caller
+	VerificationId string                 `protobuf:"bytes,2,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompleteGithubVerificationRequest) Reset() {
+	*x = CompleteGithubVerificationRequest{}
+	mi := &file_user_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteGithubVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteGithubVerificationRequest) ProtoMessage() {}
+
+func (x *CompleteGithubVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteGithubVerificationRequest.ProtoReflect.Descriptor instead.
+func (*CompleteGithubVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CompleteGithubVerificationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CompleteGithubVerificationRequest) GetVerificationId() string {
+	if x != nil {
+		return x.VerificationId
+	}
+	return ""
+}
+
+type CompleteGithubVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verification  *GithubVerification    `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteGithubVerificationResponse) Reset() {
+	*x = CompleteGithubVerificationResponse{}
+	mi := &file_user_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteGithubVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteGithubVerificationResponse) ProtoMessage() {}
+
+func (x *CompleteGithubVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteGithubVerificationResponse.ProtoReflect.Descriptor instead.
+func (*CompleteGithubVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CompleteGithubVerificationResponse) GetVerification() *GithubVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *CompleteGithubVerificationResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Departments, teams and managers. users.department holds the department name; departments are
+// created when first used.
+type Department struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LeadId         string                 `protobuf:"bytes,3,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	OspoChampionId string                 `protobuf:"bytes,4,opt,name=ospo_champion_id,json=ospoChampionId,proto3" json:"ospo_champion_id,omitempty"`
+	Members        int32                  `protobuf:"varint,5,opt,name=members,proto3" json:"members,omitempty"` // active users in the department
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Department) Reset() {
+	*x = Department{}
+	mi := &file_user_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Department) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Department) ProtoMessage() {}
+
+func (x *Department) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Department.ProtoReflect.Descriptor instead.
+func (*Department) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Department) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Department) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Department) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+func (x *Department) GetOspoChampionId() string {
+	if x != nil {
+		return x.OspoChampionId
+	}
+	return ""
+}
+
+func (x *Department) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DepartmentId  string                 `protobuf:"bytes,3,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	Department    string                 `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	MemberIds     []string               `protobuf:"bytes,5,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"` // set by GetTeam and the membership RPCs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_user_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Team) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *Team) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *Team) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type SetDepartmentChampionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    string                 `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // empty clears the champion
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDepartmentChampionRequest) Reset() {
+	*x = SetDepartmentChampionRequest{}
+	mi := &file_user_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDepartmentChampionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDepartmentChampionRequest) ProtoMessage() {}
+
+func (x *SetDepartmentChampionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDepartmentChampionRequest.ProtoReflect.Descriptor instead.
+func (*SetDepartmentChampionRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SetDepartmentChampionRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *SetDepartmentChampionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SetDepartmentChampionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    *Department            `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDepartmentChampionResponse) Reset() {
+	*x = SetDepartmentChampionResponse{}
+	mi := &file_user_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDepartmentChampionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDepartmentChampionResponse) ProtoMessage() {}
+
+func (x *SetDepartmentChampionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDepartmentChampionResponse.ProtoReflect.Descriptor instead.
+func (*SetDepartmentChampionResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetDepartmentChampionResponse) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+type ListDepartmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentsRequest) Reset() {
+	*x = ListDepartmentsRequest{}
+	mi := &file_user_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentsRequest) ProtoMessage() {}
+
+func (x *ListDepartmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDepartmentsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{54}
+}
+
+type ListDepartmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Departments   []*Department          `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentsResponse) Reset() {
+	*x = ListDepartmentsResponse{}
+	mi := &file_user_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentsResponse) ProtoMessage() {}
+
+func (x *ListDepartmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDepartmentsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListDepartmentsResponse) GetDepartments() []*Department {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Department    string                 `protobuf:"bytes,2,opt,name=department,proto3" json:"department,omitempty"` // optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_user_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+type CreateTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamResponse) Reset() {
+	*x = CreateTeamResponse{}
+	mi := &file_user_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamResponse) ProtoMessage() {}
+
+func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamResponse.ProtoReflect.Descriptor instead.
+func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_user_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetTeamRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamResponse) Reset() {
+	*x = GetTeamResponse{}
+	mi := &file_user_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamResponse) ProtoMessage() {}
+
+func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    string                 `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`       // optional filter
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional filter: the teams the user belongs to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_user_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListTeamsRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *ListTeamsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_user_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type AddTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamMemberRequest) Reset() {
+	*x = AddTeamMemberRequest{}
+	mi := &file_user_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamMemberRequest) ProtoMessage() {}
+
+func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AddTeamMemberRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *AddTeamMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AddTeamMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamMemberResponse) Reset() {
+	*x = AddTeamMemberResponse{}
+	mi := &file_user_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamMemberResponse) ProtoMessage() {}
+
+func (x *AddTeamMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamMemberResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AddTeamMemberResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type RemoveTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMemberRequest) Reset() {
+	*x = RemoveTeamMemberRequest{}
+	mi := &file_user_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMemberRequest) ProtoMessage() {}
+
+func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RemoveTeamMemberRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *RemoveTeamMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveTeamMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMemberResponse) Reset() {
+	*x = RemoveTeamMemberResponse{}
+	mi := &file_user_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMemberResponse) ProtoMessage() {}
+
+func (x *RemoveTeamMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMemberResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RemoveTeamMemberResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type SetManagerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ManagerId     string                 `protobuf:"bytes,2,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"` // empty clears the manager
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetManagerRequest) Reset() {
+	*x = SetManagerRequest{}
+	mi := &file_user_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetManagerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetManagerRequest) ProtoMessage() {}
+
+func (x *SetManagerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetManagerRequest.ProtoReflect.Descriptor instead.
+func (*SetManagerRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetManagerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetManagerRequest) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+type SetManagerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetManagerResponse) Reset() {
+	*x = SetManagerResponse{}
+	mi := &file_user_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetManagerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetManagerResponse) ProtoMessage() {}
+
+func (x *SetManagerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetManagerResponse.ProtoReflect.Descriptor instead.
+func (*SetManagerResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetManagerResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ImportOrgChartRequest carries an HR export as CSV with a header row. The columns are
+// corporate_id (required), department, team and manager_corporate_id; other columns are ignored
+// and empty cells leave the user's placement unchanged. The team becomes the user's only team.
+type ImportOrgChartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Csv           []byte                 `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrgChartRequest) Reset() {
+	*x = ImportOrgChartRequest{}
+	mi := &file_user_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrgChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrgChartRequest) ProtoMessage() {}
+
+func (x *ImportOrgChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrgChartRequest.ProtoReflect.Descriptor instead.
+func (*ImportOrgChartRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ImportOrgChartRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+type OrgChartImportError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	CorporateId   string                 `protobuf:"bytes,2,opt,name=corporate_id,json=corporateId,proto3" json:"corporate_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrgChartImportError) Reset() {
+	*x = OrgChartImportError{}
+	mi := &file_user_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrgChartImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgChartImportError) ProtoMessage() {}
+
+func (x *OrgChartImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgChartImportError.ProtoReflect.Descriptor instead.
+func (*OrgChartImportError) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *OrgChartImportError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *OrgChartImportError) GetCorporateId() string {
+	if x != nil {
+		return x.CorporateId
+	}
+	return ""
+}
+
+func (x *OrgChartImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportOrgChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Errors        []*OrgChartImportError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOrgChartResponse) Reset() {
+	*x = ImportOrgChartResponse{}
+	mi := &file_user_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOrgChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOrgChartResponse) ProtoMessage() {}
+
+func (x *ImportOrgChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOrgChartResponse.ProtoReflect.Descriptor instead.
+func (*ImportOrgChartResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ImportOrgChartResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportOrgChartResponse) GetErrors() []*OrgChartImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Project Service Messages
+type GetAuthoredProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthoredProjectsRequest) Reset() {
+	*x = GetAuthoredProjectsRequest{}
+	mi := &file_user_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthoredProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthoredProjectsRequest) ProtoMessage() {}
+
+func (x *GetAuthoredProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthoredProjectsRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthoredProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetAuthoredProjectsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAuthoredProjectsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAuthoredProjectsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAuthoredProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthoredProjectsResponse) Reset() {
+	*x = GetAuthoredProjectsResponse{}
+	mi := &file_user_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthoredProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthoredProjectsResponse) ProtoMessage() {}
+
+func (x *GetAuthoredProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthoredProjectsResponse.ProtoReflect.Descriptor instead.
+func (*GetAuthoredProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetAuthoredProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *GetAuthoredProjectsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetContributedProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContributedProjectsRequest) Reset() {
+	*x = GetContributedProjectsRequest{}
+	mi := &file_user_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContributedProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContributedProjectsRequest) ProtoMessage() {}
+
+func (x *GetContributedProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContributedProjectsRequest.ProtoReflect.Descriptor instead.
+func (*GetContributedProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetContributedProjectsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetContributedProjectsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetContributedProjectsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetContributedProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContributedProjectsResponse) Reset() {
+	*x = GetContributedProjectsResponse{}
+	mi := &file_user_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContributedProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContributedProjectsResponse) ProtoMessage() {}
+
+func (x *GetContributedProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContributedProjectsResponse.ProtoReflect.Descriptor instead.
+func (*GetContributedProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetContributedProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *GetContributedProjectsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetApprovedProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovedProjectsRequest) Reset() {
+	*x = GetApprovedProjectsRequest{}
+	mi := &file_user_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovedProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovedProjectsRequest) ProtoMessage() {}
+
+func (x *GetApprovedProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovedProjectsRequest.ProtoReflect.Descriptor instead.
+func (*GetApprovedProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetApprovedProjectsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetApprovedProjectsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetApprovedProjectsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetApprovedProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovedProjectsResponse) Reset() {
+	*x = GetApprovedProjectsResponse{}
+	mi := &file_user_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovedProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovedProjectsResponse) ProtoMessage() {}
+
+func (x *GetApprovedProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovedProjectsResponse.ProtoReflect.Descriptor instead.
+func (*GetApprovedProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetApprovedProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *GetApprovedProjectsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	License       string                 `protobuf:"bytes,4,opt,name=license,proto3" json:"license,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_user_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type CreateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectResponse) Reset() {
+	*x = CreateProjectResponse{}
+	mi := &file_user_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectResponse) ProtoMessage() {}
+
+func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *CreateProjectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request Service Messages
+type SubmitProjectRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	ProjectUrl    string                 `protobuf:"bytes,2,opt,name=project_url,json=projectUrl,proto3" json:"project_url,omitempty"`
+	License       string                 `protobuf:"bytes,3,opt,name=license,proto3" json:"license,omitempty"`
+	RequesterId   string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitProjectRequestRequest) Reset() {
+	*x = SubmitProjectRequestRequest{}
+	mi := &file_user_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitProjectRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitProjectRequestRequest) ProtoMessage() {}
+
+func (x *SubmitProjectRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitProjectRequestRequest.ProtoReflect.Descriptor instead.
+func (*SubmitProjectRequestRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SubmitProjectRequestRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SubmitProjectRequestRequest) GetProjectUrl() string {
+	if x != nil {
+		return x.ProjectUrl
+	}
+	return ""
+}
+
+func (x *SubmitProjectRequestRequest) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *SubmitProjectRequestRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+type SubmitProjectRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitProjectRequestResponse) Reset() {
+	*x = SubmitProjectRequestResponse{}
+	mi := &file_user_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitProjectRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitProjectRequestResponse) ProtoMessage() {}
+
+func (x *SubmitProjectRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitProjectRequestResponse.ProtoReflect.Descriptor instead.
+func (*SubmitProjectRequestResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SubmitProjectRequestResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SubmitProjectRequestResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SubmitPullRequestApprovalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	ProjectName   string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	PrUrl         string                 `protobuf:"bytes,3,opt,name=pr_url,json=prUrl,proto3" json:"pr_url,omitempty"`
+	RequesterId   string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitPullRequestApprovalRequest) Reset() {
+	*x = SubmitPullRequestApprovalRequest{}
+	mi := &file_user_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitPullRequestApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPullRequestApprovalRequest) ProtoMessage() {}
+
+func (x *SubmitPullRequestApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPullRequestApprovalRequest.ProtoReflect.Descriptor instead.
+func (*SubmitPullRequestApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SubmitPullRequestApprovalRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SubmitPullRequestApprovalRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *SubmitPullRequestApprovalRequest) GetPrUrl() string {
+	if x != nil {
+		return x.PrUrl
+	}
+	return ""
+}
+
+func (x *SubmitPullRequestApprovalRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+type SubmitPullRequestApprovalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitPullRequestApprovalResponse) Reset() {
+	*x = SubmitPullRequestApprovalResponse{}
+	mi := &file_user_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitPullRequestApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPullRequestApprovalResponse) ProtoMessage() {}
+
+func (x *SubmitPullRequestApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPullRequestApprovalResponse.ProtoReflect.Descriptor instead.
+func (*SubmitPullRequestApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SubmitPullRequestApprovalResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SubmitPullRequestApprovalResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SubmitAccessRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	ProjectName   string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	RequesterId   string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAccessRequestRequest) Reset() {
+	*x = SubmitAccessRequestRequest{}
+	mi := &file_user_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAccessRequestRequest) ProtoMessage() {}
+
+func (x *SubmitAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SubmitAccessRequestRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SubmitAccessRequestRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *SubmitAccessRequestRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SubmitAccessRequestRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+type SubmitAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAccessRequestResponse) Reset() {
+	*x = SubmitAccessRequestResponse{}
+	mi := &file_user_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAccessRequestResponse) ProtoMessage() {}
+
+func (x *SubmitAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SubmitAccessRequestResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SubmitAccessRequestResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // optional filter
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequestsRequest) Reset() {
+	*x = GetRequestsRequest{}
+	mi := &file_user_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequestsRequest) ProtoMessage() {}
+
+func (x *GetRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetRequestsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRequestsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*Request             `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequestsResponse) Reset() {
+	*x = GetRequestsResponse{}
+	mi := &file_user_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequestsResponse) ProtoMessage() {}
+
+func (x *GetRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetRequestsResponse) GetRequests() []*Request {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *GetRequestsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// New messages for approved projects list
+type GetApprovedProjectsListRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly              bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	License                 string                 `protobuf:"bytes,2,opt,name=license,proto3" json:"license,omitempty"`                                                                  // optional filter, e.g. MIT
+	ContributionType        string                 `protobuf:"bytes,3,opt,name=contribution_type,json=contributionType,proto3" json:"contribution_type,omitempty"`                        // optional filter: CLA, CCLA, DCO
+	AllowedContributionType string                 `protobuf:"bytes,4,opt,name=allowed_contribution_type,json=allowedContributionType,proto3" json:"allowed_contribution_type,omitempty"` // optional filter, e.g. documentation
+	Query                   string                 `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`                                                                      // optional name or description search text
+	SortBy                  string                 `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                                                      // name (default), approval_date
+	Descending              bool                   `protobuf:"varint,7,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize                int32                  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken               string                 `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous response with the same sort and filters
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetApprovedProjectsListRequest) Reset() {
+	*x = GetApprovedProjectsListRequest{}
+	mi := &file_user_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovedProjectsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovedProjectsListRequest) ProtoMessage() {}
+
+func (x *GetApprovedProjectsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovedProjectsListRequest.ProtoReflect.Descriptor instead.
+func (*GetApprovedProjectsListRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetApprovedProjectsListRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *GetApprovedProjectsListRequest) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *GetApprovedProjectsListRequest) GetContributionType() string {
+	if x != nil {
+		return x.ContributionType
+	}
+	return ""
+}
+
+func (x *GetApprovedProjectsListRequest) GetAllowedContributionType() string {
+	if x != nil {
+		return x.AllowedContributionType
+	}
+	return ""
+}
+
+func (x *GetApprovedProjectsListRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetApprovedProjectsListRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetApprovedProjectsListRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *GetApprovedProjectsListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetApprovedProjectsListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetApprovedProjectsListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*ApprovedProject     `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty when there are no more results
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovedProjectsListResponse) Reset() {
+	*x = GetApprovedProjectsListResponse{}
+	mi := &file_user_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovedProjectsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovedProjectsListResponse) ProtoMessage() {}
+
+func (x *GetApprovedProjectsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApprovedProjectsListResponse.ProtoReflect.Descriptor instead.
+func (*GetApprovedProjectsListResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetApprovedProjectsListResponse) GetProjects() []*ApprovedProject {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *GetApprovedProjectsListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// New messages for contribution permission requests
+type SubmitContributionPermissionRequestRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Title                 string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	ApprovedProjectId     string                 `protobuf:"bytes,2,opt,name=approved_project_id,json=approvedProjectId,proto3" json:"approved_project_id,omitempty"`
+	BusinessJustification string                 `protobuf:"bytes,3,opt,name=business_justification,json=businessJustification,proto3" json:"business_justification,omitempty"`
+	RequesterId           string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	ContributionKind      string                 `protobuf:"bytes,5,opt,name=contribution_kind,json=contributionKind,proto3" json:"contribution_kind,omitempty"` // one of the approved project's allowed contribution types, e.g. documentation
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SubmitContributionPermissionRequestRequest) Reset() {
+	*x = SubmitContributionPermissionRequestRequest{}
+	mi := &file_user_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitContributionPermissionRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitContributionPermissionRequestRequest) ProtoMessage() {}
+
+func (x *SubmitContributionPermissionRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitContributionPermissionRequestRequest.ProtoReflect.Descriptor instead.
+func (*SubmitContributionPermissionRequestRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SubmitContributionPermissionRequestRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SubmitContributionPermissionRequestRequest) GetApprovedProjectId() string {
+	if x != nil {
+		return x.ApprovedProjectId
+	}
+	return ""
+}
+
+func (x *SubmitContributionPermissionRequestRequest) GetBusinessJustification() string {
+	if x != nil {
+		return x.BusinessJustification
+	}
+	return ""
+}
+
+func (x *SubmitContributionPermissionRequestRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+func (x *SubmitContributionPermissionRequestRequest) GetContributionKind() string {
+	if x != nil {
+		return x.ContributionKind
+	}
+	return ""
+}
+
+type SubmitContributionPermissionRequestResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RequestId      string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending, or approved when an auto-approval rule matched
+	DecisionReason string                 `protobuf:"bytes,4,opt,name=decision_reason,json=decisionReason,proto3" json:"decision_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitContributionPermissionRequestResponse) Reset() {
+	*x = SubmitContributionPermissionRequestResponse{}
+	mi := &file_user_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitContributionPermissionRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitContributionPermissionRequestResponse) ProtoMessage() {}
+
+func (x *SubmitContributionPermissionRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitContributionPermissionRequestResponse.ProtoReflect.Descriptor instead.
+func (*SubmitContributionPermissionRequestResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SubmitContributionPermissionRequestResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SubmitContributionPermissionRequestResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubmitContributionPermissionRequestResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SubmitContributionPermissionRequestResponse) GetDecisionReason() string {
+	if x != nil {
+		return x.DecisionReason
+	}
+	return ""
+}
+
+// Messages for periodic re-certification of approved projects
+type ListRecertificationTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // optional filter
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecertificationTasksRequest) Reset() {
+	*x = ListRecertificationTasksRequest{}
+	mi := &file_user_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecertificationTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecertificationTasksRequest) ProtoMessage() {}
+
+func (x *ListRecertificationTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecertificationTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListRecertificationTasksRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListRecertificationTasksRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRecertificationTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRecertificationTasksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRecertificationTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*RecertificationTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecertificationTasksResponse) Reset() {
+	*x = ListRecertificationTasksResponse{}
+	mi := &file_user_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecertificationTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecertificationTasksResponse) ProtoMessage() {}
+
+func (x *ListRecertificationTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecertificationTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListRecertificationTasksResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListRecertificationTasksResponse) GetTasks() []*RecertificationTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type CompleteRecertificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Decision      string                 `protobuf:"bytes,3,opt,name=decision,proto3" json:"decision,omitempty"` // recertify, retire
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteRecertificationRequest) Reset() {
+	*x = CompleteRecertificationRequest{}
+	mi := &file_user_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteRecertificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteRecertificationRequest) ProtoMessage() {}
+
+func (x *CompleteRecertificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteRecertificationRequest.ProtoReflect.Descriptor instead.
+func (*CompleteRecertificationRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CompleteRecertificationRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CompleteRecertificationRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *CompleteRecertificationRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *CompleteRecertificationRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type CompleteRecertificationResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Message         string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	FlaggedRequests int32                  `protobuf:"varint,2,opt,name=flagged_requests,json=flaggedRequests,proto3" json:"flagged_requests,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompleteRecertificationResponse) Reset() {
+	*x = CompleteRecertificationResponse{}
+	mi := &file_user_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteRecertificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteRecertificationResponse) ProtoMessage() {}
+
+func (x *CompleteRecertificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteRecertificationResponse.ProtoReflect.Descriptor instead.
+func (*CompleteRecertificationResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CompleteRecertificationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CompleteRecertificationResponse) GetFlaggedRequests() int32 {
+	if x != nil {
+		return x.FlaggedRequests
+	}
+	return 0
+}
+
+// Messages for managing project contributors
+type AddProjectContributorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // contributor (default), maintainer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProjectContributorRequest) Reset() {
+	*x = AddProjectContributorRequest{}
+	mi := &file_user_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProjectContributorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProjectContributorRequest) ProtoMessage() {}
+
+func (x *AddProjectContributorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProjectContributorRequest.ProtoReflect.Descriptor instead.
+func (*AddProjectContributorRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AddProjectContributorRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *AddProjectContributorRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddProjectContributorRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AddProjectContributorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProjectContributorResponse) Reset() {
+	*x = AddProjectContributorResponse{}
+	mi := &file_user_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProjectContributorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProjectContributorResponse) ProtoMessage() {}
+
+func (x *AddProjectContributorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProjectContributorResponse.ProtoReflect.Descriptor instead.
+func (*AddProjectContributorResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *AddProjectContributorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveProjectContributorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProjectContributorRequest) Reset() {
+	*x = RemoveProjectContributorRequest{}
+	mi := &file_user_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProjectContributorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProjectContributorRequest) ProtoMessage() {}
+
+func (x *RemoveProjectContributorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProjectContributorRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProjectContributorRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RemoveProjectContributorRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RemoveProjectContributorRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveProjectContributorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProjectContributorResponse) Reset() {
+	*x = RemoveProjectContributorResponse{}
+	mi := &file_user_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProjectContributorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProjectContributorResponse) ProtoMessage() {}
+
+func (x *RemoveProjectContributorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProjectContributorResponse.ProtoReflect.Descriptor instead.
+func (*RemoveProjectContributorResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RemoveProjectContributorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Messages for the corporate contribution ledger
+type RecordContributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // approving contribution_permission or pullrequest request
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`                            // pull_request, commit
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Outcome       string                 `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome,omitempty"`                                  // open, merged, closed
+	ContributedAt string                 `protobuf:"bytes,6,opt,name=contributed_at,json=contributedAt,proto3" json:"contributed_at,omitempty"` // RFC 3339, defaults to now
+	ResolvedAt    string                 `protobuf:"bytes,7,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`          // RFC 3339, defaults to now when merged or closed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordContributionRequest) Reset() {
+	*x = RecordContributionRequest{}
+	mi := &file_user_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordContributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordContributionRequest) ProtoMessage() {}
+
+func (x *RecordContributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordContributionRequest.ProtoReflect.Descriptor instead.
+func (*RecordContributionRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RecordContributionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *RecordContributionRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RecordContributionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RecordContributionRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RecordContributionRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *RecordContributionRequest) GetContributedAt() string {
+	if x != nil {
+		return x.ContributedAt
+	}
+	return ""
+}
+
+func (x *RecordContributionRequest) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+type RecordContributionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contribution  *Contribution          `protobuf:"bytes,1,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordContributionResponse) Reset() {
+	*x = RecordContributionResponse{}
+	mi := &file_user_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordContributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordContributionResponse) ProtoMessage() {}
+
+func (x *RecordContributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordContributionResponse.ProtoReflect.Descriptor instead.
+func (*RecordContributionResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RecordContributionResponse) GetContribution() *Contribution {
+	if x != nil {
+		return x.Contribution
+	}
+	return nil
+}
+
+func (x *RecordContributionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportContributionsRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Entries       []*RecordContributionRequest `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportContributionsRequest) Reset() {
+	*x = ImportContributionsRequest{}
+	mi := &file_user_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportContributionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportContributionsRequest) ProtoMessage() {}
+
+func (x *ImportContributionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportContributionsRequest.ProtoReflect.Descriptor instead.
+func (*ImportContributionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ImportContributionsRequest) GetEntries() []*RecordContributionRequest {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ContributionImportError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContributionImportError) Reset() {
+	*x = ContributionImportError{}
+	mi := &file_user_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContributionImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContributionImportError) ProtoMessage() {}
+
+func (x *ContributionImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContributionImportError.ProtoReflect.Descriptor instead.
+func (*ContributionImportError) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ContributionImportError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ContributionImportError) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ContributionImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportContributionsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Imported      int32                      `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Errors        []*ContributionImportError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportContributionsResponse) Reset() {
+	*x = ImportContributionsResponse{}
+	mi := &file_user_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportContributionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportContributionsResponse) ProtoMessage() {}
+
+func (x *ImportContributionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportContributionsResponse.ProtoReflect.Descriptor instead.
+func (*ImportContributionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ImportContributionsResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportContributionsResponse) GetErrors() []*ContributionImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ListContributionsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                    // optional filter
+	ApprovedProjectId string                 `protobuf:"bytes,2,opt,name=approved_project_id,json=approvedProjectId,proto3" json:"approved_project_id,omitempty"` // optional filter
+	RequestId         string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`                           // optional filter
+	Outcome           string                 `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`                                                // optional filter
+	From              string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`                                                      // optional RFC 3339 lower bound on contributed_at
+	To                string                 `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`                                                          // optional RFC 3339 upper bound on contributed_at
+	Page              int32                  `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	Limit             int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListContributionsRequest) Reset() {
+	*x = ListContributionsRequest{}
+	mi := &file_user_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContributionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContributionsRequest) ProtoMessage() {}
+
+func (x *ListContributionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContributionsRequest.ProtoReflect.Descriptor instead.
+func (*ListContributionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListContributionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListContributionsRequest) GetApprovedProjectId() string {
+	if x != nil {
+		return x.ApprovedProjectId
+	}
+	return ""
+}
+
+func (x *ListContributionsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ListContributionsRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ListContributionsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListContributionsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListContributionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListContributionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListContributionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contributions []*Contribution        `protobuf:"bytes,1,rep,name=contributions,proto3" json:"contributions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContributionsResponse) Reset() {
+	*x = ListContributionsResponse{}
+	mi := &file_user_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContributionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContributionsResponse) ProtoMessage() {}
+
+func (x *ListContributionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *GetAuthoredProjectsRequest) GetLimit() int32 {
+// Deprecated: Use ListContributionsResponse.ProtoReflect.Descriptor instead.
+func (*ListContributionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListContributionsResponse) GetContributions() []*Contribution {
 	if x != nil {
-		return x.Limit
+		return x.Contributions
 	}
-	return 0
+	return nil
 }
 
-type GetAuthoredProjectsResponse struct {
+type GetContributionReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`                     // defaults to the current year
+	GroupBy       string                 `protobuf:"bytes,2,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"` // project (default) or team
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAuthoredProjectsResponse) Reset() {
-	*x = GetAuthoredProjectsResponse{}
-	mi := &file_user_service_proto_msgTypes[11]
+func (x *GetContributionReportRequest) Reset() {
+	*x = GetContributionReportRequest{}
+	mi := &file_user_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAuthoredProjectsResponse) String() string {
+func (x *GetContributionReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAuthoredProjectsResponse) ProtoMessage() {}
+func (*GetContributionReportRequest) ProtoMessage() {}
 
-func (x *GetAuthoredProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[11]
+func (x *GetContributionReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,49 +6688,51 @@ func (x *GetAuthoredProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAuthoredProjectsResponse.ProtoReflect.Descriptor instead.
-func (*GetAuthoredProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetContributionReportRequest.ProtoReflect.Descriptor instead.
+func (*GetContributionReportRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GetAuthoredProjectsResponse) GetProjects() []*Project {
+func (x *GetContributionReportRequest) GetYear() int32 {
 	if x != nil {
-		return x.Projects
+		return x.Year
 	}
-	return nil
+	return 0
 }
 
-func (x *GetAuthoredProjectsResponse) GetTotal() int32 {
+func (x *GetContributionReportRequest) GetGroupBy() string {
 	if x != nil {
-		return x.Total
+		return x.GroupBy
 	}
-	return 0
+	return ""
 }
 
-type GetContributedProjectsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+type GetContributionReportResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Year          int32                      `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Projects      []*ContributionSummary     `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"` // set when grouped by project
+	Total         int32                      `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Merged        int32                      `protobuf:"varint,4,opt,name=merged,proto3" json:"merged,omitempty"`
+	Teams         []*TeamContributionSummary `protobuf:"bytes,5,rep,name=teams,proto3" json:"teams,omitempty"` // set when grouped by team
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContributedProjectsRequest) Reset() {
-	*x = GetContributedProjectsRequest{}
-	mi := &file_user_service_proto_msgTypes[12]
+func (x *GetContributionReportResponse) Reset() {
+	*x = GetContributionReportResponse{}
+	mi := &file_user_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContributedProjectsRequest) String() string {
+func (x *GetContributionReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContributedProjectsRequest) ProtoMessage() {}
+func (*GetContributionReportResponse) ProtoMessage() {}
 
-func (x *GetContributedProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[12]
+func (x *GetContributionReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,55 +6743,73 @@ func (x *GetContributedProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContributedProjectsRequest.ProtoReflect.Descriptor instead.
-func (*GetContributedProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetContributionReportResponse.ProtoReflect.Descriptor instead.
+func (*GetContributionReportResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetContributedProjectsRequest) GetUserId() string {
+func (x *GetContributionReportResponse) GetYear() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Year
 	}
-	return ""
+	return 0
 }
 
-func (x *GetContributedProjectsRequest) GetPage() int32 {
+func (x *GetContributionReportResponse) GetProjects() []*ContributionSummary {
 	if x != nil {
-		return x.Page
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *GetContributionReportResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-func (x *GetContributedProjectsRequest) GetLimit() int32 {
+func (x *GetContributionReportResponse) GetMerged() int32 {
 	if x != nil {
-		return x.Limit
+		return x.Merged
 	}
 	return 0
 }
 
-type GetContributedProjectsResponse struct {
+func (x *GetContributionReportResponse) GetTeams() []*TeamContributionSummary {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+// Messages for upstream pull request status tracking
+type PullRequestEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // opened, updated, merged, closed
+	HeadSha       string                 `protobuf:"bytes,4,opt,name=head_sha,json=headSha,proto3" json:"head_sha,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContributedProjectsResponse) Reset() {
-	*x = GetContributedProjectsResponse{}
-	mi := &file_user_service_proto_msgTypes[13]
+func (x *PullRequestEvent) Reset() {
+	*x = PullRequestEvent{}
+	mi := &file_user_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContributedProjectsResponse) String() string {
+func (x *PullRequestEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContributedProjectsResponse) ProtoMessage() {}
+func (*PullRequestEvent) ProtoMessage() {}
 
-func (x *GetContributedProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[13]
+func (x *PullRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,49 +6820,68 @@ func (x *GetContributedProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContributedProjectsResponse.ProtoReflect.Descriptor instead.
-func (*GetContributedProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use PullRequestEvent.ProtoReflect.Descriptor instead.
+func (*PullRequestEvent) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetContributedProjectsResponse) GetProjects() []*Project {
+func (x *PullRequestEvent) GetId() string {
 	if x != nil {
-		return x.Projects
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetContributedProjectsResponse) GetTotal() int32 {
+func (x *PullRequestEvent) GetRequestId() string {
 	if x != nil {
-		return x.Total
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-type GetApprovedProjectsRequest struct {
+func (x *PullRequestEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetHeadSha() string {
+	if x != nil {
+		return x.HeadSha
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type ListPullRequestEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApprovedProjectsRequest) Reset() {
-	*x = GetApprovedProjectsRequest{}
-	mi := &file_user_service_proto_msgTypes[14]
+func (x *ListPullRequestEventsRequest) Reset() {
+	*x = ListPullRequestEventsRequest{}
+	mi := &file_user_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApprovedProjectsRequest) String() string {
+func (x *ListPullRequestEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApprovedProjectsRequest) ProtoMessage() {}
+func (*ListPullRequestEventsRequest) ProtoMessage() {}
 
-func (x *GetApprovedProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[14]
+func (x *ListPullRequestEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,55 +6892,40 @@ func (x *GetApprovedProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApprovedProjectsRequest.ProtoReflect.Descriptor instead.
-func (*GetApprovedProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListPullRequestEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListPullRequestEventsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetApprovedProjectsRequest) GetUserId() string {
+func (x *ListPullRequestEventsRequest) GetRequestId() string {
 	if x != nil {
-		return x.UserId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetApprovedProjectsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetApprovedProjectsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-type GetApprovedProjectsResponse struct {
+type ListPullRequestEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Events        []*PullRequestEvent    `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApprovedProjectsResponse) Reset() {
-	*x = GetApprovedProjectsResponse{}
-	mi := &file_user_service_proto_msgTypes[15]
+func (x *ListPullRequestEventsResponse) Reset() {
+	*x = ListPullRequestEventsResponse{}
+	mi := &file_user_service_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApprovedProjectsResponse) String() string {
+func (x *ListPullRequestEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApprovedProjectsResponse) ProtoMessage() {}
+func (*ListPullRequestEventsResponse) ProtoMessage() {}
 
-func (x *GetApprovedProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[15]
+func (x *ListPullRequestEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,51 +6936,54 @@ func (x *GetApprovedProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApprovedProjectsResponse.ProtoReflect.Descriptor instead.
-func (*GetApprovedProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListPullRequestEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListPullRequestEventsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *GetApprovedProjectsResponse) GetProjects() []*Project {
+func (x *ListPullRequestEventsResponse) GetEvents() []*PullRequestEvent {
 	if x != nil {
-		return x.Projects
+		return x.Events
 	}
 	return nil
 }
 
-func (x *GetApprovedProjectsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type CreateProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	License       string                 `protobuf:"bytes,4,opt,name=license,proto3" json:"license,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+// Attribute-based approval rules. Expressions are CEL over the variables request, user, project
+// and approved_project, e.g. project.license.startsWith("GPL").
+type ApprovalRule struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IsEnabled   bool                   `protobuf:"varint,4,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	Version     int32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	RequestType string                 `protobuf:"bytes,6,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"` // empty applies to every request type
+	Expression  string                 `protobuf:"bytes,7,opt,name=expression,proto3" json:"expression,omitempty"`
+	Action      string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"` // require_review, route, auto_approve
+	// reviewer group or team, e.g. security, legal, or requester_manager or department_ospo_champion
+	// to assign the requester's manager or department OSPO champion; unused by auto_approve
+	Target        string `protobuf:"bytes,9,opt,name=target,proto3" json:"target,omitempty"`
+	CreatedBy     string `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectRequest) Reset() {
-	*x = CreateProjectRequest{}
-	mi := &file_user_service_proto_msgTypes[16]
+func (x *ApprovalRule) Reset() {
+	*x = ApprovalRule{}
+	mi := &file_user_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectRequest) String() string {
+func (x *ApprovalRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectRequest) ProtoMessage() {}
+func (*ApprovalRule) ProtoMessage() {}
 
-func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[16]
+func (x *ApprovalRule) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1100,124 +6994,116 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ApprovalRule.ProtoReflect.Descriptor instead.
+func (*ApprovalRule) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *CreateProjectRequest) GetName() string {
+func (x *ApprovalRule) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateProjectRequest) GetDescription() string {
+func (x *ApprovalRule) GetName() string {
 	if x != nil {
-		return x.Description
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateProjectRequest) GetUrl() string {
+func (x *ApprovalRule) GetDescription() string {
 	if x != nil {
-		return x.Url
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateProjectRequest) GetLicense() string {
+func (x *ApprovalRule) GetIsEnabled() bool {
 	if x != nil {
-		return x.License
+		return x.IsEnabled
 	}
-	return ""
+	return false
 }
 
-func (x *CreateProjectRequest) GetOwnerId() string {
+func (x *ApprovalRule) GetVersion() int32 {
 	if x != nil {
-		return x.OwnerId
+		return x.Version
 	}
-	return ""
-}
-
-type CreateProjectResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *CreateProjectResponse) Reset() {
-	*x = CreateProjectResponse{}
-	mi := &file_user_service_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ApprovalRule) GetRequestType() string {
+	if x != nil {
+		return x.RequestType
+	}
+	return ""
 }
 
-func (x *CreateProjectResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ApprovalRule) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
 }
 
-func (*CreateProjectResponse) ProtoMessage() {}
-
-func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[17]
+func (x *ApprovalRule) GetAction() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Action
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
-func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{17}
+func (x *ApprovalRule) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
 }
 
-func (x *CreateProjectResponse) GetProject() *Project {
+func (x *ApprovalRule) GetCreatedBy() string {
 	if x != nil {
-		return x.Project
+		return x.CreatedBy
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateProjectResponse) GetMessage() string {
+func (x *ApprovalRule) GetUpdatedAt() string {
 	if x != nil {
-		return x.Message
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-// Request Service Messages
-type SubmitProjectRequestRequest struct {
+type CreateApprovalRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	ProjectUrl    string                 `protobuf:"bytes,2,opt,name=project_url,json=projectUrl,proto3" json:"project_url,omitempty"`
-	License       string                 `protobuf:"bytes,3,opt,name=license,proto3" json:"license,omitempty"`
-	RequesterId   string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	RequestType   string                 `protobuf:"bytes,3,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	Expression    string                 `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Target        string                 `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	IsEnabled     bool                   `protobuf:"varint,7,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitProjectRequestRequest) Reset() {
-	*x = SubmitProjectRequestRequest{}
-	mi := &file_user_service_proto_msgTypes[18]
+func (x *CreateApprovalRuleRequest) Reset() {
+	*x = CreateApprovalRuleRequest{}
+	mi := &file_user_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitProjectRequestRequest) String() string {
+func (x *CreateApprovalRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitProjectRequestRequest) ProtoMessage() {}
+func (*CreateApprovalRuleRequest) ProtoMessage() {}
 
-func (x *SubmitProjectRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[18]
+func (x *CreateApprovalRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,65 +7111,85 @@ func (x *SubmitProjectRequestRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApprovalRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateApprovalRuleRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CreateApprovalRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-// Deprecated: Use SubmitProjectRequestRequest.ProtoReflect.Descriptor instead.
-func (*SubmitProjectRequestRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{18}
+func (x *CreateApprovalRuleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *SubmitProjectRequestRequest) GetTitle() string {
+func (x *CreateApprovalRuleRequest) GetRequestType() string {
 	if x != nil {
-		return x.Title
+		return x.RequestType
 	}
 	return ""
 }
 
-func (x *SubmitProjectRequestRequest) GetProjectUrl() string {
+func (x *CreateApprovalRuleRequest) GetExpression() string {
 	if x != nil {
-		return x.ProjectUrl
+		return x.Expression
 	}
 	return ""
 }
 
-func (x *SubmitProjectRequestRequest) GetLicense() string {
+func (x *CreateApprovalRuleRequest) GetAction() string {
 	if x != nil {
-		return x.License
+		return x.Action
 	}
 	return ""
 }
 
-func (x *SubmitProjectRequestRequest) GetRequesterId() string {
+func (x *CreateApprovalRuleRequest) GetTarget() string {
 	if x != nil {
-		return x.RequesterId
+		return x.Target
 	}
 	return ""
 }
 
-type SubmitProjectRequestResponse struct {
+func (x *CreateApprovalRuleRequest) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+type CreateApprovalRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rule          *ApprovalRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitProjectRequestResponse) Reset() {
-	*x = SubmitProjectRequestResponse{}
-	mi := &file_user_service_proto_msgTypes[19]
+func (x *CreateApprovalRuleResponse) Reset() {
+	*x = CreateApprovalRuleResponse{}
+	mi := &file_user_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitProjectRequestResponse) String() string {
+func (x *CreateApprovalRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitProjectRequestResponse) ProtoMessage() {}
+func (*CreateApprovalRuleResponse) ProtoMessage() {}
 
-func (x *SubmitProjectRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[19]
+func (x *CreateApprovalRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,50 +7200,48 @@ func (x *SubmitProjectRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitProjectRequestResponse.ProtoReflect.Descriptor instead.
-func (*SubmitProjectRequestResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *SubmitProjectRequestResponse) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
+// Deprecated: Use CreateApprovalRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateApprovalRuleResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *SubmitProjectRequestResponse) GetMessage() string {
+func (x *CreateApprovalRuleResponse) GetRule() *ApprovalRule {
 	if x != nil {
-		return x.Message
+		return x.Rule
 	}
-	return ""
+	return nil
 }
 
-type SubmitPullRequestApprovalRequest struct {
+// UpdateApprovalRuleRequest replaces a rule's definition, creating a new version.
+type UpdateApprovalRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	ProjectName   string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	PrUrl         string                 `protobuf:"bytes,3,opt,name=pr_url,json=prUrl,proto3" json:"pr_url,omitempty"`
-	RequesterId   string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RequestType   string                 `protobuf:"bytes,4,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	Expression    string                 `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
+	Action        string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Target        string                 `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
+	IsEnabled     bool                   `protobuf:"varint,8,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitPullRequestApprovalRequest) Reset() {
-	*x = SubmitPullRequestApprovalRequest{}
-	mi := &file_user_service_proto_msgTypes[20]
+func (x *UpdateApprovalRuleRequest) Reset() {
+	*x = UpdateApprovalRuleRequest{}
+	mi := &file_user_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitPullRequestApprovalRequest) String() string {
+func (x *UpdateApprovalRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitPullRequestApprovalRequest) ProtoMessage() {}
+func (*UpdateApprovalRuleRequest) ProtoMessage() {}
 
-func (x *SubmitPullRequestApprovalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[20]
+func (x *UpdateApprovalRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,62 +7252,89 @@ func (x *SubmitPullRequestApprovalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitPullRequestApprovalRequest.ProtoReflect.Descriptor instead.
-func (*SubmitPullRequestApprovalRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UpdateApprovalRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApprovalRuleRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *SubmitPullRequestApprovalRequest) GetTitle() string {
+func (x *UpdateApprovalRuleRequest) GetRuleId() string {
 	if x != nil {
-		return x.Title
+		return x.RuleId
 	}
 	return ""
 }
 
-func (x *SubmitPullRequestApprovalRequest) GetProjectName() string {
+func (x *UpdateApprovalRuleRequest) GetName() string {
 	if x != nil {
-		return x.ProjectName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SubmitPullRequestApprovalRequest) GetPrUrl() string {
+func (x *UpdateApprovalRuleRequest) GetDescription() string {
 	if x != nil {
-		return x.PrUrl
+		return x.Description
 	}
 	return ""
 }
 
-func (x *SubmitPullRequestApprovalRequest) GetRequesterId() string {
+func (x *UpdateApprovalRuleRequest) GetRequestType() string {
 	if x != nil {
-		return x.RequesterId
+		return x.RequestType
 	}
 	return ""
 }
 
-type SubmitPullRequestApprovalResponse struct {
+func (x *UpdateApprovalRuleRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *UpdateApprovalRuleRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *UpdateApprovalRuleRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *UpdateApprovalRuleRequest) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+type UpdateApprovalRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rule          *ApprovalRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitPullRequestApprovalResponse) Reset() {
-	*x = SubmitPullRequestApprovalResponse{}
-	mi := &file_user_service_proto_msgTypes[21]
+func (x *UpdateApprovalRuleResponse) Reset() {
+	*x = UpdateApprovalRuleResponse{}
+	mi := &file_user_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitPullRequestApprovalResponse) String() string {
+func (x *UpdateApprovalRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitPullRequestApprovalResponse) ProtoMessage() {}
+func (*UpdateApprovalRuleResponse) ProtoMessage() {}
 
-func (x *SubmitPullRequestApprovalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[21]
+func (x *UpdateApprovalRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,50 +7345,40 @@ func (x *SubmitPullRequestApprovalResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitPullRequestApprovalResponse.ProtoReflect.Descriptor instead.
-func (*SubmitPullRequestApprovalResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *SubmitPullRequestApprovalResponse) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
+// Deprecated: Use UpdateApprovalRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateApprovalRuleResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *SubmitPullRequestApprovalResponse) GetMessage() string {
+func (x *UpdateApprovalRuleResponse) GetRule() *ApprovalRule {
 	if x != nil {
-		return x.Message
+		return x.Rule
 	}
-	return ""
+	return nil
 }
 
-type SubmitAccessRequestRequest struct {
+type ListApprovalRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	ProjectName   string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	RequesterId   string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	EnabledOnly   bool                   `protobuf:"varint,1,opt,name=enabled_only,json=enabledOnly,proto3" json:"enabled_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitAccessRequestRequest) Reset() {
-	*x = SubmitAccessRequestRequest{}
-	mi := &file_user_service_proto_msgTypes[22]
+func (x *ListApprovalRulesRequest) Reset() {
+	*x = ListApprovalRulesRequest{}
+	mi := &file_user_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitAccessRequestRequest) String() string {
+func (x *ListApprovalRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitAccessRequestRequest) ProtoMessage() {}
+func (*ListApprovalRulesRequest) ProtoMessage() {}
 
-func (x *SubmitAccessRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[22]
+func (x *ListApprovalRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,62 +7389,95 @@ func (x *SubmitAccessRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitAccessRequestRequest.ProtoReflect.Descriptor instead.
-func (*SubmitAccessRequestRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListApprovalRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalRulesRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *SubmitAccessRequestRequest) GetTitle() string {
+func (x *ListApprovalRulesRequest) GetEnabledOnly() bool {
 	if x != nil {
-		return x.Title
+		return x.EnabledOnly
 	}
-	return ""
+	return false
 }
 
-func (x *SubmitAccessRequestRequest) GetProjectName() string {
-	if x != nil {
-		return x.ProjectName
-	}
-	return ""
+type ListApprovalRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ApprovalRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitAccessRequestRequest) GetRole() string {
+func (x *ListApprovalRulesResponse) Reset() {
+	*x = ListApprovalRulesResponse{}
+	mi := &file_user_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalRulesResponse) ProtoMessage() {}
+
+func (x *ListApprovalRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[117]
 	if x != nil {
-		return x.Role
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SubmitAccessRequestRequest) GetRequesterId() string {
+// Deprecated: Use ListApprovalRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalRulesResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListApprovalRulesResponse) GetRules() []*ApprovalRule {
 	if x != nil {
-		return x.RequesterId
+		return x.Rules
 	}
-	return ""
+	return nil
 }
 
-type SubmitAccessRequestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+// DryRunPolicyRequest evaluates either a stored rule or an ad-hoc expression against past requests
+// without recording any matches.
+type DryRunPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Policy:
+	//
+	//	*DryRunPolicyRequest_RuleId
+	//	*DryRunPolicyRequest_Expression
+	Policy        isDryRunPolicyRequest_Policy `protobuf_oneof:"policy"`
+	RuleVersion   int32                        `protobuf:"varint,3,opt,name=rule_version,json=ruleVersion,proto3" json:"rule_version,omitempty"` // with rule_id; 0 uses the current version
+	RequestType   string                       `protobuf:"bytes,4,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`  // overrides the rule's request type
+	From          string                       `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`                                   // RFC 3339, inclusive
+	To            string                       `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`                                       // RFC 3339, exclusive
+	Limit         int32                        `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                                // defaults to 500, at most 5000
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitAccessRequestResponse) Reset() {
-	*x = SubmitAccessRequestResponse{}
-	mi := &file_user_service_proto_msgTypes[23]
+func (x *DryRunPolicyRequest) Reset() {
+	*x = DryRunPolicyRequest{}
+	mi := &file_user_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitAccessRequestResponse) String() string {
+func (x *DryRunPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitAccessRequestResponse) ProtoMessage() {}
+func (*DryRunPolicyRequest) ProtoMessage() {}
 
-func (x *SubmitAccessRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[23]
+func (x *DryRunPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,50 +7488,114 @@ func (x *SubmitAccessRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitAccessRequestResponse.ProtoReflect.Descriptor instead.
-func (*SubmitAccessRequestResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use DryRunPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DryRunPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *SubmitAccessRequestResponse) GetRequestId() string {
+func (x *DryRunPolicyRequest) GetPolicy() isDryRunPolicyRequest_Policy {
 	if x != nil {
-		return x.RequestId
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *DryRunPolicyRequest) GetRuleId() string {
+	if x != nil {
+		if x, ok := x.Policy.(*DryRunPolicyRequest_RuleId); ok {
+			return x.RuleId
+		}
 	}
 	return ""
 }
 
-func (x *SubmitAccessRequestResponse) GetMessage() string {
+func (x *DryRunPolicyRequest) GetExpression() string {
 	if x != nil {
-		return x.Message
+		if x, ok := x.Policy.(*DryRunPolicyRequest_Expression); ok {
+			return x.Expression
+		}
 	}
 	return ""
 }
 
-type GetRequestsRequest struct {
+func (x *DryRunPolicyRequest) GetRuleVersion() int32 {
+	if x != nil {
+		return x.RuleVersion
+	}
+	return 0
+}
+
+func (x *DryRunPolicyRequest) GetRequestType() string {
+	if x != nil {
+		return x.RequestType
+	}
+	return ""
+}
+
+func (x *DryRunPolicyRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DryRunPolicyRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *DryRunPolicyRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type isDryRunPolicyRequest_Policy interface {
+	isDryRunPolicyRequest_Policy()
+}
+
+type DryRunPolicyRequest_RuleId struct {
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3,oneof"`
+}
+
+type DryRunPolicyRequest_Expression struct {
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3,oneof"`
+}
+
+func (*DryRunPolicyRequest_RuleId) isDryRunPolicyRequest_Policy() {}
+
+func (*DryRunPolicyRequest_Expression) isDryRunPolicyRequest_Policy() {}
+
+type DryRunPolicyResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // optional filter
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"` // set when the expression failed to evaluate
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRequestsRequest) Reset() {
-	*x = GetRequestsRequest{}
-	mi := &file_user_service_proto_msgTypes[24]
+func (x *DryRunPolicyResult) Reset() {
+	*x = DryRunPolicyResult{}
+	mi := &file_user_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRequestsRequest) String() string {
+func (x *DryRunPolicyResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRequestsRequest) ProtoMessage() {}
+func (*DryRunPolicyResult) ProtoMessage() {}
 
-func (x *GetRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[24]
+func (x *DryRunPolicyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1588,62 +7606,79 @@ func (x *GetRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRequestsRequest.ProtoReflect.Descriptor instead.
-func (*GetRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DryRunPolicyResult.ProtoReflect.Descriptor instead.
+func (*DryRunPolicyResult) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetRequestsRequest) GetUserId() string {
+func (x *DryRunPolicyResult) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *DryRunPolicyResult) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DryRunPolicyResult) GetTitle() string {
 	if x != nil {
-		return x.UserId
+		return x.Title
 	}
 	return ""
 }
 
-func (x *GetRequestsRequest) GetStatus() string {
+func (x *DryRunPolicyResult) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *GetRequestsRequest) GetPage() int32 {
+func (x *DryRunPolicyResult) GetCreatedAt() string {
 	if x != nil {
-		return x.Page
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *GetRequestsRequest) GetLimit() int32 {
+func (x *DryRunPolicyResult) GetError() string {
 	if x != nil {
-		return x.Limit
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type GetRequestsResponse struct {
+type DryRunPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Requests      []*Request             `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Evaluated     int32                  `protobuf:"varint,1,opt,name=evaluated,proto3" json:"evaluated,omitempty"`
+	Matched       int32                  `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`
+	Errors        int32                  `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	Matches       []*DryRunPolicyResult  `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"`
+	Failures      []*DryRunPolicyResult  `protobuf:"bytes,5,rep,name=failures,proto3" json:"failures,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRequestsResponse) Reset() {
-	*x = GetRequestsResponse{}
-	mi := &file_user_service_proto_msgTypes[25]
+func (x *DryRunPolicyResponse) Reset() {
+	*x = DryRunPolicyResponse{}
+	mi := &file_user_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRequestsResponse) String() string {
+func (x *DryRunPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRequestsResponse) ProtoMessage() {}
+func (*DryRunPolicyResponse) ProtoMessage() {}
 
-func (x *GetRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[25]
+func (x *DryRunPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1654,48 +7689,72 @@ func (x *GetRequestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRequestsResponse.ProtoReflect.Descriptor instead.
-func (*GetRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DryRunPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DryRunPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *GetRequestsResponse) GetRequests() []*Request {
+func (x *DryRunPolicyResponse) GetEvaluated() int32 {
 	if x != nil {
-		return x.Requests
+		return x.Evaluated
 	}
-	return nil
+	return 0
 }
 
-func (x *GetRequestsResponse) GetTotal() int32 {
+func (x *DryRunPolicyResponse) GetMatched() int32 {
 	if x != nil {
-		return x.Total
+		return x.Matched
 	}
 	return 0
 }
 
-// New messages for approved projects list
-type GetApprovedProjectsListRequest struct {
+func (x *DryRunPolicyResponse) GetErrors() int32 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *DryRunPolicyResponse) GetMatches() []*DryRunPolicyResult {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *DryRunPolicyResponse) GetFailures() []*DryRunPolicyResult {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type ListAutoApprovedRequestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActiveOnly    bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // RFC 3339, inclusive, on approval time
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`     // RFC 3339, exclusive
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApprovedProjectsListRequest) Reset() {
-	*x = GetApprovedProjectsListRequest{}
-	mi := &file_user_service_proto_msgTypes[26]
+func (x *ListAutoApprovedRequestsRequest) Reset() {
+	*x = ListAutoApprovedRequestsRequest{}
+	mi := &file_user_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApprovedProjectsListRequest) String() string {
+func (x *ListAutoApprovedRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApprovedProjectsListRequest) ProtoMessage() {}
+func (*ListAutoApprovedRequestsRequest) ProtoMessage() {}
 
-func (x *GetApprovedProjectsListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[26]
+func (x *ListAutoApprovedRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,40 +7765,78 @@ func (x *GetApprovedProjectsListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApprovedProjectsListRequest.ProtoReflect.Descriptor instead.
-func (*GetApprovedProjectsListRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ListAutoApprovedRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListAutoApprovedRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GetApprovedProjectsListRequest) GetActiveOnly() bool {
+func (x *ListAutoApprovedRequestsRequest) GetRuleId() string {
 	if x != nil {
-		return x.ActiveOnly
+		return x.RuleId
 	}
-	return false
+	return ""
 }
 
-type GetApprovedProjectsListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*ApprovedProject     `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListAutoApprovedRequestsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
 }
 
-func (x *GetApprovedProjectsListResponse) Reset() {
-	*x = GetApprovedProjectsListResponse{}
-	mi := &file_user_service_proto_msgTypes[27]
+func (x *ListAutoApprovedRequestsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListAutoApprovedRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAutoApprovedRequestsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AutoApprovedRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RequestId         string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	RequesterId       string                 `protobuf:"bytes,3,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	ApprovedProjectId string                 `protobuf:"bytes,4,opt,name=approved_project_id,json=approvedProjectId,proto3" json:"approved_project_id,omitempty"`
+	ContributionKind  string                 `protobuf:"bytes,5,opt,name=contribution_kind,json=contributionKind,proto3" json:"contribution_kind,omitempty"`
+	RuleId            string                 `protobuf:"bytes,6,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName          string                 `protobuf:"bytes,7,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	RuleVersion       int32                  `protobuf:"varint,8,opt,name=rule_version,json=ruleVersion,proto3" json:"rule_version,omitempty"`
+	DecisionReason    string                 `protobuf:"bytes,9,opt,name=decision_reason,json=decisionReason,proto3" json:"decision_reason,omitempty"`
+	ApprovedAt        string                 `protobuf:"bytes,10,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AutoApprovedRequest) Reset() {
+	*x = AutoApprovedRequest{}
+	mi := &file_user_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApprovedProjectsListResponse) String() string {
+func (x *AutoApprovedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApprovedProjectsListResponse) ProtoMessage() {}
+func (*AutoApprovedRequest) ProtoMessage() {}
 
-func (x *GetApprovedProjectsListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[27]
+func (x *AutoApprovedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1750,110 +7847,111 @@ func (x *GetApprovedProjectsListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApprovedProjectsListResponse.ProtoReflect.Descriptor instead.
-func (*GetApprovedProjectsListResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use AutoApprovedRequest.ProtoReflect.Descriptor instead.
+func (*AutoApprovedRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *GetApprovedProjectsListResponse) GetProjects() []*ApprovedProject {
+func (x *AutoApprovedRequest) GetRequestId() string {
 	if x != nil {
-		return x.Projects
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-// New messages for contribution permission requests
-type SubmitContributionPermissionRequestRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Title                 string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	ApprovedProjectId     string                 `protobuf:"bytes,2,opt,name=approved_project_id,json=approvedProjectId,proto3" json:"approved_project_id,omitempty"`
-	BusinessJustification string                 `protobuf:"bytes,3,opt,name=business_justification,json=businessJustification,proto3" json:"business_justification,omitempty"`
-	RequesterId           string                 `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *AutoApprovedRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *SubmitContributionPermissionRequestRequest) Reset() {
-	*x = SubmitContributionPermissionRequestRequest{}
-	mi := &file_user_service_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AutoApprovedRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
 }
 
-func (x *SubmitContributionPermissionRequestRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AutoApprovedRequest) GetApprovedProjectId() string {
+	if x != nil {
+		return x.ApprovedProjectId
+	}
+	return ""
 }
 
-func (*SubmitContributionPermissionRequestRequest) ProtoMessage() {}
-
-func (x *SubmitContributionPermissionRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[28]
+func (x *AutoApprovedRequest) GetContributionKind() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ContributionKind
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SubmitContributionPermissionRequestRequest.ProtoReflect.Descriptor instead.
-func (*SubmitContributionPermissionRequestRequest) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{28}
+func (x *AutoApprovedRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
 }
 
-func (x *SubmitContributionPermissionRequestRequest) GetTitle() string {
+func (x *AutoApprovedRequest) GetRuleName() string {
 	if x != nil {
-		return x.Title
+		return x.RuleName
 	}
 	return ""
 }
 
-func (x *SubmitContributionPermissionRequestRequest) GetApprovedProjectId() string {
+func (x *AutoApprovedRequest) GetRuleVersion() int32 {
 	if x != nil {
-		return x.ApprovedProjectId
+		return x.RuleVersion
+	}
+	return 0
+}
+
+func (x *AutoApprovedRequest) GetDecisionReason() string {
+	if x != nil {
+		return x.DecisionReason
 	}
 	return ""
 }
 
-func (x *SubmitContributionPermissionRequestRequest) GetBusinessJustification() string {
+func (x *AutoApprovedRequest) GetApprovedAt() string {
 	if x != nil {
-		return x.BusinessJustification
+		return x.ApprovedAt
 	}
 	return ""
 }
 
-func (x *SubmitContributionPermissionRequestRequest) GetRequesterId() string {
+func (x *AutoApprovedRequest) GetCreatedAt() string {
 	if x != nil {
-		return x.RequesterId
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type SubmitContributionPermissionRequestResponse struct {
+type ListAutoApprovedRequestsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Requests      []*AutoApprovedRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitContributionPermissionRequestResponse) Reset() {
-	*x = SubmitContributionPermissionRequestResponse{}
-	mi := &file_user_service_proto_msgTypes[29]
+func (x *ListAutoApprovedRequestsResponse) Reset() {
+	*x = ListAutoApprovedRequestsResponse{}
+	mi := &file_user_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitContributionPermissionRequestResponse) String() string {
+func (x *ListAutoApprovedRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitContributionPermissionRequestResponse) ProtoMessage() {}
+func (*ListAutoApprovedRequestsResponse) ProtoMessage() {}
 
-func (x *SubmitContributionPermissionRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[29]
+func (x *ListAutoApprovedRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1864,23 +7962,23 @@ func (x *SubmitContributionPermissionRequestResponse) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitContributionPermissionRequestResponse.ProtoReflect.Descriptor instead.
-func (*SubmitContributionPermissionRequestResponse) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListAutoApprovedRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListAutoApprovedRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *SubmitContributionPermissionRequestResponse) GetRequestId() string {
+func (x *ListAutoApprovedRequestsResponse) GetRequests() []*AutoApprovedRequest {
 	if x != nil {
-		return x.RequestId
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-func (x *SubmitContributionPermissionRequestResponse) GetMessage() string {
+func (x *ListAutoApprovedRequestsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Message
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
 var File_user_service_proto protoreflect.FileDescriptor
@@ -1896,7 +7994,7 @@ const file_user_service_proto_rawDesc = "" +
 	"\rlast_activity\x18\x05 \x01(\tR\flastActivity\x12\x10\n" +
 	"\x03url\x18\x06 \x01(\tR\x03url\x12\x18\n" +
 	"\alicense\x18\a \x01(\tR\alicense\x12\x19\n" +
-	"\bowner_id\x18\b \x01(\tR\aownerId\"\xf4\x02\n" +
+	"\bowner_id\x18\b \x01(\tR\aownerId\"\xfa\x03\n" +
 	"\x0fApprovedProject\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1908,7 +8006,20 @@ const file_user_service_proto_rawDesc = "" +
 	"\rapproval_date\x18\b \x01(\tR\fapprovalDate\x12\x1b\n" +
 	"\tis_active\x18\t \x01(\bR\bisActive\x12<\n" +
 	"\x1aallowed_contribution_types\x18\n" +
-	" \x03(\tR\x18allowedContributionTypes\"\xb0\x01\n" +
+	" \x03(\tR\x18allowedContributionTypes\x120\n" +
+	"\x14review_interval_days\x18\v \x01(\x05R\x12reviewIntervalDays\x12(\n" +
+	"\x10next_review_date\x18\f \x01(\tR\x0enextReviewDate\x12(\n" +
+	"\x10last_reviewed_at\x18\r \x01(\tR\x0elastReviewedAt\"\x85\x02\n" +
+	"\x13RecertificationTask\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13approved_project_id\x18\x02 \x01(\tR\x11approvedProjectId\x12!\n" +
+	"\fproject_name\x18\x03 \x01(\tR\vprojectName\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x19\n" +
+	"\bdue_date\x18\x05 \x01(\tR\adueDate\x12\x1f\n" +
+	"\vreviewer_id\x18\x06 \x01(\tR\n" +
+	"reviewerId\x12\x14\n" +
+	"\x05notes\x18\a \x01(\tR\x05notes\x12!\n" +
+	"\fcompleted_at\x18\b \x01(\tR\vcompletedAt\"\xa0\x03\n" +
 	"\x04User\x12!\n" +
 	"\fcorporate_id\x18\x01 \x01(\tR\vcorporateId\x12'\n" +
 	"\x0fgithub_username\x18\x02 \x01(\tR\x0egithubUsername\x12\x12\n" +
@@ -1917,7 +8028,17 @@ const file_user_service_proto_rawDesc = "" +
 	"department\x18\x04 \x01(\tR\n" +
 	"department\x12\x14\n" +
 	"\x05email\x18\x05 \x01(\tR\x05email\x12\x12\n" +
-	"\x04role\x18\x06 \x01(\tR\x04role\"\x8f\x02\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\x12\x0e\n" +
+	"\x02id\x18\a \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\b \x01(\tR\tmanagerId\x12\x1b\n" +
+	"\tis_active\x18\t \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12,\n" +
+	"\x12is_service_account\x18\v \x01(\bR\x10isServiceAccount\x12'\n" +
+	"\x0fgithub_verified\x18\f \x01(\bR\x0egithubVerified\x12,\n" +
+	"\x12github_verified_at\x18\r \x01(\tR\x10githubVerifiedAt\"\xb0\x02\n" +
 	"\aRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
@@ -1931,22 +8052,316 @@ const file_user_service_proto_rawDesc = "" +
 	"projectUrl\x12\x18\n" +
 	"\alicense\x18\t \x01(\tR\alicense\x12\x12\n" +
 	"\x04role\x18\n" +
-	" \x01(\tR\x04role\"h\n" +
+	" \x01(\tR\x04role\x12\x1f\n" +
+	"\vreviewer_id\x18\v \x01(\tR\n" +
+	"reviewerId\"\xbc\x02\n" +
+	"\fContribution\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12.\n" +
+	"\x13approved_project_id\x18\x03 \x01(\tR\x11approvedProjectId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04kind\x18\x05 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12\x18\n" +
+	"\aoutcome\x18\b \x01(\tR\aoutcome\x12%\n" +
+	"\x0econtributed_at\x18\t \x01(\tR\rcontributedAt\x12\x1f\n" +
+	"\vresolved_at\x18\n" +
+	" \x01(\tR\n" +
+	"resolvedAt\x12\x16\n" +
+	"\x06source\x18\v \x01(\tR\x06source\"\xe6\x01\n" +
+	"\x13ContributionSummary\x12.\n" +
+	"\x13approved_project_id\x18\x01 \x01(\tR\x11approvedProjectId\x12!\n" +
+	"\fproject_name\x18\x02 \x01(\tR\vprojectName\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x16\n" +
+	"\x06merged\x18\x04 \x01(\x05R\x06merged\x12\x16\n" +
+	"\x06closed\x18\x05 \x01(\x05R\x06closed\x12\x12\n" +
+	"\x04open\x18\x06 \x01(\x05R\x04open\x12\"\n" +
+	"\fcontributors\x18\a \x01(\x05R\fcontributors\"\xcd\x01\n" +
+	"\x17TeamContributionSummary\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x16\n" +
+	"\x06merged\x18\x04 \x01(\x05R\x06merged\x12\x16\n" +
+	"\x06closed\x18\x05 \x01(\x05R\x06closed\x12\x12\n" +
+	"\x04open\x18\x06 \x01(\x05R\x04open\x12\"\n" +
+	"\fcontributors\x18\a \x01(\x05R\fcontributors\"\xbb\x01\n" +
 	"\x1aRegisterContributorRequest\x12!\n" +
 	"\fcorporate_id\x18\x01 \x01(\tR\vcorporateId\x12'\n" +
-	"\x0fgithub_username\x18\x02 \x01(\tR\x0egithubUsername\"7\n" +
+	"\x0fgithub_username\x18\x02 \x01(\tR\x0egithubUsername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1b\n" +
+	"\tfull_name\x18\x04 \x01(\tR\bfullName\x12\x1e\n" +
+	"\n" +
+	"department\x18\x05 \x01(\tR\n" +
+	"department\"t\n" +
 	"\x1bRegisterContributorResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\":\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12!\n" +
+	"\x04user\x18\x02 \x01(\v2\r.backend.UserR\x04user\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\bR\acreated\":\n" +
 	"\x15GetContributorRequest\x12!\n" +
-	"\fcorporate_id\x18\x01 \x01(\tR\vcorporateId\"\x91\x01\n" +
+	"\fcorporate_id\x18\x01 \x01(\tR\vcorporateId\"\xcc\x01\n" +
+	"\x10ContributorGrant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12!\n" +
+	"\fproject_name\x18\x04 \x01(\tR\vprojectName\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x1f\n" +
+	"\vapproved_at\x18\x06 \x01(\tR\n" +
+	"approvedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\"\xb2\x01\n" +
 	"\x16GetContributorResponse\x12!\n" +
 	"\fcorporate_id\x18\x01 \x01(\tR\vcorporateId\x12'\n" +
-	"\x0fgithub_username\x18\x02 \x01(\tR\x0egithubUsername\x12+\n" +
-	"\x11approved_projects\x18\x03 \x03(\tR\x10approvedProjects\":\n" +
+	"\x0fgithub_username\x18\x02 \x01(\tR\x0egithubUsername\x12F\n" +
+	"\x11approved_projects\x18\x04 \x03(\v2\x19.backend.ContributorGrantR\x10approvedProjectsJ\x04\b\x03\x10\x04\":\n" +
 	"\x15GetUserProfileRequest\x12!\n" +
-	"\fcorporate_id\x18\x01 \x01(\tR\vcorporateId\";\n" +
+	"\fcorporate_id\x18\x01 \x01(\tR\vcorporateId\"\xf1\x02\n" +
+	"\tUserStats\x12V\n" +
+	"\x12requests_by_status\x18\x01 \x03(\v2(.backend.UserStats.RequestsByStatusEntryR\x10requestsByStatus\x12%\n" +
+	"\x0etotal_requests\x18\x02 \x01(\x05R\rtotalRequests\x12%\n" +
+	"\x0eowned_projects\x18\x03 \x01(\x05R\rownedProjects\x121\n" +
+	"\x14contributed_projects\x18\x04 \x01(\x05R\x13contributedProjects\x12F\n" +
+	"\x1factive_contribution_permissions\x18\x05 \x01(\x05R\x1dactiveContributionPermissions\x1aC\n" +
+	"\x15RequestsByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"e\n" +
 	"\x16GetUserProfileResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.backend.UserR\x04user\"_\n" +
+	"\x04user\x18\x01 \x01(\v2\r.backend.UserR\x04user\x12(\n" +
+	"\x05stats\x18\x02 \x01(\v2\x12.backend.UserStatsR\x05stats\"i\n" +
+	"\x13OffboardUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fsuccessor_id\x18\x02 \x01(\tR\vsuccessorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"J\n" +
+	"\x14OffboardUserResponse\x122\n" +
+	"\x06report\x18\x01 \x01(\v2\x1a.backend.OffboardingReportR\x06report\"6\n" +
+	"\x1bGetOffboardingReportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"R\n" +
+	"\x1cGetOffboardingReportResponse\x122\n" +
+	"\x06report\x18\x01 \x01(\v2\x1a.backend.OffboardingReportR\x06report\"M\n" +
+	"\x0fOffboardingItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"\xc2\x04\n" +
+	"\x11OffboardingReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fsuccessor_id\x18\x03 \x01(\tR\vsuccessorId\x12!\n" +
+	"\finitiated_by\x18\x04 \x01(\tR\vinitiatedBy\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12K\n" +
+	"\x14transferred_projects\x18\a \x03(\v2\x18.backend.OffboardingItemR\x13transferredProjects\x12O\n" +
+	"\x16untransferred_projects\x18\b \x03(\v2\x18.backend.OffboardingItemR\x15untransferredProjects\x12?\n" +
+	"\x0erevoked_access\x18\t \x03(\v2\x18.backend.OffboardingItemR\rrevokedAccess\x12G\n" +
+	"\x12cancelled_requests\x18\n" +
+	" \x03(\v2\x18.backend.OffboardingItemR\x11cancelledRequests\x12G\n" +
+	"\x12reassigned_reviews\x18\v \x03(\v2\x18.backend.OffboardingItemR\x11reassignedReviews\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\"S\n" +
+	"\x18SetDepartmentLeadRequest\x12\x1e\n" +
+	"\n" +
+	"department\x18\x01 \x01(\tR\n" +
+	"department\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"5\n" +
+	"\x19SetDepartmentLeadResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xea\x01\n" +
+	"\x10ListUsersRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1e\n" +
+	"\n" +
+	"department\x18\x04 \x01(\tR\n" +
+	"department\x12 \n" +
+	"\tis_active\x18\x05 \x01(\bH\x00R\bisActive\x88\x01\x01\x121\n" +
+	"\x12is_service_account\x18\x06 \x01(\bH\x01R\x10isServiceAccount\x88\x01\x01B\f\n" +
+	"\n" +
+	"_is_activeB\x15\n" +
+	"\x13_is_service_account\"N\n" +
+	"\x11ListUsersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.backend.UserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xb8\x01\n" +
+	"\x12SearchUsersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1e\n" +
+	"\n" +
+	"department\x18\x05 \x01(\tR\n" +
+	"department\x12 \n" +
+	"\tis_active\x18\x06 \x01(\bH\x00R\bisActive\x88\x01\x01B\f\n" +
+	"\n" +
+	"_is_active\"P\n" +
+	"\x13SearchUsersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.backend.UserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xf7\x01\n" +
+	"\x11UpdateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\tfull_name\x18\x02 \x01(\tH\x00R\bfullName\x88\x01\x01\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tH\x01R\x05email\x88\x01\x01\x12#\n" +
+	"\n" +
+	"department\x18\x04 \x01(\tH\x02R\n" +
+	"department\x88\x01\x01\x12,\n" +
+	"\x0fgithub_username\x18\x05 \x01(\tH\x03R\x0egithubUsername\x88\x01\x01B\f\n" +
+	"\n" +
+	"_full_nameB\b\n" +
+	"\x06_emailB\r\n" +
+	"\v_departmentB\x12\n" +
+	"\x10_github_username\"7\n" +
+	"\x12UpdateUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.backend.UserR\x04user\"A\n" +
+	"\x12SetUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"8\n" +
+	"\x13SetUserRoleResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.backend.UserR\x04user\"k\n" +
+	"\x15DeactivateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fsuccessor_id\x18\x02 \x01(\tR\vsuccessorId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"o\n" +
+	"\x16DeactivateUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.backend.UserR\x04user\x122\n" +
+	"\x06report\x18\x02 \x01(\v2\x1a.backend.OffboardingReportR\x06report\"0\n" +
+	"\x15ReactivateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\";\n" +
+	"\x16ReactivateUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.backend.UserR\x04user\"\xbc\x01\n" +
+	"\x1bCreateServiceAccountRequest\x12!\n" +
+	"\fcorporate_id\x18\x01 \x01(\tR\vcorporateId\x12\x1b\n" +
+	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x1e\n" +
+	"\n" +
+	"department\x18\x03 \x01(\tR\n" +
+	"department\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12'\n" +
+	"\x0fgithub_username\x18\x05 \x01(\tR\x0egithubUsername\"A\n" +
+	"\x1cCreateServiceAccountResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.backend.UserR\x04user\"\x95\x02\n" +
+	"\bAPIToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12 \n" +
+	"\flast_used_at\x18\a \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\tR\trevokedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"\x84\x01\n" +
+	"\x15CreateAPITokenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12&\n" +
+	"\x0fexpires_in_days\x18\x04 \x01(\x05R\rexpiresInDays\"Y\n" +
+	"\x16CreateAPITokenResponse\x12'\n" +
+	"\x05token\x18\x01 \x01(\v2\x11.backend.APITokenR\x05token\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"X\n" +
+	"\x14ListAPITokensRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0finclude_revoked\x18\x02 \x01(\bR\x0eincludeRevoked\"B\n" +
+	"\x15ListAPITokensResponse\x12)\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x11.backend.APITokenR\x06tokens\"K\n" +
+	"\x15RevokeAPITokenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\"A\n" +
+	"\x16RevokeAPITokenResponse\x12'\n" +
+	"\x05token\x18\x01 \x01(\v2\x11.backend.APITokenR\x05token\"\xc1\x02\n" +
+	"\x12GithubVerification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fgithub_username\x18\x02 \x01(\tR\x0egithubUsername\x12\x1b\n" +
+	"\tuser_code\x18\x03 \x01(\tR\buserCode\x12)\n" +
+	"\x10verification_uri\x18\x04 \x01(\tR\x0fverificationUri\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\x12)\n" +
+	"\x10interval_seconds\x18\x06 \x01(\x05R\x0fintervalSeconds\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12%\n" +
+	"\x0efailure_reason\x18\b \x01(\tR\rfailureReason\x12!\n" +
+	"\fcompleted_at\x18\t \x01(\tR\vcompletedAt\"9\n" +
+	"\x1eStartGithubVerificationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"b\n" +
+	"\x1fStartGithubVerificationResponse\x12?\n" +
+	"\fverification\x18\x01 \x01(\v2\x1b.backend.GithubVerificationR\fverification\"e\n" +
+	"!CompleteGithubVerificationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fverification_id\x18\x02 \x01(\tR\x0everificationId\"\x88\x01\n" +
+	"\"CompleteGithubVerificationResponse\x12?\n" +
+	"\fverification\x18\x01 \x01(\v2\x1b.backend.GithubVerificationR\fverification\x12!\n" +
+	"\x04user\x18\x02 \x01(\v2\r.backend.UserR\x04user\"\x8d\x01\n" +
+	"\n" +
+	"Department\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\alead_id\x18\x03 \x01(\tR\x06leadId\x12(\n" +
+	"\x10ospo_champion_id\x18\x04 \x01(\tR\x0eospoChampionId\x12\x18\n" +
+	"\amembers\x18\x05 \x01(\x05R\amembers\"\x8e\x01\n" +
+	"\x04Team\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rdepartment_id\x18\x03 \x01(\tR\fdepartmentId\x12\x1e\n" +
+	"\n" +
+	"department\x18\x04 \x01(\tR\n" +
+	"department\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x05 \x03(\tR\tmemberIds\"W\n" +
+	"\x1cSetDepartmentChampionRequest\x12\x1e\n" +
+	"\n" +
+	"department\x18\x01 \x01(\tR\n" +
+	"department\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"T\n" +
+	"\x1dSetDepartmentChampionResponse\x123\n" +
+	"\n" +
+	"department\x18\x01 \x01(\v2\x13.backend.DepartmentR\n" +
+	"department\"\x18\n" +
+	"\x16ListDepartmentsRequest\"P\n" +
+	"\x17ListDepartmentsResponse\x125\n" +
+	"\vdepartments\x18\x01 \x03(\v2\x13.backend.DepartmentR\vdepartments\"G\n" +
+	"\x11CreateTeamRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"department\x18\x02 \x01(\tR\n" +
+	"department\"7\n" +
+	"\x12CreateTeamResponse\x12!\n" +
+	"\x04team\x18\x01 \x01(\v2\r.backend.TeamR\x04team\")\n" +
+	"\x0eGetTeamRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\"4\n" +
+	"\x0fGetTeamResponse\x12!\n" +
+	"\x04team\x18\x01 \x01(\v2\r.backend.TeamR\x04team\"K\n" +
+	"\x10ListTeamsRequest\x12\x1e\n" +
+	"\n" +
+	"department\x18\x01 \x01(\tR\n" +
+	"department\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"8\n" +
+	"\x11ListTeamsResponse\x12#\n" +
+	"\x05teams\x18\x01 \x03(\v2\r.backend.TeamR\x05teams\"H\n" +
+	"\x14AddTeamMemberRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\":\n" +
+	"\x15AddTeamMemberResponse\x12!\n" +
+	"\x04team\x18\x01 \x01(\v2\r.backend.TeamR\x04team\"K\n" +
+	"\x17RemoveTeamMemberRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"=\n" +
+	"\x18RemoveTeamMemberResponse\x12!\n" +
+	"\x04team\x18\x01 \x01(\v2\r.backend.TeamR\x04team\"K\n" +
+	"\x11SetManagerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x02 \x01(\tR\tmanagerId\"7\n" +
+	"\x12SetManagerResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.backend.UserR\x04user\")\n" +
+	"\x15ImportOrgChartRequest\x12\x10\n" +
+	"\x03csv\x18\x01 \x01(\fR\x03csv\"f\n" +
+	"\x13OrgChartImportError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12!\n" +
+	"\fcorporate_id\x18\x02 \x01(\tR\vcorporateId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"j\n" +
+	"\x16ImportOrgChartResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x124\n" +
+	"\x06errors\x18\x02 \x03(\v2\x1c.backend.OrgChartImportErrorR\x06errors\"_\n" +
 	"\x1aGetAuthoredProjectsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
@@ -2012,37 +8427,278 @@ const file_user_service_proto_rawDesc = "" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\"Y\n" +
 	"\x13GetRequestsResponse\x12,\n" +
 	"\brequests\x18\x01 \x03(\v2\x10.backend.RequestR\brequests\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"A\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xcf\x02\n" +
 	"\x1eGetApprovedProjectsListRequest\x12\x1f\n" +
 	"\vactive_only\x18\x01 \x01(\bR\n" +
-	"activeOnly\"W\n" +
+	"activeOnly\x12\x18\n" +
+	"\alicense\x18\x02 \x01(\tR\alicense\x12+\n" +
+	"\x11contribution_type\x18\x03 \x01(\tR\x10contributionType\x12:\n" +
+	"\x19allowed_contribution_type\x18\x04 \x01(\tR\x17allowedContributionType\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x12\x17\n" +
+	"\asort_by\x18\x06 \x01(\tR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\a \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\tpage_size\x18\b \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\t \x01(\tR\tpageToken\"\x7f\n" +
 	"\x1fGetApprovedProjectsListResponse\x124\n" +
-	"\bprojects\x18\x01 \x03(\v2\x18.backend.ApprovedProjectR\bprojects\"\xcc\x01\n" +
+	"\bprojects\x18\x01 \x03(\v2\x18.backend.ApprovedProjectR\bprojects\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf9\x01\n" +
 	"*SubmitContributionPermissionRequestRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12.\n" +
 	"\x13approved_project_id\x18\x02 \x01(\tR\x11approvedProjectId\x125\n" +
 	"\x16business_justification\x18\x03 \x01(\tR\x15businessJustification\x12!\n" +
-	"\frequester_id\x18\x04 \x01(\tR\vrequesterId\"f\n" +
+	"\frequester_id\x18\x04 \x01(\tR\vrequesterId\x12+\n" +
+	"\x11contribution_kind\x18\x05 \x01(\tR\x10contributionKind\"\xa7\x01\n" +
 	"+SubmitContributionPermissionRequestResponse\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x95\x02\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12'\n" +
+	"\x0fdecision_reason\x18\x04 \x01(\tR\x0edecisionReason\"c\n" +
+	"\x1fListRecertificationTasksRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"V\n" +
+	" ListRecertificationTasksResponse\x122\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x1c.backend.RecertificationTaskR\x05tasks\"\x8c\x01\n" +
+	"\x1eCompleteRecertificationRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12\x1a\n" +
+	"\bdecision\x18\x03 \x01(\tR\bdecision\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\"f\n" +
+	"\x1fCompleteRecertificationResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12)\n" +
+	"\x10flagged_requests\x18\x02 \x01(\x05R\x0fflaggedRequests\"j\n" +
+	"\x1cAddProjectContributorRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"9\n" +
+	"\x1dAddProjectContributorResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"Y\n" +
+	"\x1fRemoveProjectContributorRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"<\n" +
+	" RemoveProjectContributorResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xd8\x01\n" +
+	"\x19RecordContributionRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\aoutcome\x18\x05 \x01(\tR\aoutcome\x12%\n" +
+	"\x0econtributed_at\x18\x06 \x01(\tR\rcontributedAt\x12\x1f\n" +
+	"\vresolved_at\x18\a \x01(\tR\n" +
+	"resolvedAt\"q\n" +
+	"\x1aRecordContributionResponse\x129\n" +
+	"\fcontribution\x18\x01 \x01(\v2\x15.backend.ContributionR\fcontribution\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Z\n" +
+	"\x1aImportContributionsRequest\x12<\n" +
+	"\aentries\x18\x01 \x03(\v2\".backend.RecordContributionRequestR\aentries\"[\n" +
+	"\x17ContributionImportError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"s\n" +
+	"\x1bImportContributionsResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x128\n" +
+	"\x06errors\x18\x02 \x03(\v2 .backend.ContributionImportErrorR\x06errors\"\xea\x01\n" +
+	"\x18ListContributionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x13approved_project_id\x18\x02 \x01(\tR\x11approvedProjectId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\tR\x02to\x12\x12\n" +
+	"\x04page\x18\a \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\"X\n" +
+	"\x19ListContributionsResponse\x12;\n" +
+	"\rcontributions\x18\x01 \x03(\v2\x15.backend.ContributionR\rcontributions\"M\n" +
+	"\x1cGetContributionReportRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x19\n" +
+	"\bgroup_by\x18\x02 \x01(\tR\agroupBy\"\xd3\x01\n" +
+	"\x1dGetContributionReportResponse\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x128\n" +
+	"\bprojects\x18\x02 \x03(\v2\x1c.backend.ContributionSummaryR\bprojects\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x16\n" +
+	"\x06merged\x18\x04 \x01(\x05R\x06merged\x126\n" +
+	"\x05teams\x18\x05 \x03(\v2 .backend.TeamContributionSummaryR\x05teams\"\x9c\x01\n" +
+	"\x10PullRequestEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x19\n" +
+	"\bhead_sha\x18\x04 \x01(\tR\aheadSha\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\tR\n" +
+	"occurredAt\"=\n" +
+	"\x1cListPullRequestEventsRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"R\n" +
+	"\x1dListPullRequestEventsResponse\x121\n" +
+	"\x06events\x18\x01 \x03(\v2\x19.backend.PullRequestEventR\x06events\"\xbe\x02\n" +
+	"\fApprovalRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x04 \x01(\bR\tisEnabled\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x12!\n" +
+	"\frequest_type\x18\x06 \x01(\tR\vrequestType\x12\x1e\n" +
+	"\n" +
+	"expression\x18\a \x01(\tR\n" +
+	"expression\x12\x16\n" +
+	"\x06action\x18\b \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\t \x01(\tR\x06target\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\"\xe3\x01\n" +
+	"\x19CreateApprovalRuleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12!\n" +
+	"\frequest_type\x18\x03 \x01(\tR\vrequestType\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x04 \x01(\tR\n" +
+	"expression\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x06 \x01(\tR\x06target\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\a \x01(\bR\tisEnabled\"G\n" +
+	"\x1aCreateApprovalRuleResponse\x12)\n" +
+	"\x04rule\x18\x01 \x01(\v2\x15.backend.ApprovalRuleR\x04rule\"\xfc\x01\n" +
+	"\x19UpdateApprovalRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
+	"\frequest_type\x18\x04 \x01(\tR\vrequestType\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x05 \x01(\tR\n" +
+	"expression\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\a \x01(\tR\x06target\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\b \x01(\bR\tisEnabled\"G\n" +
+	"\x1aUpdateApprovalRuleResponse\x12)\n" +
+	"\x04rule\x18\x01 \x01(\v2\x15.backend.ApprovalRuleR\x04rule\"=\n" +
+	"\x18ListApprovalRulesRequest\x12!\n" +
+	"\fenabled_only\x18\x01 \x01(\bR\venabledOnly\"H\n" +
+	"\x19ListApprovalRulesResponse\x12+\n" +
+	"\x05rules\x18\x01 \x03(\v2\x15.backend.ApprovalRuleR\x05rules\"\xdc\x01\n" +
+	"\x13DryRunPolicyRequest\x12\x19\n" +
+	"\arule_id\x18\x01 \x01(\tH\x00R\x06ruleId\x12 \n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tH\x00R\n" +
+	"expression\x12!\n" +
+	"\frule_version\x18\x03 \x01(\x05R\vruleVersion\x12!\n" +
+	"\frequest_type\x18\x04 \x01(\tR\vrequestType\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\tR\x02to\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limitB\b\n" +
+	"\x06policy\"\xaa\x01\n" +
+	"\x12DryRunPolicyResult\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xd6\x01\n" +
+	"\x14DryRunPolicyResponse\x12\x1c\n" +
+	"\tevaluated\x18\x01 \x01(\x05R\tevaluated\x12\x18\n" +
+	"\amatched\x18\x02 \x01(\x05R\amatched\x12\x16\n" +
+	"\x06errors\x18\x03 \x01(\x05R\x06errors\x125\n" +
+	"\amatches\x18\x04 \x03(\v2\x1b.backend.DryRunPolicyResultR\amatches\x127\n" +
+	"\bfailures\x18\x05 \x03(\v2\x1b.backend.DryRunPolicyResultR\bfailures\"\x88\x01\n" +
+	"\x1fListAutoApprovedRequestsRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x8c\x03\n" +
+	"\x13AutoApprovedRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
+	"\frequester_id\x18\x03 \x01(\tR\vrequesterId\x12.\n" +
+	"\x13approved_project_id\x18\x04 \x01(\tR\x11approvedProjectId\x12+\n" +
+	"\x11contribution_kind\x18\x05 \x01(\tR\x10contributionKind\x12\x17\n" +
+	"\arule_id\x18\x06 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\a \x01(\tR\bruleName\x12!\n" +
+	"\frule_version\x18\b \x01(\x05R\vruleVersion\x12'\n" +
+	"\x0fdecision_reason\x18\t \x01(\tR\x0edecisionReason\x12\x1f\n" +
+	"\vapproved_at\x18\n" +
+	" \x01(\tR\n" +
+	"approvedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"r\n" +
+	" ListAutoApprovedRequestsResponse\x128\n" +
+	"\brequests\x18\x01 \x03(\v2\x1c.backend.AutoApprovedRequestR\brequests\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total2\xf2\x11\n" +
 	"\vUserService\x12`\n" +
 	"\x13RegisterContributor\x12#.backend.RegisterContributorRequest\x1a$.backend.RegisterContributorResponse\x12Q\n" +
 	"\x0eGetContributor\x12\x1e.backend.GetContributorRequest\x1a\x1f.backend.GetContributorResponse\x12Q\n" +
-	"\x0eGetUserProfile\x12\x1e.backend.GetUserProfileRequest\x1a\x1f.backend.GetUserProfileResponse2\xfd\x03\n" +
+	"\x0eGetUserProfile\x12\x1e.backend.GetUserProfileRequest\x1a\x1f.backend.GetUserProfileResponse\x12K\n" +
+	"\fOffboardUser\x12\x1c.backend.OffboardUserRequest\x1a\x1d.backend.OffboardUserResponse\x12c\n" +
+	"\x14GetOffboardingReport\x12$.backend.GetOffboardingReportRequest\x1a%.backend.GetOffboardingReportResponse\x12Z\n" +
+	"\x11SetDepartmentLead\x12!.backend.SetDepartmentLeadRequest\x1a\".backend.SetDepartmentLeadResponse\x12f\n" +
+	"\x15SetDepartmentChampion\x12%.backend.SetDepartmentChampionRequest\x1a&.backend.SetDepartmentChampionResponse\x12T\n" +
+	"\x0fListDepartments\x12\x1f.backend.ListDepartmentsRequest\x1a .backend.ListDepartmentsResponse\x12E\n" +
+	"\n" +
+	"CreateTeam\x12\x1a.backend.CreateTeamRequest\x1a\x1b.backend.CreateTeamResponse\x12<\n" +
+	"\aGetTeam\x12\x17.backend.GetTeamRequest\x1a\x18.backend.GetTeamResponse\x12B\n" +
+	"\tListTeams\x12\x19.backend.ListTeamsRequest\x1a\x1a.backend.ListTeamsResponse\x12N\n" +
+	"\rAddTeamMember\x12\x1d.backend.AddTeamMemberRequest\x1a\x1e.backend.AddTeamMemberResponse\x12W\n" +
+	"\x10RemoveTeamMember\x12 .backend.RemoveTeamMemberRequest\x1a!.backend.RemoveTeamMemberResponse\x12E\n" +
+	"\n" +
+	"SetManager\x12\x1a.backend.SetManagerRequest\x1a\x1b.backend.SetManagerResponse\x12Q\n" +
+	"\x0eImportOrgChart\x12\x1e.backend.ImportOrgChartRequest\x1a\x1f.backend.ImportOrgChartResponse\x12B\n" +
+	"\tListUsers\x12\x19.backend.ListUsersRequest\x1a\x1a.backend.ListUsersResponse\x12H\n" +
+	"\vSearchUsers\x12\x1b.backend.SearchUsersRequest\x1a\x1c.backend.SearchUsersResponse\x12E\n" +
+	"\n" +
+	"UpdateUser\x12\x1a.backend.UpdateUserRequest\x1a\x1b.backend.UpdateUserResponse\x12H\n" +
+	"\vSetUserRole\x12\x1b.backend.SetUserRoleRequest\x1a\x1c.backend.SetUserRoleResponse\x12Q\n" +
+	"\x0eDeactivateUser\x12\x1e.backend.DeactivateUserRequest\x1a\x1f.backend.DeactivateUserResponse\x12Q\n" +
+	"\x0eReactivateUser\x12\x1e.backend.ReactivateUserRequest\x1a\x1f.backend.ReactivateUserResponse\x12c\n" +
+	"\x14CreateServiceAccount\x12$.backend.CreateServiceAccountRequest\x1a%.backend.CreateServiceAccountResponse\x12Q\n" +
+	"\x0eCreateAPIToken\x12\x1e.backend.CreateAPITokenRequest\x1a\x1f.backend.CreateAPITokenResponse\x12N\n" +
+	"\rListAPITokens\x12\x1d.backend.ListAPITokensRequest\x1a\x1e.backend.ListAPITokensResponse\x12Q\n" +
+	"\x0eRevokeAPIToken\x12\x1e.backend.RevokeAPITokenRequest\x1a\x1f.backend.RevokeAPITokenResponse\x12l\n" +
+	"\x17StartGithubVerification\x12'.backend.StartGithubVerificationRequest\x1a(.backend.StartGithubVerificationResponse\x12u\n" +
+	"\x1aCompleteGithubVerification\x12*.backend.CompleteGithubVerificationRequest\x1a+.backend.CompleteGithubVerificationResponse2\xb5\a\n" +
 	"\x0eProjectService\x12`\n" +
 	"\x13GetAuthoredProjects\x12#.backend.GetAuthoredProjectsRequest\x1a$.backend.GetAuthoredProjectsResponse\x12i\n" +
 	"\x16GetContributedProjects\x12&.backend.GetContributedProjectsRequest\x1a'.backend.GetContributedProjectsResponse\x12`\n" +
 	"\x13GetApprovedProjects\x12#.backend.GetApprovedProjectsRequest\x1a$.backend.GetApprovedProjectsResponse\x12N\n" +
 	"\rCreateProject\x12\x1d.backend.CreateProjectRequest\x1a\x1e.backend.CreateProjectResponse\x12l\n" +
-	"\x17GetApprovedProjectsList\x12'.backend.GetApprovedProjectsListRequest\x1a(.backend.GetApprovedProjectsListResponse2\xa8\x04\n" +
+	"\x17GetApprovedProjectsList\x12'.backend.GetApprovedProjectsListRequest\x1a(.backend.GetApprovedProjectsListResponse\x12o\n" +
+	"\x18ListRecertificationTasks\x12(.backend.ListRecertificationTasksRequest\x1a).backend.ListRecertificationTasksResponse\x12l\n" +
+	"\x17CompleteRecertification\x12'.backend.CompleteRecertificationRequest\x1a(.backend.CompleteRecertificationResponse\x12f\n" +
+	"\x15AddProjectContributor\x12%.backend.AddProjectContributorRequest\x1a&.backend.AddProjectContributorResponse\x12o\n" +
+	"\x18RemoveProjectContributor\x12(.backend.RemoveProjectContributorRequest\x1a).backend.RemoveProjectContributorResponse2\xed\v\n" +
 	"\x0eRequestService\x12c\n" +
 	"\x14SubmitProjectRequest\x12$.backend.SubmitProjectRequestRequest\x1a%.backend.SubmitProjectRequestResponse\x12r\n" +
 	"\x19SubmitPullRequestApproval\x12).backend.SubmitPullRequestApprovalRequest\x1a*.backend.SubmitPullRequestApprovalResponse\x12`\n" +
 	"\x13SubmitAccessRequest\x12#.backend.SubmitAccessRequestRequest\x1a$.backend.SubmitAccessRequestResponse\x12\x90\x01\n" +
 	"#SubmitContributionPermissionRequest\x123.backend.SubmitContributionPermissionRequestRequest\x1a4.backend.SubmitContributionPermissionRequestResponse\x12H\n" +
-	"\vGetRequests\x12\x1b.backend.GetRequestsRequest\x1a\x1c.backend.GetRequestsResponseB\x19Z\x17sourcestream/backend/pbb\x06proto3"
+	"\vGetRequests\x12\x1b.backend.GetRequestsRequest\x1a\x1c.backend.GetRequestsResponse\x12]\n" +
+	"\x12RecordContribution\x12\".backend.RecordContributionRequest\x1a#.backend.RecordContributionResponse\x12`\n" +
+	"\x13ImportContributions\x12#.backend.ImportContributionsRequest\x1a$.backend.ImportContributionsResponse\x12Z\n" +
+	"\x11ListContributions\x12!.backend.ListContributionsRequest\x1a\".backend.ListContributionsResponse\x12f\n" +
+	"\x15GetContributionReport\x12%.backend.GetContributionReportRequest\x1a&.backend.GetContributionReportResponse\x12f\n" +
+	"\x15ListPullRequestEvents\x12%.backend.ListPullRequestEventsRequest\x1a&.backend.ListPullRequestEventsResponse\x12]\n" +
+	"\x12CreateApprovalRule\x12\".backend.CreateApprovalRuleRequest\x1a#.backend.CreateApprovalRuleResponse\x12]\n" +
+	"\x12UpdateApprovalRule\x12\".backend.UpdateApprovalRuleRequest\x1a#.backend.UpdateApprovalRuleResponse\x12Z\n" +
+	"\x11ListApprovalRules\x12!.backend.ListApprovalRulesRequest\x1a\".backend.ListApprovalRulesResponse\x12K\n" +
+	"\fDryRunPolicy\x12\x1c.backend.DryRunPolicyRequest\x1a\x1d.backend.DryRunPolicyResponse\x12o\n" +
+	"\x18ListAutoApprovedRequests\x12(.backend.ListAutoApprovedRequestsRequest\x1a).backend.ListAutoApprovedRequestsResponseB\x19Z\x17sourcestream/backend/pbb\x06proto3"
 
 var (
 	file_user_service_proto_rawDescOnce sync.Once
@@ -2056,78 +8712,297 @@ func file_user_service_proto_rawDescGZIP() []byte {
 	return file_user_service_proto_rawDescData
 }
 
-var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 125)
 var file_user_service_proto_goTypes = []any{
 	(*Project)(nil),                                     // 0: backend.Project
 	(*ApprovedProject)(nil),                             // 1: backend.ApprovedProject
-	(*User)(nil),                                        // 2: backend.User
-	(*Request)(nil),                                     // 3: backend.Request
-	(*RegisterContributorRequest)(nil),                  // 4: backend.RegisterContributorRequest
-	(*RegisterContributorResponse)(nil),                 // 5: backend.RegisterContributorResponse
-	(*GetContributorRequest)(nil),                       // 6: backend.GetContributorRequest
-	(*GetContributorResponse)(nil),                      // 7: backend.GetContributorResponse
-	(*GetUserProfileRequest)(nil),                       // 8: backend.GetUserProfileRequest
-	(*GetUserProfileResponse)(nil),                      // 9: backend.GetUserProfileResponse
-	(*GetAuthoredProjectsRequest)(nil),                  // 10: backend.GetAuthoredProjectsRequest
-	(*GetAuthoredProjectsResponse)(nil),                 // 11: backend.GetAuthoredProjectsResponse
-	(*GetContributedProjectsRequest)(nil),               // 12: backend.GetContributedProjectsRequest
-	(*GetContributedProjectsResponse)(nil),              // 13: backend.GetContributedProjectsResponse
-	(*GetApprovedProjectsRequest)(nil),                  // 14: backend.GetApprovedProjectsRequest
-	(*GetApprovedProjectsResponse)(nil),                 // 15: backend.GetApprovedProjectsResponse
-	(*CreateProjectRequest)(nil),                        // 16: backend.CreateProjectRequest
-	(*CreateProjectResponse)(nil),                       // 17: backend.CreateProjectResponse
-	(*SubmitProjectRequestRequest)(nil),                 // 18: backend.SubmitProjectRequestRequest
-	(*SubmitProjectRequestResponse)(nil),                // 19: backend.SubmitProjectRequestResponse
-	(*SubmitPullRequestApprovalRequest)(nil),            // 20: backend.SubmitPullRequestApprovalRequest
-	(*SubmitPullRequestApprovalResponse)(nil),           // 21: backend.SubmitPullRequestApprovalResponse
-	(*SubmitAccessRequestRequest)(nil),                  // 22: backend.SubmitAccessRequestRequest
-	(*SubmitAccessRequestResponse)(nil),                 // 23: backend.SubmitAccessRequestResponse
-	(*GetRequestsRequest)(nil),                          // 24: backend.GetRequestsRequest
-	(*GetRequestsResponse)(nil),                         // 25: backend.GetRequestsResponse
-	(*GetApprovedProjectsListRequest)(nil),              // 26: backend.GetApprovedProjectsListRequest
-	(*GetApprovedProjectsListResponse)(nil),             // 27: backend.GetApprovedProjectsListResponse
-	(*SubmitContributionPermissionRequestRequest)(nil),  // 28: backend.SubmitContributionPermissionRequestRequest
-	(*SubmitContributionPermissionRequestResponse)(nil), // 29: backend.SubmitContributionPermissionRequestResponse
+	(*RecertificationTask)(nil),                         // 2: backend.RecertificationTask
+	(*User)(nil),                                        // 3: backend.User
+	(*Request)(nil),                                     // 4: backend.Request
+	(*Contribution)(nil),                                // 5: backend.Contribution
+	(*ContributionSummary)(nil),                         // 6: backend.ContributionSummary
+	(*TeamContributionSummary)(nil),                     // 7: backend.TeamContributionSummary
+	(*RegisterContributorRequest)(nil),                  // 8: backend.RegisterContributorRequest
+	(*RegisterContributorResponse)(nil),                 // 9: backend.RegisterContributorResponse
+	(*GetContributorRequest)(nil),                       // 10: backend.GetContributorRequest
+	(*ContributorGrant)(nil),                            // 11: backend.ContributorGrant
+	(*GetContributorResponse)(nil),                      // 12: backend.GetContributorResponse
+	(*GetUserProfileRequest)(nil),                       // 13: backend.GetUserProfileRequest
+	(*UserStats)(nil),                                   // 14: backend.UserStats
+	(*GetUserProfileResponse)(nil),                      // 15: backend.GetUserProfileResponse
+	(*OffboardUserRequest)(nil),                         // 16: backend.OffboardUserRequest
+	(*OffboardUserResponse)(nil),                        // 17: backend.OffboardUserResponse
+	(*GetOffboardingReportRequest)(nil),                 // 18: backend.GetOffboardingReportRequest
+	(*GetOffboardingReportResponse)(nil),                // 19: backend.GetOffboardingReportResponse
+	(*OffboardingItem)(nil),                             // 20: backend.OffboardingItem
+	(*OffboardingReport)(nil),                           // 21: backend.OffboardingReport
+	(*SetDepartmentLeadRequest)(nil),                    // 22: backend.SetDepartmentLeadRequest
+	(*SetDepartmentLeadResponse)(nil),                   // 23: backend.SetDepartmentLeadResponse
+	(*ListUsersRequest)(nil),                            // 24: backend.ListUsersRequest
+	(*ListUsersResponse)(nil),                           // 25: backend.ListUsersResponse
+	(*SearchUsersRequest)(nil),                          // 26: backend.SearchUsersRequest
+	(*SearchUsersResponse)(nil),                         // 27: backend.SearchUsersResponse
+	(*UpdateUserRequest)(nil),                           // 28: backend.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                          // 29: backend.UpdateUserResponse
+	(*SetUserRoleRequest)(nil),                          // 30: backend.SetUserRoleRequest
+	(*SetUserRoleResponse)(nil),                         // 31: backend.SetUserRoleResponse
+	(*DeactivateUserRequest)(nil),                       // 32: backend.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),                      // 33: backend.DeactivateUserResponse
+	(*ReactivateUserRequest)(nil),                       // 34: backend.ReactivateUserRequest
+	(*ReactivateUserResponse)(nil),                      // 35: backend.ReactivateUserResponse
+	(*CreateServiceAccountRequest)(nil),                 // 36: backend.CreateServiceAccountRequest
+	(*CreateServiceAccountResponse)(nil),                // 37: backend.CreateServiceAccountResponse
+	(*APIToken)(nil),                                    // 38: backend.APIToken
+	(*CreateAPITokenRequest)(nil),                       // 39: backend.CreateAPITokenRequest
+	(*CreateAPITokenResponse)(nil),                      // 40: backend.CreateAPITokenResponse
+	(*ListAPITokensRequest)(nil),                        // 41: backend.ListAPITokensRequest
+	(*ListAPITokensResponse)(nil),                       // 42: backend.ListAPITokensResponse
+	(*RevokeAPITokenRequest)(nil),                       // 43: backend.RevokeAPITokenRequest
+	(*RevokeAPITokenResponse)(nil),                      // 44: backend.RevokeAPITokenResponse
+	(*GithubVerification)(nil),                          // 45: backend.GithubVerification
+	(*StartGithubVerificationRequest)(nil),              // 46: backend.StartGithubVerificationRequest
+	(*StartGithubVerificationResponse)(nil),             // 47: backend.StartGithubVerificationResponse
+	(*CompleteGithubVerificationRequest)(nil),           // 48: backend.CompleteGithubVerificationRequest
+	(*CompleteGithubVerificationResponse)(nil),          // 49: backend.CompleteGithubVerificationResponse
+	(*Department)(nil),                                  // 50: backend.Department
+	(*Team)(nil),                                        // 51: backend.Team
+	(*SetDepartmentChampionRequest)(nil),                // 52: backend.SetDepartmentChampionRequest
+	(*SetDepartmentChampionResponse)(nil),               // 53: backend.SetDepartmentChampionResponse
+	(*ListDepartmentsRequest)(nil),                      // 54: backend.ListDepartmentsRequest
+	(*ListDepartmentsResponse)(nil),                     // 55: backend.ListDepartmentsResponse
+	(*CreateTeamRequest)(nil),                           // 56: backend.CreateTeamRequest
+	(*CreateTeamResponse)(nil),                          // 57: backend.CreateTeamResponse
+	(*GetTeamRequest)(nil),                              // 58: backend.GetTeamRequest
+	(*GetTeamResponse)(nil),                             // 59: backend.GetTeamResponse
+	(*ListTeamsRequest)(nil),                            // 60: backend.ListTeamsRequest
+	(*ListTeamsResponse)(nil),                           // 61: backend.ListTeamsResponse
+	(*AddTeamMemberRequest)(nil),                        // 62: backend.AddTeamMemberRequest
+	(*AddTeamMemberResponse)(nil),                       // 63: backend.AddTeamMemberResponse
+	(*RemoveTeamMemberRequest)(nil),                     // 64: backend.RemoveTeamMemberRequest
+	(*RemoveTeamMemberResponse)(nil),                    // 65: backend.RemoveTeamMemberResponse
+	(*SetManagerRequest)(nil),                           // 66: backend.SetManagerRequest
+	(*SetManagerResponse)(nil),                          // 67: backend.SetManagerResponse
+	(*ImportOrgChartRequest)(nil),                       // 68: backend.ImportOrgChartRequest
+	(*OrgChartImportError)(nil),                         // 69: backend.OrgChartImportError
+	(*ImportOrgChartResponse)(nil),                      // 70: backend.ImportOrgChartResponse
+	(*GetAuthoredProjectsRequest)(nil),                  // 71: backend.GetAuthoredProjectsRequest
+	(*GetAuthoredProjectsResponse)(nil),                 // 72: backend.GetAuthoredProjectsResponse
+	(*GetContributedProjectsRequest)(nil),               // 73: backend.GetContributedProjectsRequest
+	(*GetContributedProjectsResponse)(nil),              // 74: backend.GetContributedProjectsResponse
+	(*GetApprovedProjectsRequest)(nil),                  // 75: backend.GetApprovedProjectsRequest
+	(*GetApprovedProjectsResponse)(nil),                 // 76: backend.GetApprovedProjectsResponse
+	(*CreateProjectRequest)(nil),                        // 77: backend.CreateProjectRequest
+	(*CreateProjectResponse)(nil),                       // 78: backend.CreateProjectResponse
+	(*SubmitProjectRequestRequest)(nil),                 // 79: backend.SubmitProjectRequestRequest
+	(*SubmitProjectRequestResponse)(nil),                // 80: backend.SubmitProjectRequestResponse
+	(*SubmitPullRequestApprovalRequest)(nil),            // 81: backend.SubmitPullRequestApprovalRequest
+	(*SubmitPullRequestApprovalResponse)(nil),           // 82: backend.SubmitPullRequestApprovalResponse
+	(*SubmitAccessRequestRequest)(nil),                  // 83: backend.SubmitAccessRequestRequest
+	(*SubmitAccessRequestResponse)(nil),                 // 84: backend.SubmitAccessRequestResponse
+	(*GetRequestsRequest)(nil),                          // 85: backend.GetRequestsRequest
+	(*GetRequestsResponse)(nil),                         // 86: backend.GetRequestsResponse
+	(*GetApprovedProjectsListRequest)(nil),              // 87: backend.GetApprovedProjectsListRequest
+	(*GetApprovedProjectsListResponse)(nil),             // 88: backend.GetApprovedProjectsListResponse
+	(*SubmitContributionPermissionRequestRequest)(nil),  // 89: backend.SubmitContributionPermissionRequestRequest
+	(*SubmitContributionPermissionRequestResponse)(nil), // 90: backend.SubmitContributionPermissionRequestResponse
+	(*ListRecertificationTasksRequest)(nil),             // 91: backend.ListRecertificationTasksRequest
+	(*ListRecertificationTasksResponse)(nil),            // 92: backend.ListRecertificationTasksResponse
+	(*CompleteRecertificationRequest)(nil),              // 93: backend.CompleteRecertificationRequest
+	(*CompleteRecertificationResponse)(nil),             // 94: backend.CompleteRecertificationResponse
+	(*AddProjectContributorRequest)(nil),                // 95: backend.AddProjectContributorRequest
+	(*AddProjectContributorResponse)(nil),               // 96: backend.AddProjectContributorResponse
+	(*RemoveProjectContributorRequest)(nil),             // 97: backend.RemoveProjectContributorRequest
+	(*RemoveProjectContributorResponse)(nil),            // 98: backend.RemoveProjectContributorResponse
+	(*RecordContributionRequest)(nil),                   // 99: backend.RecordContributionRequest
+	(*RecordContributionResponse)(nil),                  // 100: backend.RecordContributionResponse
+	(*ImportContributionsRequest)(nil),                  // 101: backend.ImportContributionsRequest
+	(*ContributionImportError)(nil),                     // 102: backend.ContributionImportError
+	(*ImportContributionsResponse)(nil),                 // 103: backend.ImportContributionsResponse
+	(*ListContributionsRequest)(nil),                    // 104: backend.ListContributionsRequest
+	(*ListContributionsResponse)(nil),                   // 105: backend.ListContributionsResponse
+	(*GetContributionReportRequest)(nil),                // 106: backend.GetContributionReportRequest
+	(*GetContributionReportResponse)(nil),               // 107: backend.GetContributionReportResponse
+	(*PullRequestEvent)(nil),                            // 108: backend.PullRequestEvent
+	(*ListPullRequestEventsRequest)(nil),                // 109: backend.ListPullRequestEventsRequest
+	(*ListPullRequestEventsResponse)(nil),               // 110: backend.ListPullRequestEventsResponse
+	(*ApprovalRule)(nil),                                // 111: backend.ApprovalRule
+	(*CreateApprovalRuleRequest)(nil),                   // 112: backend.CreateApprovalRuleRequest
+	(*CreateApprovalRuleResponse)(nil),                  // 113: backend.CreateApprovalRuleResponse
+	(*UpdateApprovalRuleRequest)(nil),                   // 114: backend.UpdateApprovalRuleRequest
+	(*UpdateApprovalRuleResponse)(nil),                  // 115: backend.UpdateApprovalRuleResponse
+	(*ListApprovalRulesRequest)(nil),                    // 116: backend.ListApprovalRulesRequest
+	(*ListApprovalRulesResponse)(nil),                   // 117: backend.ListApprovalRulesResponse
+	(*DryRunPolicyRequest)(nil),                         // 118: backend.DryRunPolicyRequest
+	(*DryRunPolicyResult)(nil),                          // 119: backend.DryRunPolicyResult
+	(*DryRunPolicyResponse)(nil),                        // 120: backend.DryRunPolicyResponse
+	(*ListAutoApprovedRequestsRequest)(nil),             // 121: backend.ListAutoApprovedRequestsRequest
+	(*AutoApprovedRequest)(nil),                         // 122: backend.AutoApprovedRequest
+	(*ListAutoApprovedRequestsResponse)(nil),            // 123: backend.ListAutoApprovedRequestsResponse
+	nil,                                                 // 124: backend.UserStats.RequestsByStatusEntry
 }
 var file_user_service_proto_depIdxs = []int32{
-	2,  // 0: backend.GetUserProfileResponse.user:type_name -> backend.User
-	0,  // 1: backend.GetAuthoredProjectsResponse.projects:type_name -> backend.Project
-	0,  // 2: backend.GetContributedProjectsResponse.projects:type_name -> backend.Project
-	0,  // 3: backend.GetApprovedProjectsResponse.projects:type_name -> backend.Project
-	0,  // 4: backend.CreateProjectResponse.project:type_name -> backend.Project
-	3,  // 5: backend.GetRequestsResponse.requests:type_name -> backend.Request
-	1,  // 6: backend.GetApprovedProjectsListResponse.projects:type_name -> backend.ApprovedProject
-	4,  // 7: backend.UserService.RegisterContributor:input_type -> backend.RegisterContributorRequest
-	6,  // 8: backend.UserService.GetContributor:input_type -> backend.GetContributorRequest
-	8,  // 9: backend.UserService.GetUserProfile:input_type -> backend.GetUserProfileRequest
-	10, // 10: backend.ProjectService.GetAuthoredProjects:input_type -> backend.GetAuthoredProjectsRequest
-	12, // 11: backend.ProjectService.GetContributedProjects:input_type -> backend.GetContributedProjectsRequest
-	14, // 12: backend.ProjectService.GetApprovedProjects:input_type -> backend.GetApprovedProjectsRequest
-	16, // 13: backend.ProjectService.CreateProject:input_type -> backend.CreateProjectRequest
-	26, // 14: backend.ProjectService.GetApprovedProjectsList:input_type -> backend.GetApprovedProjectsListRequest
-	18, // 15: backend.RequestService.SubmitProjectRequest:input_type -> backend.SubmitProjectRequestRequest
-	20, // 16: backend.RequestService.SubmitPullRequestApproval:input_type -> backend.SubmitPullRequestApprovalRequest
-	22, // 17: backend.RequestService.SubmitAccessRequest:input_type -> backend.SubmitAccessRequestRequest
-	28, // 18: backend.RequestService.SubmitContributionPermissionRequest:input_type -> backend.SubmitContributionPermissionRequestRequest
-	24, // 19: backend.RequestService.GetRequests:input_type -> backend.GetRequestsRequest
-	5,  // 20: backend.UserService.RegisterContributor:output_type -> backend.RegisterContributorResponse
-	7,  // 21: backend.UserService.GetContributor:output_type -> backend.GetContributorResponse
-	9,  // 22: backend.UserService.GetUserProfile:output_type -> backend.GetUserProfileResponse
-	11, // 23: backend.ProjectService.GetAuthoredProjects:output_type -> backend.GetAuthoredProjectsResponse
-	13, // 24: backend.ProjectService.GetContributedProjects:output_type -> backend.GetContributedProjectsResponse
-	15, // 25: backend.ProjectService.GetApprovedProjects:output_type -> backend.GetApprovedProjectsResponse
-	17, // 26: backend.ProjectService.CreateProject:output_type -> backend.CreateProjectResponse
-	27, // 27: backend.ProjectService.GetApprovedProjectsList:output_type -> backend.GetApprovedProjectsListResponse
-	19, // 28: backend.RequestService.SubmitProjectRequest:output_type -> backend.SubmitProjectRequestResponse
-	21, // 29: backend.RequestService.SubmitPullRequestApproval:output_type -> backend.SubmitPullRequestApprovalResponse
-	23, // 30: backend.RequestService.SubmitAccessRequest:output_type -> backend.SubmitAccessRequestResponse
-	29, // 31: backend.RequestService.SubmitContributionPermissionRequest:output_type -> backend.SubmitContributionPermissionRequestResponse
-	25, // 32: backend.RequestService.GetRequests:output_type -> backend.GetRequestsResponse
-	20, // [20:33] is the sub-list for method output_type
-	7,  // [7:20] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	3,   // 0: backend.RegisterContributorResponse.user:type_name -> backend.User
+	11,  // 1: backend.GetContributorResponse.approved_projects:type_name -> backend.ContributorGrant
+	124, // 2: backend.UserStats.requests_by_status:type_name -> backend.UserStats.RequestsByStatusEntry
+	3,   // 3: backend.GetUserProfileResponse.user:type_name -> backend.User
+	14,  // 4: backend.GetUserProfileResponse.stats:type_name -> backend.UserStats
+	21,  // 5: backend.OffboardUserResponse.report:type_name -> backend.OffboardingReport
+	21,  // 6: backend.GetOffboardingReportResponse.report:type_name -> backend.OffboardingReport
+	20,  // 7: backend.OffboardingReport.transferred_projects:type_name -> backend.OffboardingItem
+	20,  // 8: backend.OffboardingReport.untransferred_projects:type_name -> backend.OffboardingItem
+	20,  // 9: backend.OffboardingReport.revoked_access:type_name -> backend.OffboardingItem
+	20,  // 10: backend.OffboardingReport.cancelled_requests:type_name -> backend.OffboardingItem
+	20,  // 11: backend.OffboardingReport.reassigned_reviews:type_name -> backend.OffboardingItem
+	3,   // 12: backend.ListUsersResponse.users:type_name -> backend.User
+	3,   // 13: backend.SearchUsersResponse.users:type_name -> backend.User
+	3,   // 14: backend.UpdateUserResponse.user:type_name -> backend.User
+	3,   // 15: backend.SetUserRoleResponse.user:type_name -> backend.User
+	3,   // 16: backend.DeactivateUserResponse.user:type_name -> backend.User
+	21,  // 17: backend.DeactivateUserResponse.report:type_name -> backend.OffboardingReport
+	3,   // 18: backend.ReactivateUserResponse.user:type_name -> backend.User
+	3,   // 19: backend.CreateServiceAccountResponse.user:type_name -> backend.User
+	38,  // 20: backend.CreateAPITokenResponse.token:type_name -> backend.APIToken
+	38,  // 21: backend.ListAPITokensResponse.tokens:type_name -> backend.APIToken
+	38,  // 22: backend.RevokeAPITokenResponse.token:type_name -> backend.APIToken
+	45,  // 23: backend.StartGithubVerificationResponse.verification:type_name -> backend.GithubVerification
+	45,  // 24: backend.CompleteGithubVerificationResponse.verification:type_name -> backend.GithubVerification
+	3,   // 25: backend.CompleteGithubVerificationResponse.user:type_name -> backend.User
+	50,  // 26: backend.SetDepartmentChampionResponse.department:type_name -> backend.Department
+	50,  // 27: backend.ListDepartmentsResponse.departments:type_name -> backend.Department
+	51,  // 28: backend.CreateTeamResponse.team:type_name -> backend.Team
+	51,  // 29: backend.GetTeamResponse.team:type_name -> backend.Team
+	51,  // 30: backend.ListTeamsResponse.teams:type_name -> backend.Team
+	51,  // 31: backend.AddTeamMemberResponse.team:type_name -> backend.Team
+	51,  // 32: backend.RemoveTeamMemberResponse.team:type_name -> backend.Team
+	3,   // 33: backend.SetManagerResponse.user:type_name -> backend.User
+	69,  // 34: backend.ImportOrgChartResponse.errors:type_name -> backend.OrgChartImportError
+	0,   // 35: backend.GetAuthoredProjectsResponse.projects:type_name -> backend.Project
+	0,   // 36: backend.GetContributedProjectsResponse.projects:type_name -> backend.Project
+	0,   // 37: backend.GetApprovedProjectsResponse.projects:type_name -> backend.Project
+	0,   // 38: backend.CreateProjectResponse.project:type_name -> backend.Project
+	4,   // 39: backend.GetRequestsResponse.requests:type_name -> backend.Request
+	1,   // 40: backend.GetApprovedProjectsListResponse.projects:type_name -> backend.ApprovedProject
+	2,   // 41: backend.ListRecertificationTasksResponse.tasks:type_name -> backend.RecertificationTask
+	5,   // 42: backend.RecordContributionResponse.contribution:type_name -> backend.Contribution
+	99,  // 43: backend.ImportContributionsRequest.entries:type_name -> backend.RecordContributionRequest
+	102, // 44: backend.ImportContributionsResponse.errors:type_name -> backend.ContributionImportError
+	5,   // 45: backend.ListContributionsResponse.contributions:type_name -> backend.Contribution
+	6,   // 46: backend.GetContributionReportResponse.projects:type_name -> backend.ContributionSummary
+	7,   // 47: backend.GetContributionReportResponse.teams:type_name -> backend.TeamContributionSummary
+	108, // 48: backend.ListPullRequestEventsResponse.events:type_name -> backend.PullRequestEvent
+	111, // 49: backend.CreateApprovalRuleResponse.rule:type_name -> backend.ApprovalRule
+	111, // 50: backend.UpdateApprovalRuleResponse.rule:type_name -> backend.ApprovalRule
+	111, // 51: backend.ListApprovalRulesResponse.rules:type_name -> backend.ApprovalRule
+	119, // 52: backend.DryRunPolicyResponse.matches:type_name -> backend.DryRunPolicyResult
+	119, // 53: backend.DryRunPolicyResponse.failures:type_name -> backend.DryRunPolicyResult
+	122, // 54: backend.ListAutoApprovedRequestsResponse.requests:type_name -> backend.AutoApprovedRequest
+	8,   // 55: backend.UserService.RegisterContributor:input_type -> backend.RegisterContributorRequest
+	10,  // 56: backend.UserService.GetContributor:input_type -> backend.GetContributorRequest
+	13,  // 57: backend.UserService.GetUserProfile:input_type -> backend.GetUserProfileRequest
+	16,  // 58: backend.UserService.OffboardUser:input_type -> backend.OffboardUserRequest
+	18,  // 59: backend.UserService.GetOffboardingReport:input_type -> backend.GetOffboardingReportRequest
+	22,  // 60: backend.UserService.SetDepartmentLead:input_type -> backend.SetDepartmentLeadRequest
+	52,  // 61: backend.UserService.SetDepartmentChampion:input_type -> backend.SetDepartmentChampionRequest
+	54,  // 62: backend.UserService.ListDepartments:input_type -> backend.ListDepartmentsRequest
+	56,  // 63: backend.UserService.CreateTeam:input_type -> backend.CreateTeamRequest
+	58,  // 64: backend.UserService.GetTeam:input_type -> backend.GetTeamRequest
+	60,  // 65: backend.UserService.ListTeams:input_type -> backend.ListTeamsRequest
+	62,  // 66: backend.UserService.AddTeamMember:input_type -> backend.AddTeamMemberRequest
+	64,  // 67: backend.UserService.RemoveTeamMember:input_type -> backend.RemoveTeamMemberRequest
+	66,  // 68: backend.UserService.SetManager:input_type -> backend.SetManagerRequest
+	68,  // 69: backend.UserService.ImportOrgChart:input_type -> backend.ImportOrgChartRequest
+	24,  // 70: backend.UserService.ListUsers:input_type -> backend.ListUsersRequest
+	26,  // 71: backend.UserService.SearchUsers:input_type -> backend.SearchUsersRequest
+	28,  // 72: backend.UserService.UpdateUser:input_type -> backend.UpdateUserRequest
+	30,  // 73: backend.UserService.SetUserRole:input_type -> backend.SetUserRoleRequest
+	32,  // 74: backend.UserService.DeactivateUser:input_type -> backend.DeactivateUserRequest
+	34,  // 75: backend.UserService.ReactivateUser:input_type -> backend.ReactivateUserRequest
+	36,  // 76: backend.UserService.CreateServiceAccount:input_type -> backend.CreateServiceAccountRequest
+	39,  // 77: backend.UserService.CreateAPIToken:input_type -> backend.CreateAPITokenRequest
+	41,  // 78: backend.UserService.ListAPITokens:input_type -> backend.ListAPITokensRequest
+	43,  // 79: backend.UserService.RevokeAPIToken:input_type -> backend.RevokeAPITokenRequest
+	46,  // 80: backend.UserService.StartGithubVerification:input_type -> backend.StartGithubVerificationRequest
+	48,  // 81: backend.UserService.CompleteGithubVerification:input_type -> backend.CompleteGithubVerificationRequest
+	71,  // 82: backend.ProjectService.GetAuthoredProjects:input_type -> backend.GetAuthoredProjectsRequest
+	73,  // 83: backend.ProjectService.GetContributedProjects:input_type -> backend.GetContributedProjectsRequest
+	75,  // 84: backend.ProjectService.GetApprovedProjects:input_type -> backend.GetApprovedProjectsRequest
+	77,  // 85: backend.ProjectService.CreateProject:input_type -> backend.CreateProjectRequest
+	87,  // 86: backend.ProjectService.GetApprovedProjectsList:input_type -> backend.GetApprovedProjectsListRequest
+	91,  // 87: backend.ProjectService.ListRecertificationTasks:input_type -> backend.ListRecertificationTasksRequest
+	93,  // 88: backend.ProjectService.CompleteRecertification:input_type -> backend.CompleteRecertificationRequest
+	95,  // 89: backend.ProjectService.AddProjectContributor:input_type -> backend.AddProjectContributorRequest
+	97,  // 90: backend.ProjectService.RemoveProjectContributor:input_type -> backend.RemoveProjectContributorRequest
+	79,  // 91: backend.RequestService.SubmitProjectRequest:input_type -> backend.SubmitProjectRequestRequest
+	81,  // 92: backend.RequestService.SubmitPullRequestApproval:input_type -> backend.SubmitPullRequestApprovalRequest
+	83,  // 93: backend.RequestService.SubmitAccessRequest:input_type -> backend.SubmitAccessRequestRequest
+	89,  // 94: backend.RequestService.SubmitContributionPermissionRequest:input_type -> backend.SubmitContributionPermissionRequestRequest
+	85,  // 95: backend.RequestService.GetRequests:input_type -> backend.GetRequestsRequest
+	99,  // 96: backend.RequestService.RecordContribution:input_type -> backend.RecordContributionRequest
+	101, // 97: backend.RequestService.ImportContributions:input_type -> backend.ImportContributionsRequest
+	104, // 98: backend.RequestService.ListContributions:input_type -> backend.ListContributionsRequest
+	106, // 99: backend.RequestService.GetContributionReport:input_type -> backend.GetContributionReportRequest
+	109, // 100: backend.RequestService.ListPullRequestEvents:input_type -> backend.ListPullRequestEventsRequest
+	112, // 101: backend.RequestService.CreateApprovalRule:input_type -> backend.CreateApprovalRuleRequest
+	114, // 102: backend.RequestService.UpdateApprovalRule:input_type -> backend.UpdateApprovalRuleRequest
+	116, // 103: backend.RequestService.ListApprovalRules:input_type -> backend.ListApprovalRulesRequest
+	118, // 104: backend.RequestService.DryRunPolicy:input_type -> backend.DryRunPolicyRequest
+	121, // 105: backend.RequestService.ListAutoApprovedRequests:input_type -> backend.ListAutoApprovedRequestsRequest
+	9,   // 106: backend.UserService.RegisterContributor:output_type -> backend.RegisterContributorResponse
+	12,  // 107: backend.UserService.GetContributor:output_type -> backend.GetContributorResponse
+	15,  // 108: backend.UserService.GetUserProfile:output_type -> backend.GetUserProfileResponse
+	17,  // 109: backend.UserService.OffboardUser:output_type -> backend.OffboardUserResponse
+	19,  // 110: backend.UserService.GetOffboardingReport:output_type -> backend.GetOffboardingReportResponse
+	23,  // 111: backend.UserService.SetDepartmentLead:output_type -> backend.SetDepartmentLeadResponse
+	53,  // 112: backend.UserService.SetDepartmentChampion:output_type -> backend.SetDepartmentChampionResponse
+	55,  // 113: backend.UserService.ListDepartments:output_type -> backend.ListDepartmentsResponse
+	57,  // 114: backend.UserService.CreateTeam:output_type -> backend.CreateTeamResponse
+	59,  // 115: backend.UserService.GetTeam:output_type -> backend.GetTeamResponse
+	61,  // 116: backend.UserService.ListTeams:output_type -> backend.ListTeamsResponse
+	63,  // 117: backend.UserService.AddTeamMember:output_type -> backend.AddTeamMemberResponse
+	65,  // 118: backend.UserService.RemoveTeamMember:output_type -> backend.RemoveTeamMemberResponse
+	67,  // 119: backend.UserService.SetManager:output_type -> backend.SetManagerResponse
+	70,  // 120: backend.UserService.ImportOrgChart:output_type -> backend.ImportOrgChartResponse
+	25,  // 121: backend.UserService.ListUsers:output_type -> backend.ListUsersResponse
+	27,  // 122: backend.UserService.SearchUsers:output_type -> backend.SearchUsersResponse
+	29,  // 123: backend.UserService.UpdateUser:output_type -> backend.UpdateUserResponse
+	31,  // 124: backend.UserService.SetUserRole:output_type -> backend.SetUserRoleResponse
+	33,  // 125: backend.UserService.DeactivateUser:output_type -> backend.DeactivateUserResponse
+	35,  // 126: backend.UserService.ReactivateUser:output_type -> backend.ReactivateUserResponse
+	37,  // 127: backend.UserService.CreateServiceAccount:output_type -> backend.CreateServiceAccountResponse
+	40,  // 128: backend.UserService.CreateAPIToken:output_type -> backend.CreateAPITokenResponse
+	42,  // 129: backend.UserService.ListAPITokens:output_type -> backend.ListAPITokensResponse
+	44,  // 130: backend.UserService.RevokeAPIToken:output_type -> backend.RevokeAPITokenResponse
+	47,  // 131: backend.UserService.StartGithubVerification:output_type -> backend.StartGithubVerificationResponse
+	49,  // 132: backend.UserService.CompleteGithubVerification:output_type -> backend.CompleteGithubVerificationResponse
+	72,  // 133: backend.ProjectService.GetAuthoredProjects:output_type -> backend.GetAuthoredProjectsResponse
+	74,  // 134: backend.ProjectService.GetContributedProjects:output_type -> backend.GetContributedProjectsResponse
+	76,  // 135: backend.ProjectService.GetApprovedProjects:output_type -> backend.GetApprovedProjectsResponse
+	78,  // 136: backend.ProjectService.CreateProject:output_type -> backend.CreateProjectResponse
+	88,  // 137: backend.ProjectService.GetApprovedProjectsList:output_type -> backend.GetApprovedProjectsListResponse
+	92,  // 138: backend.ProjectService.ListRecertificationTasks:output_type -> backend.ListRecertificationTasksResponse
+	94,  // 139: backend.ProjectService.CompleteRecertification:output_type -> backend.CompleteRecertificationResponse
+	96,  // 140: backend.ProjectService.AddProjectContributor:output_type -> backend.AddProjectContributorResponse
+	98,  // 141: backend.ProjectService.RemoveProjectContributor:output_type -> backend.RemoveProjectContributorResponse
+	80,  // 142: backend.RequestService.SubmitProjectRequest:output_type -> backend.SubmitProjectRequestResponse
+	82,  // 143: backend.RequestService.SubmitPullRequestApproval:output_type -> backend.SubmitPullRequestApprovalResponse
+	84,  // 144: backend.RequestService.SubmitAccessRequest:output_type -> backend.SubmitAccessRequestResponse
+	90,  // 145: backend.RequestService.SubmitContributionPermissionRequest:output_type -> backend.SubmitContributionPermissionRequestResponse
+	86,  // 146: backend.RequestService.GetRequests:output_type -> backend.GetRequestsResponse
+	100, // 147: backend.RequestService.RecordContribution:output_type -> backend.RecordContributionResponse
+	103, // 148: backend.RequestService.ImportContributions:output_type -> backend.ImportContributionsResponse
+	105, // 149: backend.RequestService.ListContributions:output_type -> backend.ListContributionsResponse
+	107, // 150: backend.RequestService.GetContributionReport:output_type -> backend.GetContributionReportResponse
+	110, // 151: backend.RequestService.ListPullRequestEvents:output_type -> backend.ListPullRequestEventsResponse
+	113, // 152: backend.RequestService.CreateApprovalRule:output_type -> backend.CreateApprovalRuleResponse
+	115, // 153: backend.RequestService.UpdateApprovalRule:output_type -> backend.UpdateApprovalRuleResponse
+	117, // 154: backend.RequestService.ListApprovalRules:output_type -> backend.ListApprovalRulesResponse
+	120, // 155: backend.RequestService.DryRunPolicy:output_type -> backend.DryRunPolicyResponse
+	123, // 156: backend.RequestService.ListAutoApprovedRequests:output_type -> backend.ListAutoApprovedRequestsResponse
+	106, // [106:157] is the sub-list for method output_type
+	55,  // [55:106] is the sub-list for method input_type
+	55,  // [55:55] is the sub-list for extension type_name
+	55,  // [55:55] is the sub-list for extension extendee
+	0,   // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_user_service_proto_init() }
@@ -2135,13 +9010,20 @@ func file_user_service_proto_init() {
 	if File_user_service_proto != nil {
 		return
 	}
+	file_user_service_proto_msgTypes[24].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[26].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[28].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[118].OneofWrappers = []any{
+		(*DryRunPolicyRequest_RuleId)(nil),
+		(*DryRunPolicyRequest_Expression)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_service_proto_rawDesc), len(file_user_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   125,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -19,9 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_RegisterContributor_FullMethodName = "/backend.UserService/RegisterContributor"
-	UserService_GetContributor_FullMethodName      = "/backend.UserService/GetContributor"
-	UserService_GetUserProfile_FullMethodName      = "/backend.UserService/GetUserProfile"
+	UserService_RegisterContributor_FullMethodName        = "/backend.UserService/RegisterContributor"
+	UserService_GetContributor_FullMethodName             = "/backend.UserService/GetContributor"
+	UserService_GetUserProfile_FullMethodName             = "/backend.UserService/GetUserProfile"
+	UserService_OffboardUser_FullMethodName               = "/backend.UserService/OffboardUser"
+	UserService_GetOffboardingReport_FullMethodName       = "/backend.UserService/GetOffboardingReport"
+	UserService_SetDepartmentLead_FullMethodName          = "/backend.UserService/SetDepartmentLead"
+	UserService_SetDepartmentChampion_FullMethodName      = "/backend.UserService/SetDepartmentChampion"
+	UserService_ListDepartments_FullMethodName            = "/backend.UserService/ListDepartments"
+	UserService_CreateTeam_FullMethodName                 = "/backend.UserService/CreateTeam"
+	UserService_GetTeam_FullMethodName                    = "/backend.UserService/GetTeam"
+	UserService_ListTeams_FullMethodName                  = "/backend.UserService/ListTeams"
+	UserService_AddTeamMember_FullMethodName              = "/backend.UserService/AddTeamMember"
+	UserService_RemoveTeamMember_FullMethodName           = "/backend.UserService/RemoveTeamMember"
+	UserService_SetManager_FullMethodName                 = "/backend.UserService/SetManager"
+	UserService_ImportOrgChart_FullMethodName             = "/backend.UserService/ImportOrgChart"
+	UserService_ListUsers_FullMethodName                  = "/backend.UserService/ListUsers"
+	UserService_SearchUsers_FullMethodName                = "/backend.UserService/SearchUsers"
+	UserService_UpdateUser_FullMethodName                 = "/backend.UserService/UpdateUser"
+	UserService_SetUserRole_FullMethodName                = "/backend.UserService/SetUserRole"
+	UserService_DeactivateUser_FullMethodName             = "/backend.UserService/DeactivateUser"
+	UserService_ReactivateUser_FullMethodName             = "/backend.UserService/ReactivateUser"
+	UserService_CreateServiceAccount_FullMethodName       = "/backend.UserService/CreateServiceAccount"
+	UserService_CreateAPIToken_FullMethodName             = "/backend.UserService/CreateAPIToken"
+	UserService_ListAPITokens_FullMethodName              = "/backend.UserService/ListAPITokens"
+	UserService_RevokeAPIToken_FullMethodName             = "/backend.UserService/RevokeAPIToken"
+	UserService_StartGithubVerification_FullMethodName    = "/backend.UserService/StartGithubVerification"
+	UserService_CompleteGithubVerification_FullMethodName = "/backend.UserService/CompleteGithubVerification"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -33,6 +57,30 @@ type UserServiceClient interface {
 	RegisterContributor(ctx context.Context, in *RegisterContributorRequest, opts ...grpc.CallOption) (*RegisterContributorResponse, error)
 	GetContributor(ctx context.Context, in *GetContributorRequest, opts ...grpc.CallOption) (*GetContributorResponse, error)
 	GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...grpc.CallOption) (*GetUserProfileResponse, error)
+	OffboardUser(ctx context.Context, in *OffboardUserRequest, opts ...grpc.CallOption) (*OffboardUserResponse, error)
+	GetOffboardingReport(ctx context.Context, in *GetOffboardingReportRequest, opts ...grpc.CallOption) (*GetOffboardingReportResponse, error)
+	SetDepartmentLead(ctx context.Context, in *SetDepartmentLeadRequest, opts ...grpc.CallOption) (*SetDepartmentLeadResponse, error)
+	SetDepartmentChampion(ctx context.Context, in *SetDepartmentChampionRequest, opts ...grpc.CallOption) (*SetDepartmentChampionResponse, error)
+	ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...grpc.CallOption) (*ListDepartmentsResponse, error)
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error)
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	AddTeamMember(ctx context.Context, in *AddTeamMemberRequest, opts ...grpc.CallOption) (*AddTeamMemberResponse, error)
+	RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*RemoveTeamMemberResponse, error)
+	SetManager(ctx context.Context, in *SetManagerRequest, opts ...grpc.CallOption) (*SetManagerResponse, error)
+	ImportOrgChart(ctx context.Context, in *ImportOrgChartRequest, opts ...grpc.CallOption) (*ImportOrgChartResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*SetUserRoleResponse, error)
+	DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*DeactivateUserResponse, error)
+	ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
+	CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error)
+	ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*ListAPITokensResponse, error)
+	RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*RevokeAPITokenResponse, error)
+	StartGithubVerification(ctx context.Context, in *StartGithubVerificationRequest, opts ...grpc.CallOption) (*StartGithubVerificationResponse, error)
+	CompleteGithubVerification(ctx context.Context, in *CompleteGithubVerificationRequest, opts ...grpc.CallOption) (*CompleteGithubVerificationResponse, error)
 }
 
 type userServiceClient struct {
@@ -73,6 +121,246 @@ func (c *userServiceClient) GetUserProfile(ctx context.Context, in *GetUserProfi
 	return out, nil
 }
 
+func (c *userServiceClient) OffboardUser(ctx context.Context, in *OffboardUserRequest, opts ...grpc.CallOption) (*OffboardUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OffboardUserResponse)
+	err := c.cc.Invoke(ctx, UserService_OffboardUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetOffboardingReport(ctx context.Context, in *GetOffboardingReportRequest, opts ...grpc.CallOption) (*GetOffboardingReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOffboardingReportResponse)
+	err := c.cc.Invoke(ctx, UserService_GetOffboardingReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetDepartmentLead(ctx context.Context, in *SetDepartmentLeadRequest, opts ...grpc.CallOption) (*SetDepartmentLeadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDepartmentLeadResponse)
+	err := c.cc.Invoke(ctx, UserService_SetDepartmentLead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetDepartmentChampion(ctx context.Context, in *SetDepartmentChampionRequest, opts ...grpc.CallOption) (*SetDepartmentChampionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDepartmentChampionResponse)
+	err := c.cc.Invoke(ctx, UserService_SetDepartmentChampion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...grpc.CallOption) (*ListDepartmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDepartmentsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListDepartments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTeamResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamResponse)
+	err := c.cc.Invoke(ctx, UserService_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddTeamMember(ctx context.Context, in *AddTeamMemberRequest, opts ...grpc.CallOption) (*AddTeamMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTeamMemberResponse)
+	err := c.cc.Invoke(ctx, UserService_AddTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*RemoveTeamMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTeamMemberResponse)
+	err := c.cc.Invoke(ctx, UserService_RemoveTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetManager(ctx context.Context, in *SetManagerRequest, opts ...grpc.CallOption) (*SetManagerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetManagerResponse)
+	err := c.cc.Invoke(ctx, UserService_SetManager_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ImportOrgChart(ctx context.Context, in *ImportOrgChartRequest, opts ...grpc.CallOption) (*ImportOrgChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportOrgChartResponse)
+	err := c.cc.Invoke(ctx, UserService_ImportOrgChart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*SetUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserRoleResponse)
+	err := c.cc.Invoke(ctx, UserService_SetUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*DeactivateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateUserResponse)
+	err := c.cc.Invoke(ctx, UserService_DeactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateUserResponse)
+	err := c.cc.Invoke(ctx, UserService_ReactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPITokenResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*ListAPITokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPITokensResponse)
+	err := c.cc.Invoke(ctx, UserService_ListAPITokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*RevokeAPITokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPITokenResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartGithubVerification(ctx context.Context, in *StartGithubVerificationRequest, opts ...grpc.CallOption) (*StartGithubVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartGithubVerificationResponse)
+	err := c.cc.Invoke(ctx, UserService_StartGithubVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CompleteGithubVerification(ctx context.Context, in *CompleteGithubVerificationRequest, opts ...grpc.CallOption) (*CompleteGithubVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteGithubVerificationResponse)
+	err := c.cc.Invoke(ctx, UserService_CompleteGithubVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -82,96 +370,624 @@ type UserServiceServer interface {
 	RegisterContributor(context.Context, *RegisterContributorRequest) (*RegisterContributorResponse, error)
 	GetContributor(context.Context, *GetContributorRequest) (*GetContributorResponse, error)
 	GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error)
+	OffboardUser(context.Context, *OffboardUserRequest) (*OffboardUserResponse, error)
+	GetOffboardingReport(context.Context, *GetOffboardingReportRequest) (*GetOffboardingReportResponse, error)
+	SetDepartmentLead(context.Context, *SetDepartmentLeadRequest) (*SetDepartmentLeadResponse, error)
+	SetDepartmentChampion(context.Context, *SetDepartmentChampionRequest) (*SetDepartmentChampionResponse, error)
+	ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error)
+	CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error)
+	GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error)
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	AddTeamMember(context.Context, *AddTeamMemberRequest) (*AddTeamMemberResponse, error)
+	RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*RemoveTeamMemberResponse, error)
+	SetManager(context.Context, *SetManagerRequest) (*SetManagerResponse, error)
+	ImportOrgChart(context.Context, *ImportOrgChartRequest) (*ImportOrgChartResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	SetUserRole(context.Context, *SetUserRoleRequest) (*SetUserRoleResponse, error)
+	DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error)
+	ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
+	CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error)
+	ListAPITokens(context.Context, *ListAPITokensRequest) (*ListAPITokensResponse, error)
+	RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*RevokeAPITokenResponse, error)
+	StartGithubVerification(context.Context, *StartGithubVerificationRequest) (*StartGithubVerificationResponse, error)
+	CompleteGithubVerification(context.Context, *CompleteGithubVerificationRequest) (*CompleteGithubVerificationResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
-// UnimplementedUserServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedUserServiceServer struct{}
-
-func (UnimplementedUserServiceServer) RegisterContributor(context.Context, *RegisterContributorRequest) (*RegisterContributorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterContributor not implemented")
+// UnimplementedUserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) RegisterContributor(context.Context, *RegisterContributorRequest) (*RegisterContributorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterContributor not implemented")
+}
+func (UnimplementedUserServiceServer) GetContributor(context.Context, *GetContributorRequest) (*GetContributorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContributor not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
+}
+func (UnimplementedUserServiceServer) OffboardUser(context.Context, *OffboardUserRequest) (*OffboardUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffboardUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetOffboardingReport(context.Context, *GetOffboardingReportRequest) (*GetOffboardingReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOffboardingReport not implemented")
+}
+func (UnimplementedUserServiceServer) SetDepartmentLead(context.Context, *SetDepartmentLeadRequest) (*SetDepartmentLeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDepartmentLead not implemented")
+}
+func (UnimplementedUserServiceServer) SetDepartmentChampion(context.Context, *SetDepartmentChampionRequest) (*SetDepartmentChampionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDepartmentChampion not implemented")
+}
+func (UnimplementedUserServiceServer) ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDepartments not implemented")
+}
+func (UnimplementedUserServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedUserServiceServer) GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedUserServiceServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedUserServiceServer) AddTeamMember(context.Context, *AddTeamMemberRequest) (*AddTeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTeamMember not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*RemoveTeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMember not implemented")
+}
+func (UnimplementedUserServiceServer) SetManager(context.Context, *SetManagerRequest) (*SetManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetManager not implemented")
+}
+func (UnimplementedUserServiceServer) ImportOrgChart(context.Context, *ImportOrgChartRequest) (*ImportOrgChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOrgChart not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserServiceServer) SetUserRole(context.Context, *SetUserRoleRequest) (*SetUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRole not implemented")
+}
+func (UnimplementedUserServiceServer) DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateUser not implemented")
+}
+func (UnimplementedUserServiceServer) ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateUser not implemented")
+}
+func (UnimplementedUserServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedUserServiceServer) CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIToken not implemented")
+}
+func (UnimplementedUserServiceServer) ListAPITokens(context.Context, *ListAPITokensRequest) (*ListAPITokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPITokens not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*RevokeAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIToken not implemented")
+}
+func (UnimplementedUserServiceServer) StartGithubVerification(context.Context, *StartGithubVerificationRequest) (*StartGithubVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGithubVerification not implemented")
+}
+func (UnimplementedUserServiceServer) CompleteGithubVerification(context.Context, *CompleteGithubVerificationRequest) (*CompleteGithubVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteGithubVerification not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_RegisterContributor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterContributorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegisterContributor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RegisterContributor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegisterContributor(ctx, req.(*RegisterContributorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetContributor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContributorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetContributor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetContributor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetContributor(ctx, req.(*GetContributorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserProfile(ctx, req.(*GetUserProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_OffboardUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OffboardUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).OffboardUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_OffboardUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).OffboardUser(ctx, req.(*OffboardUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetOffboardingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOffboardingReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetOffboardingReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetOffboardingReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetOffboardingReport(ctx, req.(*GetOffboardingReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetDepartmentLead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDepartmentLeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetDepartmentLead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetDepartmentLead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetDepartmentLead(ctx, req.(*SetDepartmentLeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetDepartmentChampion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDepartmentChampionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetDepartmentChampion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetDepartmentChampion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetDepartmentChampion(ctx, req.(*SetDepartmentChampionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListDepartments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDepartmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListDepartments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListDepartments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListDepartments(ctx, req.(*ListDepartmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddTeamMember(ctx, req.(*AddTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveTeamMember(ctx, req.(*RemoveTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetManagerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetManager_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetManager(ctx, req.(*SetManagerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ImportOrgChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOrgChartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ImportOrgChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ImportOrgChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ImportOrgChart(ctx, req.(*ImportOrgChartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetUserRole(ctx, req.(*SetUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeactivateUser(ctx, req.(*DeactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) GetContributor(context.Context, *GetContributorRequest) (*GetContributorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetContributor not implemented")
+
+func _UserService_ReactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReactivateUser(ctx, req.(*ReactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
+
+func _UserService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
-func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UserServiceServer will
-// result in compilation errors.
-type UnsafeUserServiceServer interface {
-	mustEmbedUnimplementedUserServiceServer()
+func _UserService_CreateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateAPIToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateAPIToken(ctx, req.(*CreateAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
-	// If the following call pancis, it indicates UnimplementedUserServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _UserService_ListAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPITokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&UserService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAPITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAPITokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAPITokens(ctx, req.(*ListAPITokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_RegisterContributor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterContributorRequest)
+func _UserService_RevokeAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPITokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).RegisterContributor(ctx, in)
+		return srv.(UserServiceServer).RevokeAPIToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_RegisterContributor_FullMethodName,
+		FullMethod: UserService_RevokeAPIToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).RegisterContributor(ctx, req.(*RegisterContributorRequest))
+		return srv.(UserServiceServer).RevokeAPIToken(ctx, req.(*RevokeAPITokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetContributor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetContributorRequest)
+func _UserService_StartGithubVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGithubVerificationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetContributor(ctx, in)
+		return srv.(UserServiceServer).StartGithubVerification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetContributor_FullMethodName,
+		FullMethod: UserService_StartGithubVerification_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetContributor(ctx, req.(*GetContributorRequest))
+		return srv.(UserServiceServer).StartGithubVerification(ctx, req.(*StartGithubVerificationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserProfileRequest)
+func _UserService_CompleteGithubVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteGithubVerificationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserProfile(ctx, in)
+		return srv.(UserServiceServer).CompleteGithubVerification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUserProfile_FullMethodName,
+		FullMethod: UserService_CompleteGithubVerification_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserProfile(ctx, req.(*GetUserProfileRequest))
+		return srv.(UserServiceServer).CompleteGithubVerification(ctx, req.(*CompleteGithubVerificationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -195,17 +1011,117 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserProfile",
 			Handler:    _UserService_GetUserProfile_Handler,
 		},
+		{
+			MethodName: "OffboardUser",
+			Handler:    _UserService_OffboardUser_Handler,
+		},
+		{
+			MethodName: "GetOffboardingReport",
+			Handler:    _UserService_GetOffboardingReport_Handler,
+		},
+		{
+			MethodName: "SetDepartmentLead",
+			Handler:    _UserService_SetDepartmentLead_Handler,
+		},
+		{
+			MethodName: "SetDepartmentChampion",
+			Handler:    _UserService_SetDepartmentChampion_Handler,
+		},
+		{
+			MethodName: "ListDepartments",
+			Handler:    _UserService_ListDepartments_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _UserService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _UserService_GetTeam_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _UserService_ListTeams_Handler,
+		},
+		{
+			MethodName: "AddTeamMember",
+			Handler:    _UserService_AddTeamMember_Handler,
+		},
+		{
+			MethodName: "RemoveTeamMember",
+			Handler:    _UserService_RemoveTeamMember_Handler,
+		},
+		{
+			MethodName: "SetManager",
+			Handler:    _UserService_SetManager_Handler,
+		},
+		{
+			MethodName: "ImportOrgChart",
+			Handler:    _UserService_ImportOrgChart_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserService_ListUsers_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserService_SearchUsers_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UserService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "SetUserRole",
+			Handler:    _UserService_SetUserRole_Handler,
+		},
+		{
+			MethodName: "DeactivateUser",
+			Handler:    _UserService_DeactivateUser_Handler,
+		},
+		{
+			MethodName: "ReactivateUser",
+			Handler:    _UserService_ReactivateUser_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _UserService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateAPIToken",
+			Handler:    _UserService_CreateAPIToken_Handler,
+		},
+		{
+			MethodName: "ListAPITokens",
+			Handler:    _UserService_ListAPITokens_Handler,
+		},
+		{
+			MethodName: "RevokeAPIToken",
+			Handler:    _UserService_RevokeAPIToken_Handler,
+		},
+		{
+			MethodName: "StartGithubVerification",
+			Handler:    _UserService_StartGithubVerification_Handler,
+		},
+		{
+			MethodName: "CompleteGithubVerification",
+			Handler:    _UserService_CompleteGithubVerification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_service.proto",
 }
 
 const (
-	ProjectService_GetAuthoredProjects_FullMethodName     = "/backend.ProjectService/GetAuthoredProjects"
-	ProjectService_GetContributedProjects_FullMethodName  = "/backend.ProjectService/GetContributedProjects"
-	ProjectService_GetApprovedProjects_FullMethodName     = "/backend.ProjectService/GetApprovedProjects"
-	ProjectService_CreateProject_FullMethodName           = "/backend.ProjectService/CreateProject"
-	ProjectService_GetApprovedProjectsList_FullMethodName = "/backend.ProjectService/GetApprovedProjectsList"
+	ProjectService_GetAuthoredProjects_FullMethodName      = "/backend.ProjectService/GetAuthoredProjects"
+	ProjectService_GetContributedProjects_FullMethodName   = "/backend.ProjectService/GetContributedProjects"
+	ProjectService_GetApprovedProjects_FullMethodName      = "/backend.ProjectService/GetApprovedProjects"
+	ProjectService_CreateProject_FullMethodName            = "/backend.ProjectService/CreateProject"
+	ProjectService_GetApprovedProjectsList_FullMethodName  = "/backend.ProjectService/GetApprovedProjectsList"
+	ProjectService_ListRecertificationTasks_FullMethodName = "/backend.ProjectService/ListRecertificationTasks"
+	ProjectService_CompleteRecertification_FullMethodName  = "/backend.ProjectService/CompleteRecertification"
+	ProjectService_AddProjectContributor_FullMethodName    = "/backend.ProjectService/AddProjectContributor"
+	ProjectService_RemoveProjectContributor_FullMethodName = "/backend.ProjectService/RemoveProjectContributor"
 )
 
 // ProjectServiceClient is the client API for ProjectService service.
@@ -219,6 +1135,10 @@ type ProjectServiceClient interface {
 	GetApprovedProjects(ctx context.Context, in *GetApprovedProjectsRequest, opts ...grpc.CallOption) (*GetApprovedProjectsResponse, error)
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
 	GetApprovedProjectsList(ctx context.Context, in *GetApprovedProjectsListRequest, opts ...grpc.CallOption) (*GetApprovedProjectsListResponse, error)
+	ListRecertificationTasks(ctx context.Context, in *ListRecertificationTasksRequest, opts ...grpc.CallOption) (*ListRecertificationTasksResponse, error)
+	CompleteRecertification(ctx context.Context, in *CompleteRecertificationRequest, opts ...grpc.CallOption) (*CompleteRecertificationResponse, error)
+	AddProjectContributor(ctx context.Context, in *AddProjectContributorRequest, opts ...grpc.CallOption) (*AddProjectContributorResponse, error)
+	RemoveProjectContributor(ctx context.Context, in *RemoveProjectContributorRequest, opts ...grpc.CallOption) (*RemoveProjectContributorResponse, error)
 }
 
 type projectServiceClient struct {
@@ -279,6 +1199,46 @@ func (c *projectServiceClient) GetApprovedProjectsList(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *projectServiceClient) ListRecertificationTasks(ctx context.Context, in *ListRecertificationTasksRequest, opts ...grpc.CallOption) (*ListRecertificationTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecertificationTasksResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListRecertificationTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) CompleteRecertification(ctx context.Context, in *CompleteRecertificationRequest, opts ...grpc.CallOption) (*CompleteRecertificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteRecertificationResponse)
+	err := c.cc.Invoke(ctx, ProjectService_CompleteRecertification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) AddProjectContributor(ctx context.Context, in *AddProjectContributorRequest, opts ...grpc.CallOption) (*AddProjectContributorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddProjectContributorResponse)
+	err := c.cc.Invoke(ctx, ProjectService_AddProjectContributor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) RemoveProjectContributor(ctx context.Context, in *RemoveProjectContributorRequest, opts ...grpc.CallOption) (*RemoveProjectContributorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveProjectContributorResponse)
+	err := c.cc.Invoke(ctx, ProjectService_RemoveProjectContributor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 // All implementations must embed UnimplementedProjectServiceServer
 // for forward compatibility.
@@ -290,6 +1250,10 @@ type ProjectServiceServer interface {
 	GetApprovedProjects(context.Context, *GetApprovedProjectsRequest) (*GetApprovedProjectsResponse, error)
 	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
 	GetApprovedProjectsList(context.Context, *GetApprovedProjectsListRequest) (*GetApprovedProjectsListResponse, error)
+	ListRecertificationTasks(context.Context, *ListRecertificationTasksRequest) (*ListRecertificationTasksResponse, error)
+	CompleteRecertification(context.Context, *CompleteRecertificationRequest) (*CompleteRecertificationResponse, error)
+	AddProjectContributor(context.Context, *AddProjectContributorRequest) (*AddProjectContributorResponse, error)
+	RemoveProjectContributor(context.Context, *RemoveProjectContributorRequest) (*RemoveProjectContributorResponse, error)
 	mustEmbedUnimplementedProjectServiceServer()
 }
 
@@ -315,6 +1279,18 @@ func (UnimplementedProjectServiceServer) CreateProject(context.Context, *CreateP
 func (UnimplementedProjectServiceServer) GetApprovedProjectsList(context.Context, *GetApprovedProjectsListRequest) (*GetApprovedProjectsListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApprovedProjectsList not implemented")
 }
+func (UnimplementedProjectServiceServer) ListRecertificationTasks(context.Context, *ListRecertificationTasksRequest) (*ListRecertificationTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecertificationTasks not implemented")
+}
+func (UnimplementedProjectServiceServer) CompleteRecertification(context.Context, *CompleteRecertificationRequest) (*CompleteRecertificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteRecertification not implemented")
+}
+func (UnimplementedProjectServiceServer) AddProjectContributor(context.Context, *AddProjectContributorRequest) (*AddProjectContributorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProjectContributor not implemented")
+}
+func (UnimplementedProjectServiceServer) RemoveProjectContributor(context.Context, *RemoveProjectContributorRequest) (*RemoveProjectContributorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProjectContributor not implemented")
+}
 func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
 func (UnimplementedProjectServiceServer) testEmbeddedByValue()                        {}
 
@@ -360,68 +1336,140 @@ func _ProjectService_GetContributedProjects_Handler(srv interface{}, ctx context
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).GetContributedProjects(ctx, in)
+		return srv.(ProjectServiceServer).GetContributedProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetContributedProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetContributedProjects(ctx, req.(*GetContributedProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetApprovedProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovedProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetApprovedProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetApprovedProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetApprovedProjects(ctx, req.(*GetApprovedProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_CreateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetApprovedProjectsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovedProjectsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetApprovedProjectsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetApprovedProjectsList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetApprovedProjectsList(ctx, req.(*GetApprovedProjectsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListRecertificationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecertificationTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListRecertificationTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectService_GetContributedProjects_FullMethodName,
+		FullMethod: ProjectService_ListRecertificationTasks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).GetContributedProjects(ctx, req.(*GetContributedProjectsRequest))
+		return srv.(ProjectServiceServer).ListRecertificationTasks(ctx, req.(*ListRecertificationTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_GetApprovedProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApprovedProjectsRequest)
+func _ProjectService_CompleteRecertification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRecertificationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).GetApprovedProjects(ctx, in)
+		return srv.(ProjectServiceServer).CompleteRecertification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectService_GetApprovedProjects_FullMethodName,
+		FullMethod: ProjectService_CompleteRecertification_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).GetApprovedProjects(ctx, req.(*GetApprovedProjectsRequest))
+		return srv.(ProjectServiceServer).CompleteRecertification(ctx, req.(*CompleteRecertificationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
+func _ProjectService_AddProjectContributor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProjectContributorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).CreateProject(ctx, in)
+		return srv.(ProjectServiceServer).AddProjectContributor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectService_CreateProject_FullMethodName,
+		FullMethod: ProjectService_AddProjectContributor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+		return srv.(ProjectServiceServer).AddProjectContributor(ctx, req.(*AddProjectContributorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_GetApprovedProjectsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApprovedProjectsListRequest)
+func _ProjectService_RemoveProjectContributor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProjectContributorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).GetApprovedProjectsList(ctx, in)
+		return srv.(ProjectServiceServer).RemoveProjectContributor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectService_GetApprovedProjectsList_FullMethodName,
+		FullMethod: ProjectService_RemoveProjectContributor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).GetApprovedProjectsList(ctx, req.(*GetApprovedProjectsListRequest))
+		return srv.(ProjectServiceServer).RemoveProjectContributor(ctx, req.(*RemoveProjectContributorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -453,6 +1501,22 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetApprovedProjectsList",
 			Handler:    _ProjectService_GetApprovedProjectsList_Handler,
 		},
+		{
+			MethodName: "ListRecertificationTasks",
+			Handler:    _ProjectService_ListRecertificationTasks_Handler,
+		},
+		{
+			MethodName: "CompleteRecertification",
+			Handler:    _ProjectService_CompleteRecertification_Handler,
+		},
+		{
+			MethodName: "AddProjectContributor",
+			Handler:    _ProjectService_AddProjectContributor_Handler,
+		},
+		{
+			MethodName: "RemoveProjectContributor",
+			Handler:    _ProjectService_RemoveProjectContributor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_service.proto",
@@ -464,6 +1528,16 @@ const (
 	RequestService_SubmitAccessRequest_FullMethodName                 = "/backend.RequestService/SubmitAccessRequest"
 	RequestService_SubmitContributionPermissionRequest_FullMethodName = "/backend.RequestService/SubmitContributionPermissionRequest"
 	RequestService_GetRequests_FullMethodName                         = "/backend.RequestService/GetRequests"
+	RequestService_RecordContribution_FullMethodName                  = "/backend.RequestService/RecordContribution"
+	RequestService_ImportContributions_FullMethodName                 = "/backend.RequestService/ImportContributions"
+	RequestService_ListContributions_FullMethodName                   = "/backend.RequestService/ListContributions"
+	RequestService_GetContributionReport_FullMethodName               = "/backend.RequestService/GetContributionReport"
+	RequestService_ListPullRequestEvents_FullMethodName               = "/backend.RequestService/ListPullRequestEvents"
+	RequestService_CreateApprovalRule_FullMethodName                  = "/backend.RequestService/CreateApprovalRule"
+	RequestService_UpdateApprovalRule_FullMethodName                  = "/backend.RequestService/UpdateApprovalRule"
+	RequestService_ListApprovalRules_FullMethodName                   = "/backend.RequestService/ListApprovalRules"
+	RequestService_DryRunPolicy_FullMethodName                        = "/backend.RequestService/DryRunPolicy"
+	RequestService_ListAutoApprovedRequests_FullMethodName            = "/backend.RequestService/ListAutoApprovedRequests"
 )
 
 // RequestServiceClient is the client API for RequestService service.
@@ -477,6 +1551,16 @@ type RequestServiceClient interface {
 	SubmitAccessRequest(ctx context.Context, in *SubmitAccessRequestRequest, opts ...grpc.CallOption) (*SubmitAccessRequestResponse, error)
 	SubmitContributionPermissionRequest(ctx context.Context, in *SubmitContributionPermissionRequestRequest, opts ...grpc.CallOption) (*SubmitContributionPermissionRequestResponse, error)
 	GetRequests(ctx context.Context, in *GetRequestsRequest, opts ...grpc.CallOption) (*GetRequestsResponse, error)
+	RecordContribution(ctx context.Context, in *RecordContributionRequest, opts ...grpc.CallOption) (*RecordContributionResponse, error)
+	ImportContributions(ctx context.Context, in *ImportContributionsRequest, opts ...grpc.CallOption) (*ImportContributionsResponse, error)
+	ListContributions(ctx context.Context, in *ListContributionsRequest, opts ...grpc.CallOption) (*ListContributionsResponse, error)
+	GetContributionReport(ctx context.Context, in *GetContributionReportRequest, opts ...grpc.CallOption) (*GetContributionReportResponse, error)
+	ListPullRequestEvents(ctx context.Context, in *ListPullRequestEventsRequest, opts ...grpc.CallOption) (*ListPullRequestEventsResponse, error)
+	CreateApprovalRule(ctx context.Context, in *CreateApprovalRuleRequest, opts ...grpc.CallOption) (*CreateApprovalRuleResponse, error)
+	UpdateApprovalRule(ctx context.Context, in *UpdateApprovalRuleRequest, opts ...grpc.CallOption) (*UpdateApprovalRuleResponse, error)
+	ListApprovalRules(ctx context.Context, in *ListApprovalRulesRequest, opts ...grpc.CallOption) (*ListApprovalRulesResponse, error)
+	DryRunPolicy(ctx context.Context, in *DryRunPolicyRequest, opts ...grpc.CallOption) (*DryRunPolicyResponse, error)
+	ListAutoApprovedRequests(ctx context.Context, in *ListAutoApprovedRequestsRequest, opts ...grpc.CallOption) (*ListAutoApprovedRequestsResponse, error)
 }
 
 type requestServiceClient struct {
@@ -537,6 +1621,106 @@ func (c *requestServiceClient) GetRequests(ctx context.Context, in *GetRequestsR
 	return out, nil
 }
 
+func (c *requestServiceClient) RecordContribution(ctx context.Context, in *RecordContributionRequest, opts ...grpc.CallOption) (*RecordContributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordContributionResponse)
+	err := c.cc.Invoke(ctx, RequestService_RecordContribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requestServiceClient) ImportContributions(ctx context.Context, in *ImportContributionsRequest, opts ...grpc.CallOption) (*ImportContributionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportContributionsResponse)
+	err := c.cc.Invoke(ctx, RequestService_ImportContributions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requestServiceClient) ListContributions(ctx context.Context, in *ListContributionsRequest, opts ...grpc.CallOption) (*ListContributionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContributionsResponse)
+	err := c.cc.Invoke(ctx, RequestService_ListContributions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requestServiceClient) GetContributionReport(ctx context.Context, in *GetContributionReportRequest, opts ...grpc.CallOption) (*GetContributionReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContributionReportResponse)
+	err := c.cc.Invoke(ctx, RequestService_GetContributionReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requestServiceClient) ListPullRequestEvents(ctx context.Context, in *ListPullRequestEventsRequest, opts ...grpc.CallOption) (*ListPullRequestEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPullRequestEventsResponse)
+	err := c.cc.Invoke(ctx, RequestService_ListPullRequestEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requestServiceClient) CreateApprovalRule(ctx context.Context, in *CreateApprovalRuleRequest, opts ...grpc.CallOption) (*CreateApprovalRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApprovalRuleResponse)
+	err := c.cc.Invoke(ctx, RequestService_CreateApprovalRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requestServiceClient) UpdateApprovalRule(ctx context.Context, in *UpdateApprovalRuleRequest, opts ...grpc.CallOption) (*UpdateApprovalRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateApprovalRuleResponse)
+	err := c.cc.Invoke(ctx, RequestService_UpdateApprovalRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requestServiceClient) ListApprovalRules(ctx context.Context, in *ListApprovalRulesRequest, opts ...grpc.CallOption) (*ListApprovalRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApprovalRulesResponse)
+	err := c.cc.Invoke(ctx, RequestService_ListApprovalRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requestServiceClient) DryRunPolicy(ctx context.Context, in *DryRunPolicyRequest, opts ...grpc.CallOption) (*DryRunPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DryRunPolicyResponse)
+	err := c.cc.Invoke(ctx, RequestService_DryRunPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requestServiceClient) ListAutoApprovedRequests(ctx context.Context, in *ListAutoApprovedRequestsRequest, opts ...grpc.CallOption) (*ListAutoApprovedRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAutoApprovedRequestsResponse)
+	err := c.cc.Invoke(ctx, RequestService_ListAutoApprovedRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RequestServiceServer is the server API for RequestService service.
 // All implementations must embed UnimplementedRequestServiceServer
 // for forward compatibility.
@@ -548,6 +1732,16 @@ type RequestServiceServer interface {
 	SubmitAccessRequest(context.Context, *SubmitAccessRequestRequest) (*SubmitAccessRequestResponse, error)
 	SubmitContributionPermissionRequest(context.Context, *SubmitContributionPermissionRequestRequest) (*SubmitContributionPermissionRequestResponse, error)
 	GetRequests(context.Context, *GetRequestsRequest) (*GetRequestsResponse, error)
+	RecordContribution(context.Context, *RecordContributionRequest) (*RecordContributionResponse, error)
+	ImportContributions(context.Context, *ImportContributionsRequest) (*ImportContributionsResponse, error)
+	ListContributions(context.Context, *ListContributionsRequest) (*ListContributionsResponse, error)
+	GetContributionReport(context.Context, *GetContributionReportRequest) (*GetContributionReportResponse, error)
+	ListPullRequestEvents(context.Context, *ListPullRequestEventsRequest) (*ListPullRequestEventsResponse, error)
+	CreateApprovalRule(context.Context, *CreateApprovalRuleRequest) (*CreateApprovalRuleResponse, error)
+	UpdateApprovalRule(context.Context, *UpdateApprovalRuleRequest) (*UpdateApprovalRuleResponse, error)
+	ListApprovalRules(context.Context, *ListApprovalRulesRequest) (*ListApprovalRulesResponse, error)
+	DryRunPolicy(context.Context, *DryRunPolicyRequest) (*DryRunPolicyResponse, error)
+	ListAutoApprovedRequests(context.Context, *ListAutoApprovedRequestsRequest) (*ListAutoApprovedRequestsResponse, error)
 	mustEmbedUnimplementedRequestServiceServer()
 }
 
@@ -573,6 +1767,36 @@ func (UnimplementedRequestServiceServer) SubmitContributionPermissionRequest(con
 func (UnimplementedRequestServiceServer) GetRequests(context.Context, *GetRequestsRequest) (*GetRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRequests not implemented")
 }
+func (UnimplementedRequestServiceServer) RecordContribution(context.Context, *RecordContributionRequest) (*RecordContributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordContribution not implemented")
+}
+func (UnimplementedRequestServiceServer) ImportContributions(context.Context, *ImportContributionsRequest) (*ImportContributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportContributions not implemented")
+}
+func (UnimplementedRequestServiceServer) ListContributions(context.Context, *ListContributionsRequest) (*ListContributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContributions not implemented")
+}
+func (UnimplementedRequestServiceServer) GetContributionReport(context.Context, *GetContributionReportRequest) (*GetContributionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContributionReport not implemented")
+}
+func (UnimplementedRequestServiceServer) ListPullRequestEvents(context.Context, *ListPullRequestEventsRequest) (*ListPullRequestEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPullRequestEvents not implemented")
+}
+func (UnimplementedRequestServiceServer) CreateApprovalRule(context.Context, *CreateApprovalRuleRequest) (*CreateApprovalRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApprovalRule not implemented")
+}
+func (UnimplementedRequestServiceServer) UpdateApprovalRule(context.Context, *UpdateApprovalRuleRequest) (*UpdateApprovalRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApprovalRule not implemented")
+}
+func (UnimplementedRequestServiceServer) ListApprovalRules(context.Context, *ListApprovalRulesRequest) (*ListApprovalRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovalRules not implemented")
+}
+func (UnimplementedRequestServiceServer) DryRunPolicy(context.Context, *DryRunPolicyRequest) (*DryRunPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunPolicy not implemented")
+}
+func (UnimplementedRequestServiceServer) ListAutoApprovedRequests(context.Context, *ListAutoApprovedRequestsRequest) (*ListAutoApprovedRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAutoApprovedRequests not implemented")
+}
 func (UnimplementedRequestServiceServer) mustEmbedUnimplementedRequestServiceServer() {}
 func (UnimplementedRequestServiceServer) testEmbeddedByValue()                        {}
 
@@ -684,6 +1908,186 @@ func _RequestService_GetRequests_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RequestService_RecordContribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequestServiceServer).RecordContribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequestService_RecordContribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequestServiceServer).RecordContribution(ctx, req.(*RecordContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequestService_ImportContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportContributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequestServiceServer).ImportContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequestService_ImportContributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequestServiceServer).ImportContributions(ctx, req.(*ImportContributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequestService_ListContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequestServiceServer).ListContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequestService_ListContributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequestServiceServer).ListContributions(ctx, req.(*ListContributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequestService_GetContributionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContributionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequestServiceServer).GetContributionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequestService_GetContributionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequestServiceServer).GetContributionReport(ctx, req.(*GetContributionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequestService_ListPullRequestEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPullRequestEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequestServiceServer).ListPullRequestEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequestService_ListPullRequestEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequestServiceServer).ListPullRequestEvents(ctx, req.(*ListPullRequestEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequestService_CreateApprovalRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApprovalRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequestServiceServer).CreateApprovalRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequestService_CreateApprovalRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequestServiceServer).CreateApprovalRule(ctx, req.(*CreateApprovalRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequestService_UpdateApprovalRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApprovalRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequestServiceServer).UpdateApprovalRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequestService_UpdateApprovalRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequestServiceServer).UpdateApprovalRule(ctx, req.(*UpdateApprovalRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequestService_ListApprovalRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequestServiceServer).ListApprovalRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequestService_ListApprovalRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequestServiceServer).ListApprovalRules(ctx, req.(*ListApprovalRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequestService_DryRunPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequestServiceServer).DryRunPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequestService_DryRunPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequestServiceServer).DryRunPolicy(ctx, req.(*DryRunPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequestService_ListAutoApprovedRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAutoApprovedRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequestServiceServer).ListAutoApprovedRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequestService_ListAutoApprovedRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequestServiceServer).ListAutoApprovedRequests(ctx, req.(*ListAutoApprovedRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RequestService_ServiceDesc is the grpc.ServiceDesc for RequestService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -711,6 +2115,46 @@ var RequestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRequests",
 			Handler:    _RequestService_GetRequests_Handler,
 		},
+		{
+			MethodName: "RecordContribution",
+			Handler:    _RequestService_RecordContribution_Handler,
+		},
+		{
+			MethodName: "ImportContributions",
+			Handler:    _RequestService_ImportContributions_Handler,
+		},
+		{
+			MethodName: "ListContributions",
+			Handler:    _RequestService_ListContributions_Handler,
+		},
+		{
+			MethodName: "GetContributionReport",
+			Handler:    _RequestService_GetContributionReport_Handler,
+		},
+		{
+			MethodName: "ListPullRequestEvents",
+			Handler:    _RequestService_ListPullRequestEvents_Handler,
+		},
+		{
+			MethodName: "CreateApprovalRule",
+			Handler:    _RequestService_CreateApprovalRule_Handler,
+		},
+		{
+			MethodName: "UpdateApprovalRule",
+			Handler:    _RequestService_UpdateApprovalRule_Handler,
+		},
+		{
+			MethodName: "ListApprovalRules",
+			Handler:    _RequestService_ListApprovalRules_Handler,
+		},
+		{
+			MethodName: "DryRunPolicy",
+			Handler:    _RequestService_DryRunPolicy_Handler,
+		},
+		{
+			MethodName: "ListAutoApprovedRequests",
+			Handler:    _RequestService_ListAutoApprovedRequests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_service.proto",
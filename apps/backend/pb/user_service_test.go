@@ -19,7 +19,10 @@ func (m *mockUserServiceServer) GetContributor(ctx context.Context, in *GetContr
 	return &GetContributorResponse{
 		CorporateId:    in.GetCorporateId(),
 		GithubUsername: "mockuser",
-		ApprovedProjects: []string{"mock_project1", "mock_project2"},
+		ApprovedProjects: []*ContributorGrant{
+			{Id: "mock_request1", Type: "contribution_permission", ProjectName: "mock_project1"},
+			{Id: "mock_request2", Type: "access", ProjectName: "mock_project2", Role: "contributor"},
+		},
 	}, nil
 }
 
@@ -42,5 +45,6 @@ func TestGetContributor(t *testing.T) {
 	assert.NotNil(t, res)
 	assert.Equal(t, "testcorp", res.CorporateId)
 	assert.Equal(t, "mockuser", res.GithubUsername)
-	assert.Contains(t, res.ApprovedProjects, "mock_project1")
+	assert.Len(t, res.ApprovedProjects, 2)
+	assert.Equal(t, "mock_project1", res.ApprovedProjects[0].ProjectName)
 }
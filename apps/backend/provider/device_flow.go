@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"sourcestream/backend/config"
+)
+
+// Device flow outcomes other than success, normalized across verifiers.
+var (
+	// ErrAuthorizationPending is returned while the user has not yet entered the code.
+	ErrAuthorizationPending = errors.New("provider: authorization pending")
+	// ErrAuthorizationDenied is returned when the user declined the authorization.
+	ErrAuthorizationDenied = errors.New("provider: authorization denied")
+	// ErrAuthorizationExpired is returned when the device code expired before it was authorized.
+	ErrAuthorizationExpired = errors.New("provider: authorization expired")
+)
+
+// DeviceAuthorization is a started OAuth device flow. The user enters UserCode at
+// VerificationURI, and the flow is polled with DeviceCode no more often than Interval.
+type DeviceAuthorization struct {
+	DeviceCode      string
+	UserCode        string
+	VerificationURI string
+	ExpiresAt       time.Time
+	Interval        time.Duration
+}
+
+// AccountVerifier proves that a user controls a GitHub account through the OAuth device flow.
+type AccountVerifier interface {
+	// StartDeviceFlow starts a device flow for the account the user claims. Verifiers may use
+	// login as a hint; the account that authorizes is checked by the caller.
+	StartDeviceFlow(ctx context.Context, login string) (*DeviceAuthorization, error)
+	// PollDeviceFlow returns the login of the account that authorized the flow, or one of the
+	// ErrAuthorization errors.
+	PollDeviceFlow(ctx context.Context, deviceCode string) (string, error)
+}
+
+// NewAccountVerifier creates the AccountVerifier selected by the configuration. It returns nil
+// when verification is disabled.
+func NewAccountVerifier(cfg *config.GithubVerificationConfig) (AccountVerifier, error) {
+	switch cfg.Kind {
+	case "":
+		return nil, nil
+	case KindGitHub:
+		if cfg.ClientID == "" {
+			return nil, fmt.Errorf("GITHUB_OAUTH_CLIENT_ID is required for github verification")
+		}
+
+		return NewGitHubDeviceFlow(cfg.OAuthURL, cfg.APIURL, cfg.ClientID), nil
+	case KindFake:
+		return NewFakeAccountVerifier(true), nil
+	default:
+		return nil, fmt.Errorf("unsupported github verification %q", cfg.Kind)
+	}
+}
+
+const defaultGitHubOAuthURL = "https://github.com"
+
+// GitHubDeviceFlow is an AccountVerifier backed by a GitHub OAuth app with device flow enabled.
+// The access token it obtains is only used to read the authorizing user's login.
+type GitHubDeviceFlow struct {
+	oauth    *apiClient
+	apiURL   string
+	clientID string
+}
+
+// NewGitHubDeviceFlow creates a GitHubDeviceFlow for an OAuth app. Empty URLs use github.com
+// and api.github.com.
+func NewGitHubDeviceFlow(oauthURL, apiURL, clientID string) *GitHubDeviceFlow {
+	if oauthURL == "" {
+		oauthURL = defaultGitHubOAuthURL
+	}
+
+	if apiURL == "" {
+		apiURL = defaultGitHubURL
+	}
+
+	return &GitHubDeviceFlow{oauth: newAPIClient(oauthURL, nil), apiURL: apiURL, clientID: clientID}
+}
+
+type githubDeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+type githubAccessToken struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// StartDeviceFlow implements AccountVerifier. No scopes are requested; reading the user's
+// login needs none.
+func (g *GitHubDeviceFlow) StartDeviceFlow(ctx context.Context, _ string) (*DeviceAuthorization, error) {
+	var code githubDeviceCode
+
+	_, err := g.oauth.do(ctx, http.MethodPost, "/login/device/code", map[string]string{"client_id": g.clientID}, &code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeviceAuthorization{
+		DeviceCode:      code.DeviceCode,
+		UserCode:        code.UserCode,
+		VerificationURI: code.VerificationURI,
+		ExpiresAt:       time.Now().Add(time.Duration(code.ExpiresIn) * time.Second),
+		Interval:        time.Duration(code.Interval) * time.Second,
+	}, nil
+}
+
+// PollDeviceFlow implements AccountVerifier.
+func (g *GitHubDeviceFlow) PollDeviceFlow(ctx context.Context, deviceCode string) (string, error) {
+	var token githubAccessToken
+
+	_, err := g.oauth.do(ctx, http.MethodPost, "/login/oauth/access_token", map[string]string{
+		"client_id":   g.clientID,
+		"device_code": deviceCode,
+		"grant_type":  "urn:ietf:params:oauth:grant-type:device_code",
+	}, &token)
+	if err != nil {
+		return "", err
+	}
+
+	switch token.Error {
+	case "":
+	case "authorization_pending", "slow_down":
+		return "", ErrAuthorizationPending
+	case "access_denied":
+		return "", ErrAuthorizationDenied
+	case "expired_token":
+		return "", ErrAuthorizationExpired
+	default:
+		return "", fmt.Errorf("provider: device flow failed: %s: %s", token.Error, token.ErrorDescription)
+	}
+
+	api := newAPIClient(g.apiURL, func(r *http.Request) {
+		r.Header.Set("Accept", "application/vnd.github+json")
+		r.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	})
+
+	var user githubUser
+	if err := api.get(ctx, "/user", &user); err != nil {
+		return "", err
+	}
+
+	return user.Login, nil
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Fake is a deterministic in-memory Provider for tests and local development.
+// Data is seeded with the Add and Set methods; webhook IDs are assigned sequentially.
+type Fake struct {
+	mu           sync.Mutex
+	repositories map[string]*Repository
+	pullRequests map[string]*PullRequest
+	members      map[string][]*Member
+	webhooks     map[string][]*Webhook
+	nextHookID   int
+	host         string
+}
+
+// NewFake creates an empty Fake provider.
+func NewFake() *Fake {
+	return &Fake{
+		repositories: map[string]*Repository{},
+		pullRequests: map[string]*PullRequest{},
+		members:      map[string][]*Member{},
+		webhooks:     map[string][]*Webhook{},
+	}
+}
+
+// AddRepository seeds or replaces a repository.
+func (f *Fake) AddRepository(repo *Repository) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	copied := *repo
+	f.repositories[repoKey(repo.Owner, repo.Name)] = &copied
+}
+
+// AddPullRequest seeds or replaces a pull request.
+func (f *Fake) AddPullRequest(pr *PullRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	copied := *pr
+	f.pullRequests[pullRequestKey(pr.Owner, pr.Repo, pr.Number)] = &copied
+}
+
+// SetHost restricts the Fake to pull request URLs on host. A new Fake serves every host.
+func (f *Fake) SetHost(host string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.host = host
+}
+
+// SetOrgMembers replaces the members of an organization.
+func (f *Fake) SetOrgMembers(org string, members ...*Member) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	copied := make([]*Member, len(members))
+	for i, m := range members {
+		member := *m
+		copied[i] = &member
+	}
+
+	f.members[org] = copied
+}
+
+// Kind implements Provider.
+func (f *Fake) Kind() string { return KindFake }
+
+// Host implements Provider.
+func (f *Fake) Host() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.host
+}
+
+// GetRepository implements Provider.
+func (f *Fake) GetRepository(_ context.Context, owner, repo string) (*Repository, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r, ok := f.repositories[repoKey(owner, repo)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	copied := *r
+
+	return &copied, nil
+}
+
+// GetPullRequest implements Provider.
+func (f *Fake) GetPullRequest(_ context.Context, owner, repo string, number int) (*PullRequest, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pr, ok := f.pullRequests[pullRequestKey(owner, repo, number)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	copied := *pr
+
+	return &copied, nil
+}
+
+// ListOrgMembers implements Provider. Members are returned sorted by login.
+func (f *Fake) ListOrgMembers(_ context.Context, org string) ([]*Member, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stored, ok := f.members[org]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	members := make([]*Member, len(stored))
+	for i, m := range stored {
+		member := *m
+		members[i] = &member
+	}
+
+	sort.Slice(members, func(i, j int) bool { return members[i].Login < members[j].Login })
+
+	return members, nil
+}
+
+// IsOrgMember implements Provider.
+func (f *Fake) IsOrgMember(_ context.Context, org, login string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, m := range f.members[org] {
+		if m.Login == login {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// CreateWebhook implements Provider.
+func (f *Fake) CreateWebhook(_ context.Context, owner, repo string, cfg WebhookConfig) (*Webhook, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := repoKey(owner, repo)
+	if _, ok := f.repositories[key]; !ok {
+		return nil, ErrNotFound
+	}
+
+	f.nextHookID++
+	hook := &Webhook{
+		ID:     strconv.Itoa(f.nextHookID),
+		URL:    cfg.URL,
+		Events: append([]string(nil), cfg.Events...),
+		Active: true,
+	}
+	f.webhooks[key] = append(f.webhooks[key], hook)
+
+	copied := *hook
+
+	return &copied, nil
+}
+
+// ListWebhooks implements Provider.
+func (f *Fake) ListWebhooks(_ context.Context, owner, repo string) ([]*Webhook, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := repoKey(owner, repo)
+	if _, ok := f.repositories[key]; !ok {
+		return nil, ErrNotFound
+	}
+
+	hooks := make([]*Webhook, len(f.webhooks[key]))
+	for i, h := range f.webhooks[key] {
+		hook := *h
+		hooks[i] = &hook
+	}
+
+	return hooks, nil
+}
+
+// DeleteWebhook implements Provider.
+func (f *Fake) DeleteWebhook(_ context.Context, owner, repo, id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := repoKey(owner, repo)
+	for i, h := range f.webhooks[key] {
+		if h.ID == id {
+			f.webhooks[key] = append(f.webhooks[key][:i], f.webhooks[key][i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrNotFound
+}
+
+func repoKey(owner, repo string) string {
+	return owner + "/" + repo
+}
+
+func pullRequestKey(owner, repo string, number int) string {
+	return repoKey(owner, repo) + "#" + strconv.Itoa(number)
+}
+
+// FakeAccountVerifier is an in-memory AccountVerifier for tests and local development. Flows are
+// authorized with Approve or Deny, or, when auto-approving, by the account they were started for.
+type FakeAccountVerifier struct {
+	mu          sync.Mutex
+	autoApprove bool
+	flows       map[string]*fakeDeviceFlow // device code -> flow
+	nextCode    int
+}
+
+type fakeDeviceFlow struct {
+	userCode string
+	claimed  string
+	login    string
+	denied   bool
+}
+
+// NewFakeAccountVerifier creates a FakeAccountVerifier. With autoApprove, every flow is
+// authorized on its first poll by the account it was started for.
+func NewFakeAccountVerifier(autoApprove bool) *FakeAccountVerifier {
+	return &FakeAccountVerifier{autoApprove: autoApprove, flows: map[string]*fakeDeviceFlow{}}
+}
+
+// Approve authorizes the flow with the user code as the given account.
+func (f *FakeAccountVerifier) Approve(userCode, login string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, flow := range f.flows {
+		if flow.userCode == userCode {
+			flow.login = login
+		}
+	}
+}
+
+// Deny declines the flow with the user code.
+func (f *FakeAccountVerifier) Deny(userCode string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, flow := range f.flows {
+		if flow.userCode == userCode {
+			flow.denied = true
+		}
+	}
+}
+
+// StartDeviceFlow implements AccountVerifier. Codes are assigned sequentially.
+func (f *FakeAccountVerifier) StartDeviceFlow(_ context.Context, login string) (*DeviceAuthorization, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.nextCode++
+	deviceCode := "device-" + strconv.Itoa(f.nextCode)
+	userCode := fmt.Sprintf("FAKE-%04d", f.nextCode)
+
+	f.flows[deviceCode] = &fakeDeviceFlow{userCode: userCode, claimed: login}
+
+	return &DeviceAuthorization{
+		DeviceCode:      deviceCode,
+		UserCode:        userCode,
+		VerificationURI: "https://github.invalid/login/device",
+		ExpiresAt:       time.Now().Add(15 * time.Minute),
+		Interval:        time.Second,
+	}, nil
+}
+
+// PollDeviceFlow implements AccountVerifier.
+func (f *FakeAccountVerifier) PollDeviceFlow(_ context.Context, deviceCode string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	flow, ok := f.flows[deviceCode]
+
+	switch {
+	case !ok:
+		return "", ErrAuthorizationExpired
+	case flow.denied:
+		return "", ErrAuthorizationDenied
+	case flow.login != "":
+		return flow.login, nil
+	case f.autoApprove:
+		return flow.claimed, nil
+	default:
+		return "", ErrAuthorizationPending
+	}
+}
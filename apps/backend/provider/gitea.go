@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// giteaPageSize is the largest page size Gitea serves by default.
+const giteaPageSize = 50
+
+// Gitea is a Provider backed by the Gitea (or Forgejo) REST API.
+type Gitea struct {
+	client *apiClient
+	host   string
+}
+
+// NewGitea creates a Gitea provider for the instance at baseURL, e.g. https://gitea.example.com.
+// The /api/v1 suffix is added when missing.
+func NewGitea(baseURL, token string) *Gitea {
+	baseURL = strings.TrimRight(baseURL, "/")
+	if !strings.HasSuffix(baseURL, "/api/v1") {
+		baseURL += "/api/v1"
+	}
+
+	return &Gitea{
+		client: newAPIClient(baseURL, func(r *http.Request) {
+			if token != "" {
+				r.Header.Set("Authorization", "token "+token)
+			}
+		}),
+		host: hostOf(baseURL),
+	}
+}
+
+type giteaRepository struct {
+	Name          string     `json:"name"`
+	FullName      string     `json:"full_name"`
+	HTMLURL       string     `json:"html_url"`
+	Description   string     `json:"description"`
+	DefaultBranch string     `json:"default_branch"`
+	Stars         int        `json:"stars_count"`
+	Forks         int        `json:"forks_count"`
+	Archived      bool       `json:"archived"`
+	Private       bool       `json:"private"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	Owner         githubUser `json:"owner"`
+	Licenses      []string   `json:"licenses"`
+}
+
+type giteaHook struct {
+	ID     int64             `json:"id"`
+	Type   string            `json:"type,omitempty"`
+	Active bool              `json:"active"`
+	Events []string          `json:"events"`
+	Config map[string]string `json:"config"`
+}
+
+// Kind implements Provider.
+func (g *Gitea) Kind() string { return KindGitea }
+
+// Host implements Provider.
+func (g *Gitea) Host() string { return g.host }
+
+// GetRepository implements Provider.
+func (g *Gitea) GetRepository(ctx context.Context, owner, repo string) (*Repository, error) {
+	var r giteaRepository
+	if err := g.client.get(ctx, repoPath(owner, repo), &r); err != nil {
+		return nil, err
+	}
+
+	repository := &Repository{
+		Owner:         r.Owner.Login,
+		Name:          r.Name,
+		FullName:      r.FullName,
+		URL:           r.HTMLURL,
+		Description:   r.Description,
+		DefaultBranch: r.DefaultBranch,
+		Stars:         r.Stars,
+		Forks:         r.Forks,
+		Archived:      r.Archived,
+		Private:       r.Private,
+		UpdatedAt:     r.UpdatedAt,
+	}
+
+	if len(r.Licenses) > 0 {
+		repository.License = r.Licenses[0]
+	}
+
+	return repository, nil
+}
+
+// GetPullRequest implements Provider. Gitea pull requests share GitHub's JSON shape.
+func (g *Gitea) GetPullRequest(ctx context.Context, owner, repo string, number int) (*PullRequest, error) {
+	var pr githubPullRequest
+	if err := g.client.get(ctx, fmt.Sprintf("%s/pulls/%d", repoPath(owner, repo), number), &pr); err != nil {
+		return nil, err
+	}
+
+	state := pr.State
+	if pr.Merged || pr.MergedAt != nil {
+		state = StateMerged
+	}
+
+	return &PullRequest{
+		Owner:     owner,
+		Repo:      repo,
+		Number:    pr.Number,
+		Title:     pr.Title,
+		URL:       pr.HTMLURL,
+		State:     state,
+		Author:    pr.User.Login,
+		HeadSHA:   pr.Head.SHA,
+		CreatedAt: pr.CreatedAt,
+		UpdatedAt: pr.UpdatedAt,
+		MergedAt:  pr.MergedAt,
+		ClosedAt:  pr.ClosedAt,
+	}, nil
+}
+
+// ListOrgMembers implements Provider.
+func (g *Gitea) ListOrgMembers(ctx context.Context, org string) ([]*Member, error) {
+	var members []*Member
+
+	for page := 1; ; page++ {
+		var users []githubUser
+
+		path := fmt.Sprintf("/orgs/%s/members?limit=%d&page=%d", url.PathEscape(org), giteaPageSize, page)
+		if err := g.client.get(ctx, path, &users); err != nil {
+			return nil, err
+		}
+
+		for _, u := range users {
+			members = append(members, &Member{Login: u.Login, Role: "member"})
+		}
+
+		if len(users) < giteaPageSize {
+			return members, nil
+		}
+	}
+}
+
+// IsOrgMember implements Provider.
+func (g *Gitea) IsOrgMember(ctx context.Context, org, login string) (bool, error) {
+	path := fmt.Sprintf("/orgs/%s/members/%s", url.PathEscape(org), url.PathEscape(login))
+
+	status, err := g.client.do(ctx, http.MethodGet, path, nil, nil)
+	if err == ErrNotFound {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return status == http.StatusNoContent, nil
+}
+
+// CreateWebhook implements Provider.
+func (g *Gitea) CreateWebhook(ctx context.Context, owner, repo string, cfg WebhookConfig) (*Webhook, error) {
+	hook := giteaHook{
+		Type:   "gitea",
+		Active: true,
+		Events: cfg.Events,
+		Config: map[string]string{"url": cfg.URL, "content_type": "json", "secret": cfg.Secret},
+	}
+
+	var created giteaHook
+	if _, err := g.client.do(ctx, http.MethodPost, repoPath(owner, repo)+"/hooks", hook, &created); err != nil {
+		return nil, err
+	}
+
+	return created.toWebhook(), nil
+}
+
+// ListWebhooks implements Provider.
+func (g *Gitea) ListWebhooks(ctx context.Context, owner, repo string) ([]*Webhook, error) {
+	var hooks []giteaHook
+	if err := g.client.get(ctx, repoPath(owner, repo)+"/hooks", &hooks); err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*Webhook, len(hooks))
+	for i := range hooks {
+		webhooks[i] = hooks[i].toWebhook()
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook implements Provider.
+func (g *Gitea) DeleteWebhook(ctx context.Context, owner, repo, id string) error {
+	_, err := g.client.do(ctx, http.MethodDelete, repoPath(owner, repo)+"/hooks/"+url.PathEscape(id), nil, nil)
+	return err
+}
+
+func (h *giteaHook) toWebhook() *Webhook {
+	return &Webhook{
+		ID:     strconv.FormatInt(h.ID, 10),
+		URL:    h.Config["url"],
+		Events: h.Events,
+		Active: h.Active,
+	}
+}
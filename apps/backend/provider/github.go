@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultGitHubURL = "https://api.github.com"
+
+// GitHub is a Provider backed by the GitHub REST API (github.com or GitHub Enterprise Server).
+type GitHub struct {
+	client *apiClient
+	host   string
+}
+
+// NewGitHub creates a GitHub provider. An empty baseURL uses api.github.com.
+func NewGitHub(baseURL, token string) *GitHub {
+	if baseURL == "" {
+		baseURL = defaultGitHubURL
+	}
+
+	return &GitHub{
+		client: newAPIClient(baseURL, func(r *http.Request) {
+			r.Header.Set("Accept", "application/vnd.github+json")
+
+			if token != "" {
+				r.Header.Set("Authorization", "Bearer "+token)
+			}
+		}),
+		// github.com serves its API from api.github.com; Enterprise Server from /api/v3 on its own host
+		host: strings.TrimPrefix(hostOf(baseURL), "api."),
+	}
+}
+
+type githubUser struct {
+	Login string `json:"login"`
+}
+
+type githubRepository struct {
+	Name          string     `json:"name"`
+	FullName      string     `json:"full_name"`
+	HTMLURL       string     `json:"html_url"`
+	Description   string     `json:"description"`
+	DefaultBranch string     `json:"default_branch"`
+	Stars         int        `json:"stargazers_count"`
+	Forks         int        `json:"forks_count"`
+	Archived      bool       `json:"archived"`
+	Private       bool       `json:"private"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	Owner         githubUser `json:"owner"`
+	License       *struct {
+		SPDXID string `json:"spdx_id"`
+	} `json:"license"`
+}
+
+type githubPullRequest struct {
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	HTMLURL   string     `json:"html_url"`
+	State     string     `json:"state"`
+	Merged    bool       `json:"merged"`
+	User      githubUser `json:"user"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	MergedAt  *time.Time `json:"merged_at"`
+	ClosedAt  *time.Time `json:"closed_at"`
+	Head      struct {
+		SHA string `json:"sha"`
+	} `json:"head"`
+}
+
+type githubHook struct {
+	ID     int64    `json:"id"`
+	Name   string   `json:"name,omitempty"`
+	Active bool     `json:"active"`
+	Events []string `json:"events"`
+	Config struct {
+		URL         string `json:"url"`
+		ContentType string `json:"content_type,omitempty"`
+		Secret      string `json:"secret,omitempty"`
+	} `json:"config"`
+}
+
+// Kind implements Provider.
+func (g *GitHub) Kind() string { return KindGitHub }
+
+// Host implements Provider.
+func (g *GitHub) Host() string { return g.host }
+
+// GetRepository implements Provider.
+func (g *GitHub) GetRepository(ctx context.Context, owner, repo string) (*Repository, error) {
+	var r githubRepository
+	if err := g.client.get(ctx, repoPath(owner, repo), &r); err != nil {
+		return nil, err
+	}
+
+	repository := &Repository{
+		Owner:         r.Owner.Login,
+		Name:          r.Name,
+		FullName:      r.FullName,
+		URL:           r.HTMLURL,
+		Description:   r.Description,
+		DefaultBranch: r.DefaultBranch,
+		Stars:         r.Stars,
+		Forks:         r.Forks,
+		Archived:      r.Archived,
+		Private:       r.Private,
+		UpdatedAt:     r.UpdatedAt,
+	}
+
+	if r.License != nil {
+		repository.License = r.License.SPDXID
+	}
+
+	return repository, nil
+}
+
+// GetPullRequest implements Provider.
+func (g *GitHub) GetPullRequest(ctx context.Context, owner, repo string, number int) (*PullRequest, error) {
+	var pr githubPullRequest
+	if err := g.client.get(ctx, fmt.Sprintf("%s/pulls/%d", repoPath(owner, repo), number), &pr); err != nil {
+		return nil, err
+	}
+
+	state := pr.State
+	if pr.Merged || pr.MergedAt != nil {
+		state = StateMerged
+	}
+
+	return &PullRequest{
+		Owner:     owner,
+		Repo:      repo,
+		Number:    pr.Number,
+		Title:     pr.Title,
+		URL:       pr.HTMLURL,
+		State:     state,
+		Author:    pr.User.Login,
+		HeadSHA:   pr.Head.SHA,
+		CreatedAt: pr.CreatedAt,
+		UpdatedAt: pr.UpdatedAt,
+		MergedAt:  pr.MergedAt,
+		ClosedAt:  pr.ClosedAt,
+	}, nil
+}
+
+// ListOrgMembers implements Provider.
+func (g *GitHub) ListOrgMembers(ctx context.Context, org string) ([]*Member, error) {
+	var members []*Member
+
+	for page := 1; ; page++ {
+		var users []githubUser
+
+		path := fmt.Sprintf("/orgs/%s/members?per_page=%d&page=%d", url.PathEscape(org), pageSize, page)
+		if err := g.client.get(ctx, path, &users); err != nil {
+			return nil, err
+		}
+
+		for _, u := range users {
+			members = append(members, &Member{Login: u.Login, Role: "member"})
+		}
+
+		if len(users) < pageSize {
+			return members, nil
+		}
+	}
+}
+
+// IsOrgMember implements Provider.
+func (g *GitHub) IsOrgMember(ctx context.Context, org, login string) (bool, error) {
+	path := fmt.Sprintf("/orgs/%s/members/%s", url.PathEscape(org), url.PathEscape(login))
+
+	status, err := g.client.do(ctx, http.MethodGet, path, nil, nil)
+	if err == ErrNotFound {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return status == http.StatusNoContent, nil
+}
+
+// CreateWebhook implements Provider.
+func (g *GitHub) CreateWebhook(ctx context.Context, owner, repo string, cfg WebhookConfig) (*Webhook, error) {
+	hook := githubHook{Name: "web", Active: true, Events: cfg.Events}
+	hook.Config.URL = cfg.URL
+	hook.Config.ContentType = "json"
+	hook.Config.Secret = cfg.Secret
+
+	var created githubHook
+	if _, err := g.client.do(ctx, http.MethodPost, repoPath(owner, repo)+"/hooks", hook, &created); err != nil {
+		return nil, err
+	}
+
+	return created.toWebhook(), nil
+}
+
+// ListWebhooks implements Provider.
+func (g *GitHub) ListWebhooks(ctx context.Context, owner, repo string) ([]*Webhook, error) {
+	var hooks []githubHook
+	if err := g.client.get(ctx, repoPath(owner, repo)+"/hooks", &hooks); err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*Webhook, len(hooks))
+	for i := range hooks {
+		webhooks[i] = hooks[i].toWebhook()
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook implements Provider.
+func (g *GitHub) DeleteWebhook(ctx context.Context, owner, repo, id string) error {
+	_, err := g.client.do(ctx, http.MethodDelete, repoPath(owner, repo)+"/hooks/"+url.PathEscape(id), nil, nil)
+	return err
+}
+
+func (h *githubHook) toWebhook() *Webhook {
+	return &Webhook{
+		ID:     strconv.FormatInt(h.ID, 10),
+		URL:    h.Config.URL,
+		Events: h.Events,
+		Active: h.Active,
+	}
+}
+
+// pageSize is the page size requested from list endpoints.
+const pageSize = 100
+
+// repoPath returns the /repos/{owner}/{repo} path used by GitHub and Gitea.
+func repoPath(owner, repo string) string {
+	return "/repos/" + url.PathEscape(owner) + "/" + url.PathEscape(repo)
+}
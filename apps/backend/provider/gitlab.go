@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const defaultGitLabURL = "https://gitlab.com/api/v4"
+
+// gitlabRoles maps GitLab access levels to role names.
+var gitlabRoles = map[int]string{
+	10: "guest",
+	20: "reporter",
+	30: "developer",
+	40: "maintainer",
+	50: "owner",
+}
+
+// GitLab is a Provider backed by the GitLab REST API (gitlab.com or self-managed).
+// Repositories map to projects, organizations to groups and pull requests to merge requests.
+type GitLab struct {
+	client *apiClient
+	host   string
+}
+
+// NewGitLab creates a GitLab provider. An empty baseURL uses gitlab.com.
+func NewGitLab(baseURL, token string) *GitLab {
+	if baseURL == "" {
+		baseURL = defaultGitLabURL
+	}
+
+	return &GitLab{
+		client: newAPIClient(baseURL, func(r *http.Request) {
+			if token != "" {
+				r.Header.Set("PRIVATE-TOKEN", token)
+			}
+		}),
+		host: hostOf(baseURL),
+	}
+}
+
+type gitlabUser struct {
+	ID          int64  `json:"id"`
+	Username    string `json:"username"`
+	AccessLevel int    `json:"access_level"`
+}
+
+type gitlabProject struct {
+	Path              string    `json:"path"`
+	PathWithNamespace string    `json:"path_with_namespace"`
+	WebURL            string    `json:"web_url"`
+	Description       string    `json:"description"`
+	DefaultBranch     string    `json:"default_branch"`
+	Stars             int       `json:"star_count"`
+	Forks             int       `json:"forks_count"`
+	Archived          bool      `json:"archived"`
+	Visibility        string    `json:"visibility"`
+	LastActivityAt    time.Time `json:"last_activity_at"`
+	Namespace         struct {
+		FullPath string `json:"full_path"`
+	} `json:"namespace"`
+	License *struct {
+		Key      string `json:"key"`
+		Nickname string `json:"nickname"`
+	} `json:"license"`
+}
+
+type gitlabMergeRequest struct {
+	IID       int        `json:"iid"`
+	Title     string     `json:"title"`
+	WebURL    string     `json:"web_url"`
+	State     string     `json:"state"`
+	SHA       string     `json:"sha"`
+	Author    gitlabUser `json:"author"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	MergedAt  *time.Time `json:"merged_at"`
+	ClosedAt  *time.Time `json:"closed_at"`
+}
+
+type gitlabHook struct {
+	ID                  int64  `json:"id"`
+	URL                 string `json:"url"`
+	Token               string `json:"token,omitempty"`
+	PushEvents          bool   `json:"push_events"`
+	MergeRequestsEvents bool   `json:"merge_requests_events"`
+	EnableSSL           bool   `json:"enable_ssl_verification"`
+}
+
+// Kind implements Provider.
+func (g *GitLab) Kind() string { return KindGitLab }
+
+// Host implements Provider.
+func (g *GitLab) Host() string { return g.host }
+
+// GetRepository implements Provider.
+func (g *GitLab) GetRepository(ctx context.Context, owner, repo string) (*Repository, error) {
+	var p gitlabProject
+	if err := g.client.get(ctx, projectPath(owner, repo)+"?license=true", &p); err != nil {
+		return nil, err
+	}
+
+	repository := &Repository{
+		Owner:         p.Namespace.FullPath,
+		Name:          p.Path,
+		FullName:      p.PathWithNamespace,
+		URL:           p.WebURL,
+		Description:   p.Description,
+		DefaultBranch: p.DefaultBranch,
+		Stars:         p.Stars,
+		Forks:         p.Forks,
+		Archived:      p.Archived,
+		Private:       p.Visibility != "public",
+		UpdatedAt:     p.LastActivityAt,
+	}
+
+	if p.License != nil {
+		repository.License = p.License.Nickname
+		if repository.License == "" {
+			repository.License = p.License.Key
+		}
+	}
+
+	return repository, nil
+}
+
+// GetPullRequest implements Provider using the merge request with the given IID.
+func (g *GitLab) GetPullRequest(ctx context.Context, owner, repo string, number int) (*PullRequest, error) {
+	var mr gitlabMergeRequest
+	if err := g.client.get(ctx, fmt.Sprintf("%s/merge_requests/%d", projectPath(owner, repo), number), &mr); err != nil {
+		return nil, err
+	}
+
+	state := StateOpen
+
+	switch mr.State {
+	case "merged":
+		state = StateMerged
+	case "closed":
+		state = StateClosed
+	}
+
+	return &PullRequest{
+		Owner:     owner,
+		Repo:      repo,
+		Number:    mr.IID,
+		Title:     mr.Title,
+		URL:       mr.WebURL,
+		State:     state,
+		Author:    mr.Author.Username,
+		HeadSHA:   mr.SHA,
+		CreatedAt: mr.CreatedAt,
+		UpdatedAt: mr.UpdatedAt,
+		MergedAt:  mr.MergedAt,
+		ClosedAt:  mr.ClosedAt,
+	}, nil
+}
+
+// ListOrgMembers implements Provider using the members of the group, including inherited members.
+func (g *GitLab) ListOrgMembers(ctx context.Context, org string) ([]*Member, error) {
+	var members []*Member
+
+	for page := 1; ; page++ {
+		var users []gitlabUser
+
+		path := fmt.Sprintf("/groups/%s/members/all?per_page=%d&page=%d", url.PathEscape(org), pageSize, page)
+		if err := g.client.get(ctx, path, &users); err != nil {
+			return nil, err
+		}
+
+		for _, u := range users {
+			members = append(members, &Member{Login: u.Username, Role: gitlabRoles[u.AccessLevel]})
+		}
+
+		if len(users) < pageSize {
+			return members, nil
+		}
+	}
+}
+
+// IsOrgMember implements Provider.
+func (g *GitLab) IsOrgMember(ctx context.Context, org, login string) (bool, error) {
+	members, err := g.ListOrgMembers(ctx, org)
+	if err != nil {
+		return false, err
+	}
+
+	for _, m := range members {
+		if m.Login == login {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// CreateWebhook implements Provider. GitLab project hooks only support the pull_request
+// (merge request) and push events; other events are ignored.
+func (g *GitLab) CreateWebhook(ctx context.Context, owner, repo string, cfg WebhookConfig) (*Webhook, error) {
+	hook := gitlabHook{URL: cfg.URL, Token: cfg.Secret, EnableSSL: true}
+
+	for _, event := range cfg.Events {
+		switch event {
+		case EventPullRequest:
+			hook.MergeRequestsEvents = true
+		case EventPush:
+			hook.PushEvents = true
+		}
+	}
+
+	var created gitlabHook
+	if _, err := g.client.do(ctx, http.MethodPost, projectPath(owner, repo)+"/hooks", hook, &created); err != nil {
+		return nil, err
+	}
+
+	return created.toWebhook(), nil
+}
+
+// ListWebhooks implements Provider.
+func (g *GitLab) ListWebhooks(ctx context.Context, owner, repo string) ([]*Webhook, error) {
+	var hooks []gitlabHook
+	if err := g.client.get(ctx, projectPath(owner, repo)+"/hooks", &hooks); err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*Webhook, len(hooks))
+	for i := range hooks {
+		webhooks[i] = hooks[i].toWebhook()
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook implements Provider.
+func (g *GitLab) DeleteWebhook(ctx context.Context, owner, repo, id string) error {
+	_, err := g.client.do(ctx, http.MethodDelete, projectPath(owner, repo)+"/hooks/"+url.PathEscape(id), nil, nil)
+	return err
+}
+
+func (h *gitlabHook) toWebhook() *Webhook {
+	var events []string
+
+	if h.MergeRequestsEvents {
+		events = append(events, EventPullRequest)
+	}
+
+	if h.PushEvents {
+		events = append(events, EventPush)
+	}
+
+	return &Webhook{
+		ID:     strconv.FormatInt(h.ID, 10),
+		URL:    h.URL,
+		Events: events,
+		Active: true,
+	}
+}
+
+// projectPath returns the /projects/{id} path for a namespaced project.
+func projectPath(owner, repo string) string {
+	return "/projects/" + url.PathEscape(owner+"/"+repo)
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// APIError is returned when a provider API responds with an unexpected status.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("provider: %s %s returned %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
+
+// apiClient is the JSON-over-HTTP client shared by the provider adapters.
+type apiClient struct {
+	baseURL   string
+	http      *http.Client
+	authorize func(*http.Request)
+}
+
+func newAPIClient(baseURL string, authorize func(*http.Request)) *apiClient {
+	return &apiClient{
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		http:      &http.Client{Timeout: 15 * time.Second},
+		authorize: authorize,
+	}
+}
+
+// do sends a request with an optional JSON body and decodes a JSON response into out when non-nil.
+// It returns the response status code so callers can distinguish 204 and 404 replies.
+func (c *apiClient) do(ctx context.Context, method, path string, body, out interface{}) (int, error) {
+	var reader io.Reader
+
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.authorize != nil {
+		c.authorize(req)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return resp.StatusCode, ErrNotFound
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+		return resp.StatusCode, &APIError{StatusCode: resp.StatusCode, Method: method, Path: path, Body: string(data)}
+	}
+
+	if out != nil && resp.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp.StatusCode, fmt.Errorf("provider: failed to decode %s response: %w", path, err)
+		}
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (c *apiClient) get(ctx context.Context, path string, out interface{}) error {
+	_, err := c.do(ctx, http.MethodGet, path, nil, out)
+	return err
+}
@@ -0,0 +1,128 @@
+// Package provider abstracts the Git hosting services (GitHub, GitLab, Gitea) that SourceStream
+// reads upstream data from: repositories, pull requests, organization members and webhooks.
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sourcestream/backend/config"
+)
+
+// Supported provider kinds.
+const (
+	KindGitHub = "github"
+	KindGitLab = "gitlab"
+	KindGitea  = "gitea"
+	KindFake   = "fake"
+)
+
+// Pull request states, normalized across providers.
+const (
+	StateOpen   = "open"
+	StateClosed = "closed"
+	StateMerged = "merged"
+)
+
+// Webhook event names, normalized across providers.
+const (
+	EventPullRequest = "pull_request"
+	EventPush        = "push"
+	EventMember      = "member"
+	EventRepository  = "repository"
+)
+
+// ErrNotFound is returned when the requested repository, pull request or resource does not exist
+// or is not visible to the configured credentials.
+var ErrNotFound = errors.New("provider: not found")
+
+// Repository is upstream repository metadata.
+type Repository struct {
+	Owner         string
+	Name          string
+	FullName      string
+	URL           string
+	Description   string
+	DefaultBranch string
+	License       string
+	Stars         int
+	Forks         int
+	Archived      bool
+	Private       bool
+	UpdatedAt     time.Time
+}
+
+// PullRequest is an upstream pull request (a merge request on GitLab).
+type PullRequest struct {
+	Owner     string
+	Repo      string
+	Number    int
+	Title     string
+	URL       string
+	State     string
+	Author    string
+	HeadSHA   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	MergedAt  *time.Time
+	ClosedAt  *time.Time
+}
+
+// Member is a member of an upstream organization or group.
+type Member struct {
+	Login string
+	Role  string
+}
+
+// WebhookConfig describes a webhook to register on a repository.
+type WebhookConfig struct {
+	URL    string
+	Secret string
+	Events []string
+}
+
+// Webhook is a webhook registered on a repository.
+type Webhook struct {
+	ID     string
+	URL    string
+	Events []string
+	Active bool
+}
+
+// Provider is a Git hosting service.
+type Provider interface {
+	// Kind returns the provider kind, e.g. "github".
+	Kind() string
+	// Host returns the host of the web URLs the provider serves, e.g. "github.com". An empty host
+	// serves every URL.
+	Host() string
+	GetRepository(ctx context.Context, owner, repo string) (*Repository, error)
+	GetPullRequest(ctx context.Context, owner, repo string, number int) (*PullRequest, error)
+	ListOrgMembers(ctx context.Context, org string) ([]*Member, error)
+	IsOrgMember(ctx context.Context, org, login string) (bool, error)
+	CreateWebhook(ctx context.Context, owner, repo string, cfg WebhookConfig) (*Webhook, error)
+	ListWebhooks(ctx context.Context, owner, repo string) ([]*Webhook, error)
+	DeleteWebhook(ctx context.Context, owner, repo, id string) error
+}
+
+// New creates the Provider selected by the configuration.
+func New(cfg *config.ProviderConfig) (Provider, error) {
+	switch cfg.Kind {
+	case KindGitHub:
+		return NewGitHub(cfg.BaseURL, cfg.Token), nil
+	case KindGitLab:
+		return NewGitLab(cfg.BaseURL, cfg.Token), nil
+	case KindGitea:
+		if cfg.BaseURL == "" {
+			return nil, fmt.Errorf("GIT_PROVIDER_URL is required for gitea")
+		}
+
+		return NewGitea(cfg.BaseURL, cfg.Token), nil
+	case KindFake:
+		return NewFake(), nil
+	default:
+		return nil, fmt.Errorf("unsupported git provider %q", cfg.Kind)
+	}
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, routes map[string]interface{}) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.Method+" "+r.URL.RequestURI()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if status, isStatus := body.(int); isStatus {
+			w.WriteHeader(status)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestGitHub_GetPullRequest(t *testing.T) {
+	server := newTestServer(t, map[string]interface{}{
+		"GET /repos/facebook/react/pulls/42": map[string]interface{}{
+			"number":    42,
+			"title":     "Fix hydration warning",
+			"html_url":  "https://github.com/facebook/react/pull/42",
+			"state":     "closed",
+			"merged":    true,
+			"merged_at": "2025-01-02T03:04:05Z",
+			"user":      map[string]string{"login": "janesmith"},
+		},
+		"GET /orgs/facebook/members/janesmith": http.StatusNoContent,
+	})
+
+	gh := NewGitHub(server.URL, "token")
+
+	pr, err := gh.GetPullRequest(context.Background(), "facebook", "react", 42)
+	require.NoError(t, err)
+	assert.Equal(t, StateMerged, pr.State)
+	assert.Equal(t, "janesmith", pr.Author)
+	assert.NotNil(t, pr.MergedAt)
+
+	_, err = gh.GetPullRequest(context.Background(), "facebook", "react", 43)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	member, err := gh.IsOrgMember(context.Background(), "facebook", "janesmith")
+	require.NoError(t, err)
+	assert.True(t, member)
+
+	member, err = gh.IsOrgMember(context.Background(), "facebook", "bobwilson")
+	require.NoError(t, err)
+	assert.False(t, member)
+}
+
+func TestGitLab_GetPullRequest(t *testing.T) {
+	server := newTestServer(t, map[string]interface{}{
+		"GET /projects/gitlab-org%2Fgitlab/merge_requests/7": map[string]interface{}{
+			"iid":    7,
+			"title":  "Update docs",
+			"state":  "opened",
+			"author": map[string]string{"username": "bobwilson"},
+		},
+		"GET /groups/gitlab-org/members/all?per_page=100&page=1": []map[string]interface{}{
+			{"username": "bobwilson", "access_level": 40},
+		},
+	})
+
+	gl := NewGitLab(server.URL, "token")
+
+	pr, err := gl.GetPullRequest(context.Background(), "gitlab-org", "gitlab", 7)
+	require.NoError(t, err)
+	assert.Equal(t, StateOpen, pr.State)
+	assert.Equal(t, "bobwilson", pr.Author)
+
+	members, err := gl.ListOrgMembers(context.Background(), "gitlab-org")
+	require.NoError(t, err)
+	assert.Equal(t, []*Member{{Login: "bobwilson", Role: "maintainer"}}, members)
+}
+
+func TestGitea_GetRepository(t *testing.T) {
+	server := newTestServer(t, map[string]interface{}{
+		"GET /api/v1/repos/gitea/tea": map[string]interface{}{
+			"name":        "tea",
+			"full_name":   "gitea/tea",
+			"stars_count": 12,
+			"licenses":    []string{"MIT"},
+			"owner":       map[string]string{"login": "gitea"},
+		},
+	})
+
+	gt := NewGitea(server.URL, "token")
+
+	repo, err := gt.GetRepository(context.Background(), "gitea", "tea")
+	require.NoError(t, err)
+	assert.Equal(t, "gitea/tea", repo.FullName)
+	assert.Equal(t, "MIT", repo.License)
+	assert.Equal(t, 12, repo.Stars)
+}
+
+func TestProvider_Host(t *testing.T) {
+	assert.Equal(t, "github.com", NewGitHub("", "").Host())
+	assert.Equal(t, "ghe.example.com", NewGitHub("https://ghe.example.com/api/v3", "").Host())
+	assert.Equal(t, "gitlab.com", NewGitLab("", "").Host())
+	assert.Equal(t, "gitea.example.com", NewGitea("https://Gitea.example.com", "").Host())
+	assert.Empty(t, NewFake().Host())
+}
+
+func TestFake(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFake()
+	fake.AddRepository(&Repository{Owner: "vuejs", Name: "vue", License: "MIT"})
+	fake.AddPullRequest(&PullRequest{Owner: "vuejs", Repo: "vue", Number: 1, State: StateOpen})
+	fake.SetOrgMembers("vuejs", &Member{Login: "zed"}, &Member{Login: "amy"})
+
+	pr, err := fake.GetPullRequest(ctx, "vuejs", "vue", 1)
+	require.NoError(t, err)
+	assert.Equal(t, StateOpen, pr.State)
+
+	// Returned values are copies
+	pr.State = StateMerged
+	pr, _ = fake.GetPullRequest(ctx, "vuejs", "vue", 1)
+	assert.Equal(t, StateOpen, pr.State)
+
+	members, err := fake.ListOrgMembers(ctx, "vuejs")
+	require.NoError(t, err)
+	assert.Equal(t, "amy", members[0].Login)
+
+	hook, err := fake.CreateWebhook(ctx, "vuejs", "vue", WebhookConfig{URL: "https://sourcestream/webhooks", Events: []string{EventPullRequest}})
+	require.NoError(t, err)
+	assert.Equal(t, "1", hook.ID)
+
+	require.NoError(t, fake.DeleteWebhook(ctx, "vuejs", "vue", hook.ID))
+	assert.ErrorIs(t, fake.DeleteWebhook(ctx, "vuejs", "vue", hook.ID), ErrNotFound)
+
+	_, err = fake.GetRepository(ctx, "vuejs", "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGitHubDeviceFlow(t *testing.T) {
+	server := newTestServer(t, map[string]interface{}{
+		"POST /login/device/code": map[string]interface{}{
+			"device_code":      "dev-1",
+			"user_code":        "WDJB-MJHT",
+			"verification_uri": "https://github.com/login/device",
+			"expires_in":       900,
+			"interval":         5,
+		},
+		"POST /login/oauth/access_token": map[string]string{"access_token": "gho_1"},
+		"GET /user":                      map[string]string{"login": "janesmith"},
+	})
+
+	flow := NewGitHubDeviceFlow(server.URL, server.URL, "client")
+
+	auth, err := flow.StartDeviceFlow(context.Background(), "janesmith")
+	require.NoError(t, err)
+	assert.Equal(t, "WDJB-MJHT", auth.UserCode)
+	assert.Equal(t, 5*time.Second, auth.Interval)
+
+	login, err := flow.PollDeviceFlow(context.Background(), auth.DeviceCode)
+	require.NoError(t, err)
+	assert.Equal(t, "janesmith", login)
+
+	pending := newTestServer(t, map[string]interface{}{
+		"POST /login/oauth/access_token": map[string]string{"error": "authorization_pending"},
+	})
+
+	_, err = NewGitHubDeviceFlow(pending.URL, pending.URL, "client").PollDeviceFlow(context.Background(), "dev-1")
+	assert.ErrorIs(t, err, ErrAuthorizationPending)
+}
+
+func TestFakeAccountVerifier(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeAccountVerifier(false)
+
+	auth, err := fake.StartDeviceFlow(ctx, "janesmith")
+	require.NoError(t, err)
+
+	_, err = fake.PollDeviceFlow(ctx, auth.DeviceCode)
+	assert.ErrorIs(t, err, ErrAuthorizationPending)
+
+	fake.Approve(auth.UserCode, "someone-else")
+	login, err := fake.PollDeviceFlow(ctx, auth.DeviceCode)
+	require.NoError(t, err)
+	assert.Equal(t, "someone-else", login)
+
+	denied, err := fake.StartDeviceFlow(ctx, "janesmith")
+	require.NoError(t, err)
+	fake.Deny(denied.UserCode)
+	_, err = fake.PollDeviceFlow(ctx, denied.DeviceCode)
+	assert.ErrorIs(t, err, ErrAuthorizationDenied)
+
+	auto := NewFakeAccountVerifier(true)
+	auth, err = auto.StartDeviceFlow(ctx, "janesmith")
+	require.NoError(t, err)
+	login, err = auto.PollDeviceFlow(ctx, auth.DeviceCode)
+	require.NoError(t, err)
+	assert.Equal(t, "janesmith", login)
+}
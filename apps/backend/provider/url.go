@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// PullRequestRef identifies a pull request by its location on a hosting provider.
+type PullRequestRef struct {
+	Host   string
+	Owner  string
+	Repo   string
+	Number int
+}
+
+// hostOf returns the host of an API base URL.
+func hostOf(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToLower(u.Host)
+}
+
+// ParsePullRequestURL parses a pull request web URL in any of the supported layouts:
+// GitHub (/owner/repo/pull/N), Gitea (/owner/repo/pulls/N) and GitLab
+// (/group/subgroup/project/-/merge_requests/N, where the owner may contain slashes).
+func ParsePullRequestURL(rawURL string) (*PullRequestRef, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return nil, fmt.Errorf("invalid pull request URL %q", rawURL)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	var repoPath []string
+
+	var number string
+
+	switch n := len(segments); {
+	case n >= 5 && segments[n-3] == "-" && segments[n-2] == "merge_requests":
+		repoPath, number = segments[:n-3], segments[n-1]
+	case n == 4 && (segments[2] == "pull" || segments[2] == "pulls"):
+		repoPath, number = segments[:2], segments[3]
+	default:
+		return nil, fmt.Errorf("unrecognized pull request URL %q", rawURL)
+	}
+
+	num, err := strconv.Atoi(number)
+	if err != nil || num <= 0 {
+		return nil, fmt.Errorf("invalid pull request number in %q", rawURL)
+	}
+
+	if len(repoPath) < 2 {
+		return nil, fmt.Errorf("missing repository in pull request URL %q", rawURL)
+	}
+
+	return &PullRequestRef{
+		Host:   u.Host,
+		Owner:  strings.Join(repoPath[:len(repoPath)-1], "/"),
+		Repo:   repoPath[len(repoPath)-1],
+		Number: num,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePullRequestURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		want    *PullRequestRef
+		wantErr bool
+	}{
+		{
+			url:  "https://github.com/facebook/react/pull/123",
+			want: &PullRequestRef{Host: "github.com", Owner: "facebook", Repo: "react", Number: 123},
+		},
+		{
+			url:     "https://gitea.example.com/org/tool/pulls/9/files",
+			wantErr: true,
+		},
+		{
+			url:  "https://gitea.example.com/org/tool/pulls/9",
+			want: &PullRequestRef{Host: "gitea.example.com", Owner: "org", Repo: "tool", Number: 9},
+		},
+		{
+			url:  "https://gitlab.com/gitlab-org/security/gitlab/-/merge_requests/4567",
+			want: &PullRequestRef{Host: "gitlab.com", Owner: "gitlab-org/security", Repo: "gitlab", Number: 4567},
+		},
+		{url: "https://github.com/facebook/react", wantErr: true},
+		{url: "https://github.com/facebook/react/pull/abc", wantErr: true},
+		{url: "ftp://github.com/facebook/react/pull/1", wantErr: true},
+		{url: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePullRequestURL(tt.url)
+		if tt.wantErr {
+			assert.Error(t, err, tt.url)
+			continue
+		}
+
+		assert.NoError(t, err, tt.url)
+		assert.Equal(t, tt.want, got, tt.url)
+	}
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"sourcestream/backend/models"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ErrAPITokenNotFound is returned when no API token matches a lookup.
+var ErrAPITokenNotFound error = &NotFoundError{Resource: "API token"}
+
+// lastUsedResolution is how stale an API token's last_used_at may get before a use updates it,
+// so that busy pipelines do not write on every call.
+const lastUsedResolution = time.Minute
+
+const apiTokenColumns = `id, user_id, name, prefix, token_hash, scopes, expires_at, last_used_at, revoked_at,
+		created_by, created_at`
+
+// APITokenRepository provides DB operations for API tokens.
+type APITokenRepository struct {
+	db *sql.DB
+}
+
+// NewAPITokenRepository creates a new APITokenRepository with the given DB handle.
+func NewAPITokenRepository(db *sql.DB) *APITokenRepository {
+	return &APITokenRepository{db: db}
+}
+
+// CreateAPIToken inserts a new API token.
+func (r *APITokenRepository) CreateAPIToken(ctx context.Context, token *models.APIToken) error {
+	query := `
+		INSERT INTO api_tokens (id, user_id, name, prefix, token_hash, scopes, expires_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at`
+
+	if token.ID == "" {
+		token.ID = uuid.New().String()
+	}
+
+	return r.db.QueryRowContext(ctx, query, token.ID, token.UserID, token.Name, token.Prefix, token.TokenHash,
+		pq.Array(token.Scopes), token.ExpiresAt, token.CreatedBy).Scan(&token.CreatedAt)
+}
+
+// GetAPITokenByHash returns the API token whose secret has the given hash, including revoked and
+// expired tokens.
+func (r *APITokenRepository) GetAPITokenByHash(ctx context.Context, hash string) (*models.APIToken, error) {
+	query := `SELECT ` + apiTokenColumns + ` FROM api_tokens WHERE token_hash = $1`
+
+	token, err := scanAPIToken(r.db.QueryRowContext(ctx, query, hash))
+	if err == sql.ErrNoRows {
+		return nil, ErrAPITokenNotFound
+	}
+
+	return token, err
+}
+
+// ListAPITokens returns a user's API tokens, newest first. Revoked tokens are included only when
+// asked for.
+func (r *APITokenRepository) ListAPITokens(ctx context.Context, userID string, includeRevoked bool) ([]*models.APIToken, error) {
+	query := `
+		SELECT ` + apiTokenColumns + `
+		FROM api_tokens
+		WHERE user_id = $1 AND ($2 OR revoked_at IS NULL)
+		ORDER BY created_at DESC, id`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, includeRevoked)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var tokens []*models.APIToken
+
+	for rows.Next() {
+		token, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, rows.Err()
+}
+
+// RevokeAPIToken revokes one of a user's API tokens and returns it. Revoking a revoked token
+// keeps its original revocation time.
+func (r *APITokenRepository) RevokeAPIToken(ctx context.Context, userID, tokenID string) (*models.APIToken, error) {
+	query := `
+		UPDATE api_tokens SET revoked_at = COALESCE(revoked_at, CURRENT_TIMESTAMP)
+		WHERE id = $1 AND user_id = $2
+		RETURNING ` + apiTokenColumns
+
+	token, err := scanAPIToken(r.db.QueryRowContext(ctx, query, tokenID, userID))
+	if err == sql.ErrNoRows {
+		return nil, notFound(ErrAPITokenNotFound, tokenID)
+	}
+
+	return token, err
+}
+
+// TouchAPIToken records that an API token was used, unless that was already recorded recently.
+func (r *APITokenRepository) TouchAPIToken(ctx context.Context, id string) error {
+	query := `
+		UPDATE api_tokens SET last_used_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < CURRENT_TIMESTAMP - $2 * INTERVAL '1 second')`
+
+	_, err := r.db.ExecContext(ctx, query, id, lastUsedResolution.Seconds())
+
+	return err
+}
+
+func scanAPIToken(row rowScanner) (*models.APIToken, error) {
+	token := &models.APIToken{}
+
+	var scopes pq.StringArray
+
+	err := row.Scan(
+		&token.ID, &token.UserID, &token.Name, &token.Prefix, &token.TokenHash, &scopes,
+		&token.ExpiresAt, &token.LastUsedAt, &token.RevokedAt, &token.CreatedBy, &token.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	token.Scopes = scopes
+
+	return token, nil
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"sourcestream/backend/models"
+)
+
+// ErrApprovalRuleNotFound is returned when no approval rule, or rule version, matches a lookup.
+var ErrApprovalRuleNotFound error = &NotFoundError{Resource: "approval rule"}
+
+const approvalRuleColumns = `ar.id, ar.name, COALESCE(ar.description, ''), ar.is_enabled, v.version,
+		COALESCE(v.request_type, ''), v.expression, v.action, v.target, v.created_by, ar.created_at, v.created_at`
+
+// ApprovalRuleRepository provides DB operations for versioned approval rules.
+type ApprovalRuleRepository struct {
+	db *sql.DB
+}
+
+// NewApprovalRuleRepository creates a new ApprovalRuleRepository with the given DB handle.
+func NewApprovalRuleRepository(db *sql.DB) *ApprovalRuleRepository {
+	return &ApprovalRuleRepository{db: db}
+}
+
+// CreateApprovalRule inserts a rule together with its first version.
+func (r *ApprovalRuleRepository) CreateApprovalRule(ctx context.Context, rule *models.ApprovalRule) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO approval_rules (name, description, is_enabled)
+		VALUES ($1, NULLIF($2, ''), $3)
+		RETURNING id, created_at`,
+		rule.Name, rule.Description, rule.IsEnabled,
+	).Scan(&rule.ID, &rule.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	rule.Version = 1
+
+	if err := insertApprovalRuleVersion(ctx, tx, rule); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateApprovalRule stores a new version of a rule and makes it current. The rule's name,
+// description and enabled flag are updated in place.
+func (r *ApprovalRuleRepository) UpdateApprovalRule(ctx context.Context, rule *models.ApprovalRule) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE approval_rules
+		SET name = $2, description = NULLIF($3, ''), is_enabled = $4, current_version = current_version + 1
+		WHERE id = $1
+		RETURNING current_version, created_at`,
+		rule.ID, rule.Name, rule.Description, rule.IsEnabled,
+	).Scan(&rule.Version, &rule.CreatedAt)
+	if err == sql.ErrNoRows {
+		return notFound(ErrApprovalRuleNotFound, rule.ID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := insertApprovalRuleVersion(ctx, tx, rule); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertApprovalRuleVersion(ctx context.Context, tx *sql.Tx, rule *models.ApprovalRule) error {
+	return tx.QueryRowContext(ctx, `
+		INSERT INTO approval_rule_versions (rule_id, version, request_type, expression, action, target, created_by)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7)
+		RETURNING created_at`,
+		rule.ID, rule.Version, rule.RequestType, rule.Expression, rule.Action, rule.Target, rule.CreatedBy,
+	).Scan(&rule.UpdatedAt)
+}
+
+// GetApprovalRule returns a rule at the given version, or at its current version when version is 0.
+func (r *ApprovalRuleRepository) GetApprovalRule(ctx context.Context, id string, version int) (*models.ApprovalRule, error) {
+	query := `
+		SELECT ` + approvalRuleColumns + `
+		FROM approval_rules ar
+		INNER JOIN approval_rule_versions v ON v.rule_id = ar.id
+		WHERE ar.id = $1 AND v.version = CASE WHEN $2 > 0 THEN $2 ELSE ar.current_version END`
+
+	rows, err := r.db.QueryContext(ctx, query, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	rules, err := scanApprovalRules(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rules) == 0 {
+		return nil, notFound(ErrApprovalRuleNotFound, id)
+	}
+
+	return rules[0], nil
+}
+
+// ListApprovalRules returns every rule at its current version, optionally only enabled rules.
+func (r *ApprovalRuleRepository) ListApprovalRules(ctx context.Context, enabledOnly bool) ([]*models.ApprovalRule, error) {
+	query := `
+		SELECT ` + approvalRuleColumns + `
+		FROM approval_rules ar
+		INNER JOIN approval_rule_versions v ON v.rule_id = ar.id AND v.version = ar.current_version
+		WHERE ($1 = false OR ar.is_enabled)
+		ORDER BY ar.name ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, enabledOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return scanApprovalRules(rows)
+}
+
+// RecordRuleMatches stores the rules that matched a request.
+func (r *ApprovalRuleRepository) RecordRuleMatches(ctx context.Context, matches []*models.RequestRuleMatch) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	for _, match := range matches {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO request_rule_matches (request_id, rule_id, rule_version, action, target, assignee_id)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (request_id, rule_id) DO NOTHING`,
+			match.RequestID, match.RuleID, match.RuleVersion, match.Action, match.Target, match.AssigneeID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func scanApprovalRules(rows *sql.Rows) ([]*models.ApprovalRule, error) {
+	var rules []*models.ApprovalRule
+
+	for rows.Next() {
+		rule := &models.ApprovalRule{}
+
+		err := rows.Scan(
+			&rule.ID, &rule.Name, &rule.Description, &rule.IsEnabled, &rule.Version,
+			&rule.RequestType, &rule.Expression, &rule.Action, &rule.Target, &rule.CreatedBy,
+			&rule.CreatedAt, &rule.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
@@ -0,0 +1,468 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"sourcestream/backend/models"
+
+	"github.com/lib/pq"
+)
+
+// ErrApprovedProjectNotFound is returned when no approved project matches a lookup.
+var ErrApprovedProjectNotFound error = &NotFoundError{Resource: "approved project"}
+
+// ErrRecertificationTaskNotFound is returned when no recertification task that can still be completed
+// matches a lookup.
+var ErrRecertificationTaskNotFound error = &NotFoundError{Resource: "recertification task"}
+
+// Recertification task statuses.
+const (
+	RecertificationStatusOpen        = "open"
+	RecertificationStatusRecertified = "recertified"
+	RecertificationStatusRetired     = "retired"
+	RecertificationStatusLapsed      = "lapsed"
+)
+
+// FlagCatalogLapsed marks an approved contribution permission whose catalog entry was deactivated.
+const FlagCatalogLapsed = "catalog_lapsed"
+
+const approvedProjectColumns = `id, name, description, repository_url, license, contribution_type, maintainer_contact,
+		approval_date, is_active, allowed_contribution_types, review_interval_days, next_review_date, last_reviewed_at,
+		created_at, updated_at`
+
+// ApprovedProjectRepository provides DB operations for the approved projects catalog and its re-certification.
+type ApprovedProjectRepository struct {
+	db *sql.DB
+}
+
+// NewApprovedProjectRepository creates a new ApprovedProjectRepository with the given DB handle.
+func NewApprovedProjectRepository(db *sql.DB) *ApprovedProjectRepository {
+	return &ApprovedProjectRepository{db: db}
+}
+
+// GetApprovedProjectByID returns an approved project by its ID.
+func (r *ApprovedProjectRepository) GetApprovedProjectByID(ctx context.Context, id string) (*models.ApprovedProject, error) {
+	query := `SELECT ` + approvedProjectColumns + ` FROM approved_projects WHERE id = $1`
+
+	project, err := scanApprovedProject(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, notFound(ErrApprovedProjectNotFound, id)
+	}
+
+	return project, err
+}
+
+// Approved project list sort keys.
+const (
+	ApprovedProjectSortName         = "name"
+	ApprovedProjectSortApprovalDate = "approval_date"
+)
+
+// ApprovedProjectCursor identifies the last row of a previous page for keyset pagination.
+type ApprovedProjectCursor struct {
+	Name         string    `json:"n,omitempty"`
+	ApprovalDate time.Time `json:"d"`
+	ID           string    `json:"id"`
+}
+
+// ApprovedProjectFilter holds the filters, ordering and page bounds for ListApprovedProjects.
+type ApprovedProjectFilter struct {
+	ActiveOnly              bool
+	License                 string
+	ContributionType        string
+	AllowedContributionType string
+	Query                   string
+	SortBy                  string
+	Descending              bool
+	After                   *ApprovedProjectCursor
+	Limit                   int
+}
+
+// ListApprovedProjects returns a page of the approved projects catalog matching the filter.
+// Rows are ordered by the sort key with id as a tie-breaker, and After continues from a previous page.
+// Both sort keys are NOT NULL, so the keyset comparison covers every row.
+func (r *ApprovedProjectRepository) ListApprovedProjects(ctx context.Context, filter ApprovedProjectFilter) ([]*models.ApprovedProject, error) {
+	cond := filter.condition()
+
+	direction := "ASC"
+	if filter.Descending {
+		direction = "DESC"
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM approved_projects%s ORDER BY %[3]s %[4]s, id %[4]s LIMIT $%d`,
+		approvedProjectColumns, cond.where(), filter.sortColumn(), direction, len(cond.Args)+1)
+
+	rows, err := r.db.QueryContext(ctx, query, append(cond.Args, filter.Limit)...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return scanApprovedProjects(rows)
+}
+
+// sortColumn returns the column the filter orders by.
+func (f ApprovedProjectFilter) sortColumn() string {
+	if f.SortBy == ApprovedProjectSortApprovalDate {
+		return "approval_date"
+	}
+
+	return "name"
+}
+
+// condition renders the filters and the page cursor as a Condition. The query matches names and
+// descriptions containing it, case-insensitively and without wildcards.
+func (f ApprovedProjectFilter) condition() Condition {
+	var (
+		clauses []string
+		args    []any
+	)
+
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if f.ActiveOnly {
+		clauses = append(clauses, "is_active = true")
+	}
+
+	if f.License != "" {
+		clauses = append(clauses, "license = "+arg(f.License))
+	}
+
+	if f.ContributionType != "" {
+		clauses = append(clauses, "contribution_type = "+arg(f.ContributionType))
+	}
+
+	if f.AllowedContributionType != "" {
+		clauses = append(clauses, arg(f.AllowedContributionType)+" = ANY(allowed_contribution_types)")
+	}
+
+	if f.Query != "" {
+		p := arg("%" + likeEscaper.Replace(f.Query) + "%")
+		clauses = append(clauses, fmt.Sprintf(`(name ILIKE %[1]s ESCAPE '\' OR description ILIKE %[1]s ESCAPE '\')`, p))
+	}
+
+	if f.After != nil {
+		sortColumn := f.sortColumn()
+
+		comparison := ">"
+		if f.Descending {
+			comparison = "<"
+		}
+
+		var key any = f.After.Name
+		if sortColumn == "approval_date" {
+			key = f.After.ApprovalDate
+		}
+
+		clauses = append(clauses, fmt.Sprintf("(%s, id) %s (%s, %s)", sortColumn, comparison, arg(key), arg(f.After.ID)))
+	}
+
+	return Condition{SQL: strings.Join(clauses, " AND "), Args: args}
+}
+
+// GetApprovedProjectsDueForReview returns active approved projects whose next review falls on or before
+// the given time and that have no open re-certification task yet.
+func (r *ApprovedProjectRepository) GetApprovedProjectsDueForReview(ctx context.Context, before time.Time) ([]*models.ApprovedProject, error) {
+	query := `
+		SELECT ` + approvedProjectColumns + `
+		FROM approved_projects ap
+		WHERE ap.is_active = true AND ap.next_review_date <= $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM recertification_tasks rt
+			WHERE rt.approved_project_id = ap.id AND rt.status = 'open'
+		  )
+		ORDER BY ap.next_review_date ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, before)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return scanApprovedProjects(rows)
+}
+
+// GetOverdueApprovedProjects returns active approved projects whose open re-certification task
+// was due before asOf. A project without an open task is never overdue, however old its review.
+func (r *ApprovedProjectRepository) GetOverdueApprovedProjects(ctx context.Context, asOf time.Time) ([]*models.ApprovedProject, error) {
+	query := `
+		SELECT ` + approvedProjectColumns + `
+		FROM approved_projects ap
+		WHERE ap.is_active = true
+		  AND EXISTS (
+			SELECT 1 FROM recertification_tasks rt
+			WHERE rt.approved_project_id = ap.id AND rt.status = 'open' AND rt.due_date < $1
+		  )
+		ORDER BY ap.next_review_date ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return scanApprovedProjects(rows)
+}
+
+// CreateRecertificationTask opens a re-certification task for an approved project.
+// It is a no-op if the project already has an open task.
+func (r *ApprovedProjectRepository) CreateRecertificationTask(ctx context.Context, approvedProjectID string, dueDate time.Time) error {
+	query := `
+		INSERT INTO recertification_tasks (approved_project_id, due_date)
+		VALUES ($1, $2)
+		ON CONFLICT (approved_project_id) WHERE status = 'open' DO NOTHING`
+
+	_, err := r.db.ExecContext(ctx, query, approvedProjectID, dueDate)
+
+	return err
+}
+
+// GetRecertificationTaskByID returns a re-certification task by its ID.
+func (r *ApprovedProjectRepository) GetRecertificationTaskByID(ctx context.Context, id string) (*models.RecertificationTask, error) {
+	query := `
+		SELECT rt.id, rt.approved_project_id, ap.name, rt.status, rt.due_date, rt.reviewer_id, rt.notes,
+			   rt.completed_at, rt.created_at, rt.updated_at
+		FROM recertification_tasks rt
+		INNER JOIN approved_projects ap ON rt.approved_project_id = ap.id
+		WHERE rt.id = $1`
+
+	task := &models.RecertificationTask{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&task.ID, &task.ApprovedProjectID, &task.ProjectName, &task.Status,
+		&task.DueDate, &task.ReviewerID, &task.Notes,
+		&task.CompletedAt, &task.CreatedAt, &task.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, notFound(ErrRecertificationTaskNotFound, id)
+	}
+
+	return task, err
+}
+
+// ListRecertificationTasks returns re-certification tasks ordered by due date, optionally filtered by status.
+func (r *ApprovedProjectRepository) ListRecertificationTasks(ctx context.Context, status string, limit, offset int) ([]*models.RecertificationTask, error) {
+	query := `
+		SELECT rt.id, rt.approved_project_id, ap.name, rt.status, rt.due_date, rt.reviewer_id, rt.notes,
+			   rt.completed_at, rt.created_at, rt.updated_at
+		FROM recertification_tasks rt
+		INNER JOIN approved_projects ap ON rt.approved_project_id = ap.id
+		WHERE ($1 = '' OR rt.status = $1)
+		ORDER BY rt.due_date ASC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, query, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var tasks []*models.RecertificationTask
+
+	for rows.Next() {
+		task := &models.RecertificationTask{}
+
+		err := rows.Scan(
+			&task.ID, &task.ApprovedProjectID, &task.ProjectName, &task.Status,
+			&task.DueDate, &task.ReviewerID, &task.Notes,
+			&task.CompletedAt, &task.CreatedAt, &task.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks, rows.Err()
+}
+
+// RecertifyApprovedProject closes an open or lapsed task as recertified and schedules the project's
+// next review one review interval from now. A project that had lapsed is reactivated, and the
+// catalog_lapsed flags raised on the contribution permissions that depend on it are resolved.
+func (r *ApprovedProjectRepository) RecertifyApprovedProject(ctx context.Context, taskID, reviewerID, notes string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	var approvedProjectID string
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE recertification_tasks
+		SET status = $2, reviewer_id = $3, notes = $4, completed_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status IN ('open', 'lapsed')
+		RETURNING approved_project_id`,
+		taskID, RecertificationStatusRecertified, reviewerID, notes,
+	).Scan(&approvedProjectID)
+	if err == sql.ErrNoRows {
+		return notFound(ErrRecertificationTaskNotFound, taskID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE approved_projects
+		SET is_active = true, last_reviewed_at = CURRENT_TIMESTAMP,
+			next_review_date = CURRENT_TIMESTAMP + (review_interval_days * INTERVAL '1 day')
+		WHERE id = $1`, approvedProjectID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE request_flags
+		SET resolved_at = CURRENT_TIMESTAMP
+		WHERE flag_type = $2 AND resolved_at IS NULL
+		  AND request_id IN (SELECT id FROM requests WHERE approved_project_id = $1)`,
+		approvedProjectID, FlagCatalogLapsed)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RetireApprovedProject closes an open task as retired, deactivates the project and flags
+// the active contribution permissions that depend on it. It returns the number of flagged requests.
+func (r *ApprovedProjectRepository) RetireApprovedProject(ctx context.Context, taskID, reviewerID, notes string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	var approvedProjectID string
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE recertification_tasks
+		SET status = $2, reviewer_id = $3, notes = $4, completed_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'open'
+		RETURNING approved_project_id`,
+		taskID, RecertificationStatusRetired, reviewerID, notes,
+	).Scan(&approvedProjectID)
+	if err == sql.ErrNoRows {
+		return 0, notFound(ErrRecertificationTaskNotFound, taskID)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	flagged, err := deactivateApprovedProject(ctx, tx, approvedProjectID, "approved project retired at re-certification")
+	if err != nil {
+		return 0, err
+	}
+
+	return flagged, tx.Commit()
+}
+
+// LapseApprovedProject deactivates an approved project whose review is overdue, closes its open
+// re-certification task as lapsed and flags the active contribution permissions that depend on it.
+// It returns the number of flagged requests.
+func (r *ApprovedProjectRepository) LapseApprovedProject(ctx context.Context, approvedProjectID string) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE recertification_tasks
+		SET status = $2, completed_at = CURRENT_TIMESTAMP
+		WHERE approved_project_id = $1 AND status = 'open'`,
+		approvedProjectID, RecertificationStatusLapsed)
+	if err != nil {
+		return 0, err
+	}
+
+	flagged, err := deactivateApprovedProject(ctx, tx, approvedProjectID, "approved project re-certification is overdue")
+	if err != nil {
+		return 0, err
+	}
+
+	return flagged, tx.Commit()
+}
+
+// deactivateApprovedProject marks the catalog entry inactive and raises a catalog_lapsed flag on
+// every approved contribution permission request that references it.
+func deactivateApprovedProject(ctx context.Context, tx *sql.Tx, approvedProjectID, reason string) (int64, error) {
+	_, err := tx.ExecContext(ctx, `UPDATE approved_projects SET is_active = false WHERE id = $1`, approvedProjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO request_flags (request_id, flag_type, reason)
+		SELECT id, $2, $3
+		FROM requests
+		WHERE approved_project_id = $1 AND type = 'contribution_permission' AND status = 'approved'
+		ON CONFLICT (request_id, flag_type) WHERE resolved_at IS NULL DO NOTHING`,
+		approvedProjectID, FlagCatalogLapsed, reason)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanApprovedProject(row rowScanner) (*models.ApprovedProject, error) {
+	project := &models.ApprovedProject{}
+
+	var description, maintainerContact sql.NullString
+
+	var allowedTypes pq.StringArray
+
+	err := row.Scan(
+		&project.ID, &project.Name, &description, &project.RepositoryURL,
+		&project.License, &project.ContributionType, &maintainerContact,
+		&project.ApprovalDate, &project.IsActive, &allowedTypes,
+		&project.ReviewIntervalDays, &project.NextReviewDate, &project.LastReviewedAt,
+		&project.CreatedAt, &project.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	project.Description = description.String
+	project.MaintainerContact = maintainerContact.String
+	project.AllowedContributionTypes = []string(allowedTypes)
+
+	return project, nil
+}
+
+func scanApprovedProjects(rows *sql.Rows) ([]*models.ApprovedProject, error) {
+	var projects []*models.ApprovedProject
+
+	for rows.Next() {
+		project, err := scanApprovedProject(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, project)
+	}
+
+	return projects, rows.Err()
+}
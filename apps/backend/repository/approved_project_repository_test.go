@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApprovedProjectFilter_QueryMatchesWildcardsLiterally(t *testing.T) {
+	cond := ApprovedProjectFilter{ActiveOnly: true, Query: `my_lib 100%\`}.condition()
+
+	assert.Equal(t, `is_active = true AND (name ILIKE $1 ESCAPE '\' OR description ILIKE $1 ESCAPE '\')`, cond.SQL)
+	assert.Equal(t, []any{`%my\_lib 100\%\\%`}, cond.Args)
+}
+
+func TestApprovedProjectFilter_Cursor(t *testing.T) {
+	cond := ApprovedProjectFilter{
+		License:    "MIT",
+		SortBy:     ApprovedProjectSortName,
+		Descending: true,
+		After:      &ApprovedProjectCursor{Name: "kubernetes", ID: "ap-1"},
+	}.condition()
+
+	assert.Equal(t, "license = $1 AND (name, id) < ($2, $3)", cond.SQL)
+	assert.Equal(t, []any{"MIT", "kubernetes", "ap-1"}, cond.Args)
+}
@@ -0,0 +1,17 @@
+package repository
+
+// Condition is a SQL boolean expression for a WHERE clause whose parameters are numbered from $1.
+// It must be built from fixed column names, as the SCIM filter translator does, never from input.
+type Condition struct {
+	SQL  string
+	Args []any
+}
+
+// where renders the condition as a WHERE clause, or nothing when it is empty.
+func (c Condition) where() string {
+	if c.SQL == "" {
+		return ""
+	}
+
+	return " WHERE " + c.SQL
+}
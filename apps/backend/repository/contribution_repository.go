@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"sourcestream/backend/models"
+)
+
+// ErrApprovedRequestNotFound is returned when a contribution names a request that does not exist or
+// is not approved.
+var ErrApprovedRequestNotFound error = &NotFoundError{Resource: "approved request"}
+
+// Contribution outcomes.
+const (
+	ContributionOutcomeOpen   = "open"
+	ContributionOutcomeMerged = "merged"
+	ContributionOutcomeClosed = "closed"
+)
+
+// Contribution kinds.
+const (
+	ContributionKindPullRequest = "pull_request"
+	ContributionKindCommit      = "commit"
+)
+
+// Contribution sources.
+const (
+	ContributionSourceRPC     = "rpc"
+	ContributionSourceImport  = "import"
+	ContributionSourceWebhook = "webhook"
+)
+
+const contributionColumns = `id, request_id, approved_project_id, user_id, kind, url, COALESCE(title, ''), outcome,
+		contributed_at, resolved_at, source, created_at, updated_at`
+
+// ContributionFilter holds the optional filters for ListContributions.
+type ContributionFilter struct {
+	UserID            string
+	ApprovedProjectID string
+	RequestID         string
+	Outcome           string
+	From              *time.Time
+	To                *time.Time
+	Limit             int
+	Offset            int
+}
+
+// ContributionRepository provides DB operations for the corporate contribution ledger.
+type ContributionRepository struct {
+	db *sql.DB
+}
+
+// NewContributionRepository creates a new ContributionRepository with the given DB handle.
+func NewContributionRepository(db *sql.DB) *ContributionRepository {
+	return &ContributionRepository{db: db}
+}
+
+// RecordContribution records an upstream contribution against its approving request.
+// The approved project and contributor are taken from the request, which must be an approved
+// contribution permission or pull request approval; a pull request approval stays eligible once
+// its pull request merged or closed. Recording the same URL again updates its
+// title and outcome so that re-imports converge on the latest state.
+func (r *ContributionRepository) RecordContribution(ctx context.Context, contribution *models.Contribution) error {
+	query := `
+		INSERT INTO contributions (request_id, approved_project_id, user_id, kind, url, title, outcome, contributed_at, resolved_at, source)
+		SELECT r.id, r.approved_project_id, r.requester_id, $2, $3, NULLIF($4, ''), $5, $6,
+			   CASE WHEN $5 = 'open' THEN NULL ELSE COALESCE($7, CURRENT_TIMESTAMP) END, $8
+		FROM requests r
+		WHERE r.id = $1 AND r.type IN ('contribution_permission', 'pullrequest')
+		  AND (r.status = 'approved' OR (r.type = 'pullrequest' AND r.status IN ('merged', 'closed') AND r.approved_at IS NOT NULL))
+		ON CONFLICT (url) DO UPDATE
+		SET title = COALESCE(EXCLUDED.title, contributions.title), outcome = EXCLUDED.outcome,
+			resolved_at = EXCLUDED.resolved_at
+		RETURNING id, approved_project_id, user_id, contributed_at, resolved_at, created_at, updated_at`
+
+	err := r.db.QueryRowContext(ctx, query, contribution.RequestID, contribution.Kind, contribution.URL,
+		contribution.Title, contribution.Outcome, contribution.ContributedAt,
+		contribution.ResolvedAt, contribution.Source,
+	).Scan(
+		&contribution.ID, &contribution.ApprovedProjectID, &contribution.UserID,
+		&contribution.ContributedAt, &contribution.ResolvedAt,
+		&contribution.CreatedAt, &contribution.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return notFound(ErrApprovedRequestNotFound, contribution.RequestID)
+	}
+
+	return err
+}
+
+// ListContributions returns ledger entries matching the filter, newest first.
+func (r *ContributionRepository) ListContributions(ctx context.Context, filter ContributionFilter) ([]*models.Contribution, error) {
+	query := `
+		SELECT ` + contributionColumns + `
+		FROM contributions
+		WHERE ($1 = '' OR user_id::text = $1)
+		  AND ($2 = '' OR approved_project_id::text = $2)
+		  AND ($3 = '' OR request_id::text = $3)
+		  AND ($4 = '' OR outcome = $4)
+		  AND ($5::timestamptz IS NULL OR contributed_at >= $5)
+		  AND ($6::timestamptz IS NULL OR contributed_at < $6)
+		ORDER BY contributed_at DESC
+		LIMIT $7 OFFSET $8`
+
+	rows, err := r.db.QueryContext(ctx, query, filter.UserID, filter.ApprovedProjectID, filter.RequestID,
+		filter.Outcome, filter.From, filter.To, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var contributions []*models.Contribution
+
+	for rows.Next() {
+		contribution := &models.Contribution{}
+
+		err := rows.Scan(
+			&contribution.ID, &contribution.RequestID, &contribution.ApprovedProjectID,
+			&contribution.UserID, &contribution.Kind, &contribution.URL, &contribution.Title,
+			&contribution.Outcome, &contribution.ContributedAt, &contribution.ResolvedAt,
+			&contribution.Source, &contribution.CreatedAt, &contribution.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		contributions = append(contributions, contribution)
+	}
+
+	return contributions, rows.Err()
+}
+
+// GetContributionSummary aggregates ledger entries contributed in [from, to) by approved project.
+func (r *ContributionRepository) GetContributionSummary(ctx context.Context, from, to time.Time) ([]*models.ContributionSummary, error) {
+	query := `
+		SELECT c.approved_project_id, COALESCE(ap.name, 'Unlisted') AS project_name,
+			   COUNT(*) AS total,
+			   COUNT(*) FILTER (WHERE c.outcome = 'merged') AS merged,
+			   COUNT(*) FILTER (WHERE c.outcome = 'closed') AS closed,
+			   COUNT(*) FILTER (WHERE c.outcome = 'open') AS open,
+			   COUNT(DISTINCT c.user_id) AS contributors
+		FROM contributions c
+		LEFT JOIN approved_projects ap ON c.approved_project_id = ap.id
+		WHERE c.contributed_at >= $1 AND c.contributed_at < $2
+		GROUP BY c.approved_project_id, ap.name
+		ORDER BY total DESC, project_name ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var summaries []*models.ContributionSummary
+
+	for rows.Next() {
+		summary := &models.ContributionSummary{}
+
+		err := rows.Scan(
+			&summary.ApprovedProjectID, &summary.ProjectName, &summary.Total,
+			&summary.Merged, &summary.Closed, &summary.Open, &summary.Contributors,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, rows.Err()
+}
+
+// GetTeamContributionSummary aggregates ledger entries contributed in [from, to) by the current
+// team of the contributor. A contribution by a member of several teams counts towards each of
+// them; contributions by users without a team are grouped under "No team".
+func (r *ContributionRepository) GetTeamContributionSummary(ctx context.Context, from, to time.Time) ([]*models.TeamContributionSummary, error) {
+	query := `
+		SELECT tm.team_id, COALESCE(t.name, 'No team') AS team_name,
+			   COUNT(*) AS total,
+			   COUNT(*) FILTER (WHERE c.outcome = 'merged') AS merged,
+			   COUNT(*) FILTER (WHERE c.outcome = 'closed') AS closed,
+			   COUNT(*) FILTER (WHERE c.outcome = 'open') AS open,
+			   COUNT(DISTINCT c.user_id) AS contributors
+		FROM contributions c
+		LEFT JOIN team_members tm ON tm.user_id = c.user_id
+		LEFT JOIN teams t ON tm.team_id = t.id
+		WHERE c.contributed_at >= $1 AND c.contributed_at < $2
+		GROUP BY tm.team_id, t.name
+		ORDER BY total DESC, team_name ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var summaries []*models.TeamContributionSummary
+
+	for rows.Next() {
+		summary := &models.TeamContributionSummary{}
+
+		err := rows.Scan(
+			&summary.TeamID, &summary.TeamName, &summary.Total,
+			&summary.Merged, &summary.Closed, &summary.Open, &summary.Contributors,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, rows.Err()
+}
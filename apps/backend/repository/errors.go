@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/lib/pq"
+)
+
+// Postgres error codes translated by TranslateError.
+const (
+	pqUniqueViolation     = "23505"
+	pqForeignKeyViolation = "23503"
+	pqNotNullViolation    = "23502"
+	pqCheckViolation      = "23514"
+	pqInvalidText         = "22P02"
+	pqValueTooLong        = "22001"
+)
+
+// NotFoundError is returned when no row matches a lookup. It matches, with errors.Is, the
+// sentinel of the same resource, such as ErrUserNotFound.
+type NotFoundError struct {
+	// Resource is the kind of row, such as "user".
+	Resource string
+	// ID identifies the row looked up, when known.
+	ID string
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Resource + " not found"
+}
+
+// Is reports whether target is a NotFoundError for the same resource and, if target names one,
+// the same ID.
+func (e *NotFoundError) Is(target error) bool {
+	t, ok := target.(*NotFoundError)
+	return ok && t.Resource == e.Resource && (t.ID == "" || t.ID == e.ID)
+}
+
+// notFound returns a NotFoundError for the resource of sentinel that names the row looked up by id.
+func notFound(sentinel error, id string) error {
+	var nf *NotFoundError
+	if !errors.As(sentinel, &nf) {
+		return sentinel
+	}
+
+	return &NotFoundError{Resource: nf.Resource, ID: id}
+}
+
+// ConflictError is returned when a write would duplicate a value that must be unique.
+type ConflictError struct {
+	// Resource is the kind of row, such as "team".
+	Resource string
+	// Field is the unique field, such as "name".
+	Field string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("a %s with this %s already exists", e.Resource, e.Field)
+}
+
+// ReferenceError is returned when a write refers to a row that does not exist, or a delete would
+// leave rows referring to the one deleted.
+type ReferenceError struct {
+	// Field is the referring column, when known.
+	Field string
+	// Resource is the table on the other side of the reference.
+	Resource string
+	// StillReferenced is true when the write was a delete of a row still referred to.
+	StillReferenced bool
+}
+
+func (e *ReferenceError) Error() string {
+	if e.StillReferenced {
+		return fmt.Sprintf("still referenced from %s", e.Resource)
+	}
+
+	return fmt.Sprintf("%s refers to a %s row that does not exist", e.Field, e.Resource)
+}
+
+// InvalidError is returned when the database rejects a value as malformed, too long or against
+// a check constraint.
+type InvalidError struct {
+	// Field is the column or constraint at fault, when known.
+	Field string
+	// Reason is the database's description.
+	Reason string
+}
+
+func (e *InvalidError) Error() string {
+	if e.Field == "" {
+		return "invalid value: " + e.Reason
+	}
+
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+// Postgres reports the columns and tables involved in a violation in its detail message.
+var (
+	detailKey          = regexp.MustCompile(`^Key \(([^)]+)\)=`)
+	detailMissingTable = regexp.MustCompile(`is not present in table "([^"]+)"`)
+	detailReferencedBy = regexp.MustCompile(`is still referenced from table "([^"]+)"`)
+)
+
+// TranslateError converts a Postgres constraint or data error into a ConflictError,
+// ReferenceError or InvalidError. Other errors, including ones already translated, are returned
+// unchanged.
+func TranslateError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code {
+	case pqUniqueViolation:
+		return &ConflictError{Resource: singular(pqErr.Table), Field: detailField(pqErr, pqErr.Constraint)}
+	case pqForeignKeyViolation:
+		if m := detailReferencedBy.FindStringSubmatch(pqErr.Detail); m != nil {
+			return &ReferenceError{Resource: m[1], StillReferenced: true}
+		}
+
+		ref := &ReferenceError{Field: detailField(pqErr, pqErr.Constraint)}
+		if m := detailMissingTable.FindStringSubmatch(pqErr.Detail); m != nil {
+			ref.Resource = m[1]
+		}
+
+		return ref
+	case pqNotNullViolation:
+		return &InvalidError{Field: pqErr.Column, Reason: "is required"}
+	case pqCheckViolation:
+		return &InvalidError{Field: pqErr.Constraint, Reason: "is not an allowed value"}
+	case pqInvalidText, pqValueTooLong:
+		return &InvalidError{Field: pqErr.Column, Reason: pqErr.Message}
+	}
+
+	return err
+}
+
+// detailField returns the columns named in a violation's detail, or fallback.
+func detailField(pqErr *pq.Error, fallback string) string {
+	if m := detailKey.FindStringSubmatch(pqErr.Detail); m != nil {
+		return m[1]
+	}
+
+	return fallback
+}
+
+// singular turns a table name such as "teams" into the resource it holds.
+func singular(table string) string {
+	if n := len(table); n > 1 && table[n-1] == 's' {
+		return table[:n-1]
+	}
+
+	return table
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"sourcestream/backend/models"
+)
+
+// ErrGithubVerificationNotFound is returned when no GitHub verification matches a lookup.
+var ErrGithubVerificationNotFound error = &NotFoundError{Resource: "GitHub verification"}
+
+// ErrGithubUsernameChanged is returned when a verification completes for a GitHub username the
+// user no longer has.
+var ErrGithubUsernameChanged = errors.New("GitHub username changed during verification")
+
+// GitHub verification statuses.
+const (
+	GithubVerificationPending  = "pending"
+	GithubVerificationVerified = "verified"
+	GithubVerificationFailed   = "failed"
+	GithubVerificationExpired  = "expired"
+)
+
+const githubVerificationColumns = `id, user_id, github_username, COALESCE(device_code, ''), user_code, verification_uri,
+		interval_seconds, expires_at, status, COALESCE(failure_reason, ''), created_at, completed_at`
+
+// GithubVerificationRepository provides DB operations for GitHub account verifications.
+type GithubVerificationRepository struct {
+	db *sql.DB
+}
+
+// NewGithubVerificationRepository creates a new GithubVerificationRepository with the given DB handle.
+func NewGithubVerificationRepository(db *sql.DB) *GithubVerificationRepository {
+	return &GithubVerificationRepository{db: db}
+}
+
+// CreateGithubVerification inserts a pending verification.
+func (r *GithubVerificationRepository) CreateGithubVerification(ctx context.Context, v *models.GithubVerification) error {
+	query := `
+		INSERT INTO github_verifications (user_id, github_username, device_code, user_code, verification_uri,
+			interval_seconds, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, status, created_at`
+
+	return r.db.QueryRowContext(ctx, query, v.UserID, v.GithubUsername, v.DeviceCode, v.UserCode, v.VerificationURI,
+		v.IntervalSeconds, v.ExpiresAt).Scan(&v.ID, &v.Status, &v.CreatedAt)
+}
+
+// GetGithubVerification returns one of a user's verifications.
+func (r *GithubVerificationRepository) GetGithubVerification(ctx context.Context, userID, id string) (*models.GithubVerification, error) {
+	query := `SELECT ` + githubVerificationColumns + ` FROM github_verifications WHERE id = $1 AND user_id = $2`
+
+	v, err := scanGithubVerification(r.db.QueryRowContext(ctx, query, id, userID))
+	if err == sql.ErrNoRows {
+		return nil, notFound(ErrGithubVerificationNotFound, id)
+	}
+
+	return v, err
+}
+
+// FailGithubVerification ends a pending verification as failed or expired, with a reason. Like
+// VerifyGithubUsername, it clears the device code, which is only needed while the flow is pending.
+func (r *GithubVerificationRepository) FailGithubVerification(ctx context.Context, v *models.GithubVerification, status, reason string) error {
+	query := `
+		UPDATE github_verifications
+		SET status = $2, failure_reason = NULLIF($3, ''), device_code = NULL, completed_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'pending'
+		RETURNING ` + githubVerificationColumns
+
+	updated, err := scanGithubVerification(r.db.QueryRowContext(ctx, query, v.ID, status, reason))
+	if err == sql.ErrNoRows {
+		return notFound(ErrGithubVerificationNotFound, v.ID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*v = *updated
+
+	return nil
+}
+
+// VerifyGithubUsername ends a pending verification as verified and marks the user's GitHub
+// username verified. It returns ErrGithubUsernameChanged, and changes nothing, when the user's
+// username is no longer the one verified.
+func (r *GithubVerificationRepository) VerifyGithubUsername(ctx context.Context, v *models.GithubVerification) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE users SET github_verified_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND LOWER(github_username) = LOWER($2)`,
+		v.UserID, v.GithubUsername)
+	if err != nil {
+		return err
+	}
+
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrGithubUsernameChanged
+	}
+
+	updated, err := scanGithubVerification(tx.QueryRowContext(ctx, `
+		UPDATE github_verifications
+		SET status = 'verified', device_code = NULL, completed_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'pending'
+		RETURNING `+githubVerificationColumns,
+		v.ID))
+	if err == sql.ErrNoRows {
+		return notFound(ErrGithubVerificationNotFound, v.ID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	*v = *updated
+
+	return nil
+}
+
+func scanGithubVerification(row rowScanner) (*models.GithubVerification, error) {
+	v := &models.GithubVerification{}
+
+	err := row.Scan(
+		&v.ID, &v.UserID, &v.GithubUsername, &v.DeviceCode, &v.UserCode, &v.VerificationURI,
+		&v.IntervalSeconds, &v.ExpiresAt, &v.Status, &v.FailureReason, &v.CreatedAt, &v.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
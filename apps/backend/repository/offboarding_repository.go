@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"sourcestream/backend/models"
+)
+
+// ErrOffboardingNotFound is returned when a user has not been offboarded.
+var ErrOffboardingNotFound error = &NotFoundError{Resource: "offboarding"}
+
+// Offboarding sources.
+const (
+	OffboardingSourceAdmin = "admin"
+	OffboardingSourceSCIM  = "scim"
+)
+
+// OffboardingRepository provides DB operations for offboarding departed users.
+type OffboardingRepository struct {
+	db *sql.DB
+}
+
+// NewOffboardingRepository creates a new OffboardingRepository with the given DB handle.
+func NewOffboardingRepository(db *sql.DB) *OffboardingRepository {
+	return &OffboardingRepository{db: db}
+}
+
+// Offboard deactivates a user and, in the same transaction, transfers the projects they own to
+// the successor, revokes their project memberships, cancels their pending requests and hands the
+// pending requests they were reviewing to the successor. Without a successor owned projects are
+// left in place and reported as untransferred, and reviews go back to the review queue. The
+// report of what changed is stored and set on offboarding.
+func (r *OffboardingRepository) Offboard(ctx context.Context, offboarding *models.Offboarding) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	report := &offboarding.Report
+
+	if _, err := tx.ExecContext(ctx, `UPDATE users SET is_active = false WHERE id = $1`, offboarding.UserID); err != nil {
+		return err
+	}
+
+	if offboarding.SuccessorID != nil {
+		report.TransferredProjects, err = queryOffboardingItems(ctx, tx, `
+			UPDATE projects SET owner_id = $2
+			WHERE owner_id = $1
+			RETURNING id, name, ''`,
+			offboarding.UserID, *offboarding.SuccessorID)
+		if err != nil {
+			return err
+		}
+
+		for _, project := range report.TransferredProjects {
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO project_contributors (project_id, user_id, role)
+				VALUES ($1, $2, 'owner')
+				ON CONFLICT (project_id, user_id)
+				DO UPDATE SET role = 'owner'`,
+				project.ID, *offboarding.SuccessorID)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		report.UntransferredProjects, err = queryOffboardingItems(ctx, tx, `
+			SELECT id, name, '' FROM projects WHERE owner_id = $1 ORDER BY name`,
+			offboarding.UserID)
+		if err != nil {
+			return err
+		}
+	}
+
+	report.RevokedAccess, err = queryOffboardingItems(ctx, tx, `
+		DELETE FROM project_contributors pc
+		USING projects p
+		WHERE pc.project_id = p.id AND pc.user_id = $1
+		RETURNING p.id, p.name, COALESCE(pc.role, '')`,
+		offboarding.UserID)
+	if err != nil {
+		return err
+	}
+
+	report.CancelledRequests, err = queryOffboardingItems(ctx, tx, `
+		UPDATE requests SET status = 'cancelled', decision_reason = 'requester was offboarded'
+		WHERE requester_id = $1 AND status IN ('pending', 'in_review')
+		RETURNING id, title, type`,
+		offboarding.UserID)
+	if err != nil {
+		return err
+	}
+
+	report.ReassignedReviews, err = queryOffboardingItems(ctx, tx, `
+		UPDATE requests SET reviewer_id = $2
+		WHERE reviewer_id = $1 AND status IN ('pending', 'in_review')
+		RETURNING id, title, type`,
+		offboarding.UserID, offboarding.SuccessorID)
+	if err != nil {
+		return err
+	}
+
+	reportJSON, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO offboardings (user_id, successor_id, initiated_by, source, reason, report)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6)
+		RETURNING id, created_at`,
+		offboarding.UserID, offboarding.SuccessorID, offboarding.InitiatedBy,
+		offboarding.Source, offboarding.Reason, reportJSON,
+	).Scan(&offboarding.ID, &offboarding.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetLatestOffboarding returns the most recent offboarding of a user.
+func (r *OffboardingRepository) GetLatestOffboarding(ctx context.Context, userID string) (*models.Offboarding, error) {
+	query := `
+		SELECT id, user_id, successor_id, initiated_by, source, COALESCE(reason, ''), report, created_at
+		FROM offboardings
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT 1`
+
+	offboarding := &models.Offboarding{}
+
+	var report []byte
+
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&offboarding.ID, &offboarding.UserID, &offboarding.SuccessorID, &offboarding.InitiatedBy,
+		&offboarding.Source, &offboarding.Reason, &report, &offboarding.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, notFound(ErrOffboardingNotFound, userID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(report, &offboarding.Report); err != nil {
+		return nil, err
+	}
+
+	return offboarding, nil
+}
+
+func queryOffboardingItems(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]models.OffboardingItem, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	items := []models.OffboardingItem{}
+
+	for rows.Next() {
+		var item models.OffboardingItem
+		if err := rows.Scan(&item.ID, &item.Name, &item.Detail); err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
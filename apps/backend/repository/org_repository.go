@@ -0,0 +1,433 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"sourcestream/backend/models"
+
+	"github.com/lib/pq"
+)
+
+// ErrDepartmentNotFound is returned when no department matches a lookup.
+var ErrDepartmentNotFound error = &NotFoundError{Resource: "department"}
+
+// ErrTeamNotFound is returned when no team matches a lookup.
+var ErrTeamNotFound error = &NotFoundError{Resource: "team"}
+
+// ErrDuplicateTeam is returned when a team name is already taken.
+var ErrDuplicateTeam error = &ConflictError{Resource: "team", Field: "name"}
+
+// ErrManagerCycle is returned when setting a manager would make a user their own manager,
+// directly or through the management chain.
+var ErrManagerCycle = errors.New("manager would create a reporting cycle")
+
+// maxManagementDepth bounds the walk up a management chain.
+const maxManagementDepth = 50
+
+const teamColumns = `t.id, t.name, t.department_id, COALESCE(d.name, ''), t.created_at, t.updated_at`
+
+// OrgRepository provides DB operations for departments, teams and managers.
+type OrgRepository struct {
+	db *sql.DB
+}
+
+// NewOrgRepository creates a new OrgRepository with the given DB handle.
+func NewOrgRepository(db *sql.DB) *OrgRepository {
+	return &OrgRepository{db: db}
+}
+
+// GetDepartmentByName returns a department by name.
+func (r *OrgRepository) GetDepartmentByName(ctx context.Context, name string) (*models.Department, error) {
+	query := `
+		SELECT d.id, d.name, d.lead_id, d.ospo_champion_id,
+			   (SELECT COUNT(*) FROM users u WHERE u.department = d.name AND u.is_active),
+			   d.created_at, d.updated_at
+		FROM departments d
+		WHERE d.name = $1`
+
+	department, err := scanDepartment(r.db.QueryRowContext(ctx, query, name))
+	if err == sql.ErrNoRows {
+		return nil, notFound(ErrDepartmentNotFound, name)
+	}
+
+	return department, err
+}
+
+// ListDepartments returns every department by name, with its number of active members.
+func (r *OrgRepository) ListDepartments(ctx context.Context) ([]*models.Department, error) {
+	query := `
+		SELECT d.id, d.name, d.lead_id, d.ospo_champion_id,
+			   (SELECT COUNT(*) FROM users u WHERE u.department = d.name AND u.is_active),
+			   d.created_at, d.updated_at
+		FROM departments d
+		ORDER BY d.name`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var departments []*models.Department
+
+	for rows.Next() {
+		department, err := scanDepartment(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		departments = append(departments, department)
+	}
+
+	return departments, rows.Err()
+}
+
+// GetDepartmentLead returns the ID of a department's lead, or an empty string when it has none.
+func (r *OrgRepository) GetDepartmentLead(ctx context.Context, department string) (string, error) {
+	var userID sql.NullString
+
+	err := r.db.QueryRowContext(ctx, `SELECT lead_id FROM departments WHERE name = $1`, department).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	return userID.String, err
+}
+
+// SetDepartmentLead sets a department's lead, creating the department if needed.
+func (r *OrgRepository) SetDepartmentLead(ctx context.Context, department, userID string) error {
+	query := `
+		INSERT INTO departments (name, lead_id)
+		VALUES ($1, $2)
+		ON CONFLICT (name)
+		DO UPDATE SET lead_id = $2`
+
+	_, err := r.db.ExecContext(ctx, query, department, userID)
+
+	return err
+}
+
+// SetDepartmentChampion sets or, with a nil userID, clears a department's OSPO champion,
+// creating the department if needed.
+func (r *OrgRepository) SetDepartmentChampion(ctx context.Context, department string, userID *string) error {
+	query := `
+		INSERT INTO departments (name, ospo_champion_id)
+		VALUES ($1, $2)
+		ON CONFLICT (name)
+		DO UPDATE SET ospo_champion_id = $2`
+
+	_, err := r.db.ExecContext(ctx, query, department, userID)
+
+	return err
+}
+
+// CreateTeam inserts a team in the named department, which is created if needed. An empty
+// department name creates a team outside any department.
+func (r *OrgRepository) CreateTeam(ctx context.Context, team *models.Team) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	if team.DepartmentName != "" {
+		departmentID, err := ensureDepartment(ctx, tx, team.DepartmentName)
+		if err != nil {
+			return err
+		}
+
+		team.DepartmentID = &departmentID
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO teams (name, department_id)
+		VALUES ($1, $2)
+		RETURNING id, created_at, updated_at`,
+		team.Name, team.DepartmentID,
+	).Scan(&team.ID, &team.CreatedAt, &team.UpdatedAt)
+	if err != nil {
+		return teamError(err)
+	}
+
+	return tx.Commit()
+}
+
+// GetTeam returns a team by ID with its members.
+func (r *OrgRepository) GetTeam(ctx context.Context, id string) (*models.Team, error) {
+	query := `
+		SELECT ` + teamColumns + `
+		FROM teams t
+		LEFT JOIN departments d ON t.department_id = d.id
+		WHERE t.id = $1`
+
+	team, err := scanTeam(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, notFound(ErrTeamNotFound, id)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT user_id FROM team_members WHERE team_id = $1 ORDER BY created_at, user_id`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	team.MemberIDs = []string{}
+
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+
+		team.MemberIDs = append(team.MemberIDs, userID)
+	}
+
+	return team, rows.Err()
+}
+
+// ListTeams returns the teams by name, optionally only those in the named department. Members
+// are not loaded.
+func (r *OrgRepository) ListTeams(ctx context.Context, department string) ([]*models.Team, error) {
+	query := `
+		SELECT ` + teamColumns + `
+		FROM teams t
+		LEFT JOIN departments d ON t.department_id = d.id
+		WHERE ($1 = '' OR d.name = $1)
+		ORDER BY t.name`
+
+	return r.queryTeams(ctx, query, department)
+}
+
+// GetUserTeams returns the teams a user belongs to, by name. Members are not loaded.
+func (r *OrgRepository) GetUserTeams(ctx context.Context, userID string) ([]*models.Team, error) {
+	query := `
+		SELECT ` + teamColumns + `
+		FROM teams t
+		INNER JOIN team_members tm ON tm.team_id = t.id
+		LEFT JOIN departments d ON t.department_id = d.id
+		WHERE tm.user_id = $1
+		ORDER BY t.name`
+
+	return r.queryTeams(ctx, query, userID)
+}
+
+// AddTeamMember adds a user to a team. Adding an existing member does nothing.
+func (r *OrgRepository) AddTeamMember(ctx context.Context, teamID, userID string) error {
+	query := `
+		INSERT INTO team_members (team_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (team_id, user_id) DO NOTHING`
+
+	_, err := r.db.ExecContext(ctx, query, teamID, userID)
+
+	return teamError(err)
+}
+
+// RemoveTeamMember removes a user from a team. Removing a non-member does nothing.
+func (r *OrgRepository) RemoveTeamMember(ctx context.Context, teamID, userID string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM team_members WHERE team_id = $1 AND user_id = $2`, teamID, userID)
+	return err
+}
+
+// SetManager sets or, with a nil managerID, clears a user's manager.
+func (r *OrgRepository) SetManager(ctx context.Context, userID string, managerID *string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	if err := setManager(ctx, tx, userID, managerID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// PlaceUser applies an org chart entry's department and team to a user: the department is set
+// and the team becomes the user's only team. Departments and teams are created as needed; a new
+// team is created in the entry's department. Empty fields are left unchanged.
+func (r *OrgRepository) PlaceUser(ctx context.Context, userID string, entry *models.OrgChartEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	var departmentID *string
+
+	if entry.Department != "" {
+		id, err := ensureDepartment(ctx, tx, entry.Department)
+		if err != nil {
+			return err
+		}
+
+		departmentID = &id
+
+		if _, err := tx.ExecContext(ctx, `UPDATE users SET department = $2 WHERE id = $1`, userID, entry.Department); err != nil {
+			return err
+		}
+	}
+
+	if entry.Team != "" {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO teams (name, department_id)
+			VALUES ($1, $2)
+			ON CONFLICT (name) DO NOTHING`,
+			entry.Team, departmentID)
+		if err != nil {
+			return err
+		}
+
+		var teamID string
+		if err := tx.QueryRowContext(ctx, `SELECT id FROM teams WHERE name = $1`, entry.Team).Scan(&teamID); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM team_members WHERE user_id = $1 AND team_id <> $2`, userID, teamID); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO team_members (team_id, user_id)
+			VALUES ($1, $2)
+			ON CONFLICT (team_id, user_id) DO NOTHING`,
+			teamID, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *OrgRepository) queryTeams(ctx context.Context, query string, args ...any) ([]*models.Team, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var teams []*models.Team
+
+	for rows.Next() {
+		team, err := scanTeam(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		teams = append(teams, team)
+	}
+
+	return teams, rows.Err()
+}
+
+// ensureDepartment returns the ID of the named department, creating it if needed.
+func ensureDepartment(ctx context.Context, tx *sql.Tx, name string) (string, error) {
+	if _, err := tx.ExecContext(ctx, `INSERT INTO departments (name) VALUES ($1) ON CONFLICT (name) DO NOTHING`, name); err != nil {
+		return "", err
+	}
+
+	var id string
+	err := tx.QueryRowContext(ctx, `SELECT id FROM departments WHERE name = $1`, name).Scan(&id)
+
+	return id, err
+}
+
+// setManager sets a user's manager after checking that the user is not already in the manager's
+// management chain.
+func setManager(ctx context.Context, tx *sql.Tx, userID string, managerID *string) error {
+	if managerID != nil {
+		var cycle bool
+
+		err := tx.QueryRowContext(ctx, `
+			WITH RECURSIVE chain (id, depth) AS (
+				SELECT $2::uuid, 0
+				UNION ALL
+				SELECT u.manager_id, c.depth + 1
+				FROM users u
+				INNER JOIN chain c ON u.id = c.id
+				WHERE u.manager_id IS NOT NULL AND c.depth < $3
+			)
+			SELECT EXISTS (SELECT 1 FROM chain WHERE id = $1::uuid)`,
+			userID, *managerID, maxManagementDepth,
+		).Scan(&cycle)
+		if err != nil {
+			return err
+		}
+
+		if cycle {
+			return ErrManagerCycle
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `UPDATE users SET manager_id = $2 WHERE id = $1`, userID, managerID)
+	if err != nil {
+		return teamError(err)
+	}
+
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return notFound(ErrUserNotFound, userID)
+	}
+
+	return nil
+}
+
+// teamError converts constraint violations on teams, team members and managers into the
+// matching repository errors.
+func teamError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch {
+	case pqErr.Code == "23505" && pqErr.Constraint == "teams_name_key":
+		return ErrDuplicateTeam
+	case pqErr.Code == "23503" && pqErr.Constraint == "team_members_team_id_fkey":
+		return ErrTeamNotFound
+	case pqErr.Code == "23503":
+		return ErrUserNotFound
+	}
+
+	return TranslateError(err)
+}
+
+func scanDepartment(row rowScanner) (*models.Department, error) {
+	department := &models.Department{}
+
+	err := row.Scan(
+		&department.ID, &department.Name, &department.LeadID, &department.OSPOChampionID,
+		&department.Members, &department.CreatedAt, &department.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return department, nil
+}
+
+func scanTeam(row rowScanner) (*models.Team, error) {
+	team := &models.Team{}
+
+	err := row.Scan(
+		&team.ID, &team.Name, &team.DepartmentID, &team.DepartmentName, &team.CreatedAt, &team.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
@@ -2,8 +2,8 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
-	"fmt"
 
 	"sourcestream/backend/models"
 
@@ -11,6 +11,9 @@ import (
 	"github.com/lib/pq"
 )
 
+// ErrProjectNotFound is returned when no project matches a lookup.
+var ErrProjectNotFound error = &NotFoundError{Resource: "project"}
+
 // ProjectRepository provides DB operations for projects.
 type ProjectRepository struct {
 	db *sql.DB
@@ -22,7 +25,7 @@ func NewProjectRepository(db *sql.DB) *ProjectRepository {
 }
 
 // CreateProject inserts a new project into the database.
-func (r *ProjectRepository) CreateProject(project *models.Project) error {
+func (r *ProjectRepository) CreateProject(ctx context.Context, project *models.Project) error {
 	query := `
 		INSERT INTO projects (id, name, description, url, license, owner_id, language, stars, forks, is_public)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
@@ -31,7 +34,7 @@ func (r *ProjectRepository) CreateProject(project *models.Project) error {
 		project.ID = uuid.New().String()
 	}
 
-	_, err := r.db.Exec(query, project.ID, project.Name, project.Description,
+	_, err := r.db.ExecContext(ctx, query, project.ID, project.Name, project.Description,
 		project.URL, project.License, project.OwnerID, project.Language,
 		project.Stars, project.Forks, project.IsPublic)
 
@@ -39,13 +42,13 @@ func (r *ProjectRepository) CreateProject(project *models.Project) error {
 }
 
 // GetProjectByID returns a project by its ID.
-func (r *ProjectRepository) GetProjectByID(id string) (*models.Project, error) {
+func (r *ProjectRepository) GetProjectByID(ctx context.Context, id string) (*models.Project, error) {
 	query := `
 		SELECT id, name, description, url, license, status, owner_id, language, stars, forks, is_public, created_at, updated_at
 		FROM projects WHERE id = $1`
 
 	project := &models.Project{}
-	err := r.db.QueryRow(query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&project.ID, &project.Name, &project.Description, &project.URL,
 		&project.License, &project.Status, &project.OwnerID, &project.Language,
 		&project.Stars, &project.Forks, &project.IsPublic,
@@ -53,14 +56,14 @@ func (r *ProjectRepository) GetProjectByID(id string) (*models.Project, error) {
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("project not found")
+		return nil, notFound(ErrProjectNotFound, id)
 	}
 
 	return project, err
 }
 
 // GetProjectsByOwnerID returns projects owned by a specific user.
-func (r *ProjectRepository) GetProjectsByOwnerID(ownerID string, limit, offset int) ([]*models.Project, error) {
+func (r *ProjectRepository) GetProjectsByOwnerID(ctx context.Context, ownerID string, limit, offset int) ([]*models.Project, error) {
 	query := `
 		SELECT id, name, description, url, license, status, owner_id, language, stars, forks, is_public, created_at, updated_at
 		FROM projects 
@@ -68,7 +71,7 @@ func (r *ProjectRepository) GetProjectsByOwnerID(ownerID string, limit, offset i
 		ORDER BY created_at DESC
 		LIMIT $2 OFFSET $3`
 
-	rows, err := r.db.Query(query, ownerID, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, ownerID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +82,7 @@ func (r *ProjectRepository) GetProjectsByOwnerID(ownerID string, limit, offset i
 }
 
 // GetProjectsByContributorID returns projects a user contributes to (non-owner).
-func (r *ProjectRepository) GetProjectsByContributorID(userID string, limit, offset int) ([]*models.Project, error) {
+func (r *ProjectRepository) GetProjectsByContributorID(ctx context.Context, userID string, limit, offset int) ([]*models.Project, error) {
 	query := `
 		SELECT p.id, p.name, p.description, p.url, p.license, p.status, p.owner_id, p.language, p.stars, p.forks, p.is_public, p.created_at, p.updated_at
 		FROM projects p
@@ -88,7 +91,7 @@ func (r *ProjectRepository) GetProjectsByContributorID(userID string, limit, off
 		ORDER BY pc.joined_at DESC
 		LIMIT $2 OFFSET $3`
 
-	rows, err := r.db.Query(query, userID, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +102,7 @@ func (r *ProjectRepository) GetProjectsByContributorID(userID string, limit, off
 }
 
 // GetApprovedProjectsByUserID returns approved projects for the given user.
-func (r *ProjectRepository) GetApprovedProjectsByUserID(userID string, limit, offset int) ([]*models.Project, error) {
+func (r *ProjectRepository) GetApprovedProjectsByUserID(ctx context.Context, userID string, limit, offset int) ([]*models.Project, error) {
 	query := `
 		SELECT DISTINCT p.id, p.name, p.description, p.url, p.license, p.status, p.owner_id, p.language, p.stars, p.forks, p.is_public, p.created_at, p.updated_at
 		FROM projects p
@@ -108,7 +111,7 @@ func (r *ProjectRepository) GetApprovedProjectsByUserID(userID string, limit, of
 		ORDER BY p.created_at DESC
 		LIMIT $2 OFFSET $3`
 
-	rows, err := r.db.Query(query, userID, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -117,14 +120,74 @@ func (r *ProjectRepository) GetApprovedProjectsByUserID(userID string, limit, of
 	return r.scanProjects(rows)
 }
 
+// GetProjectByName returns a project by its name.
+func (r *ProjectRepository) GetProjectByName(ctx context.Context, name string) (*models.Project, error) {
+	query := `
+		SELECT id, name, description, url, license, status, owner_id, language, stars, forks, is_public, created_at, updated_at
+		FROM projects WHERE name = $1
+		ORDER BY created_at ASC
+		LIMIT 1`
+
+	rows, err := r.db.QueryContext(ctx, query, name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	projects, err := r.scanProjects(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(projects) == 0 {
+		return nil, notFound(ErrProjectNotFound, name)
+	}
+
+	return projects[0], nil
+}
+
+// GetProjectsByURL returns projects whose repository URL matches, ignoring case and trailing slashes.
+func (r *ProjectRepository) GetProjectsByURL(ctx context.Context, url string) ([]*models.Project, error) {
+	query := `
+		SELECT id, name, description, url, license, status, owner_id, language, stars, forks, is_public, created_at, updated_at
+		FROM projects
+		WHERE LOWER(RTRIM(url, '/')) = LOWER(RTRIM($1, '/'))`
+
+	rows, err := r.db.QueryContext(ctx, query, url)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return r.scanProjects(rows)
+}
+
+// UpdateProjectStatus sets the status of a project.
+func (r *ProjectRepository) UpdateProjectStatus(ctx context.Context, id, status string) error {
+	query := `UPDATE projects SET status = $2 WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id, status)
+
+	return err
+}
+
+// TouchProject records activity on a project by bumping its updated_at timestamp.
+func (r *ProjectRepository) TouchProject(ctx context.Context, id string) error {
+	query := `UPDATE projects SET updated_at = CURRENT_TIMESTAMP WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+
+	return err
+}
+
 // UpdateProject updates an existing project's fields.
-func (r *ProjectRepository) UpdateProject(project *models.Project) error {
+func (r *ProjectRepository) UpdateProject(ctx context.Context, project *models.Project) error {
 	query := `
 		UPDATE projects 
 		SET name = $2, description = $3, url = $4, license = $5, status = $6, language = $7, stars = $8, forks = $9, is_public = $10
 		WHERE id = $1`
 
-	_, err := r.db.Exec(query, project.ID, project.Name, project.Description,
+	_, err := r.db.ExecContext(ctx, query, project.ID, project.Name, project.Description,
 		project.URL, project.License, project.Status, project.Language,
 		project.Stars, project.Forks, project.IsPublic)
 
@@ -132,45 +195,65 @@ func (r *ProjectRepository) UpdateProject(project *models.Project) error {
 }
 
 // DeleteProject deletes a project by its ID.
-func (r *ProjectRepository) DeleteProject(id string) error {
+func (r *ProjectRepository) DeleteProject(ctx context.Context, id string) error {
 	query := `DELETE FROM projects WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 
 	return err
 }
 
 // AddContributor adds or updates a contributor for a project.
-func (r *ProjectRepository) AddContributor(projectID, userID, role string, permissions []string) error {
+func (r *ProjectRepository) AddContributor(ctx context.Context, projectID, userID, role string, permissions []string) error {
 	query := `
 		INSERT INTO project_contributors (project_id, user_id, role, permissions)
 		VALUES ($1, $2, $3, $4)
 		ON CONFLICT (project_id, user_id) 
 		DO UPDATE SET role = $3, permissions = $4`
 
-	_, err := r.db.Exec(query, projectID, userID, role, pq.Array(permissions))
+	_, err := r.db.ExecContext(ctx, query, projectID, userID, role, pq.Array(permissions))
 
 	return err
 }
 
 // RemoveContributor removes a contributor from a project.
-func (r *ProjectRepository) RemoveContributor(projectID, userID string) error {
+func (r *ProjectRepository) RemoveContributor(ctx context.Context, projectID, userID string) error {
 	query := `DELETE FROM project_contributors WHERE project_id = $1 AND user_id = $2`
-	_, err := r.db.Exec(query, projectID, userID)
+	_, err := r.db.ExecContext(ctx, query, projectID, userID)
 
 	return err
 }
 
+// GetContributorRole returns a user's role on a project: owner for the project's owner, otherwise
+// their project_contributors role. It returns an empty role when the user has none or the
+// project does not exist.
+func (r *ProjectRepository) GetContributorRole(ctx context.Context, projectID, userID string) (string, error) {
+	query := `
+		SELECT CASE WHEN p.owner_id::text = $2 THEN 'owner' ELSE COALESCE(pc.role, '') END
+		FROM projects p
+		LEFT JOIN project_contributors pc ON pc.project_id = p.id AND pc.user_id::text = $2
+		WHERE p.id::text = $1`
+
+	var role string
+
+	err := r.db.QueryRowContext(ctx, query, projectID, userID).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	return role, err
+}
+
 // GetProjectContributors lists contributors for a given project.
-func (r *ProjectRepository) GetProjectContributors(projectID string) ([]*models.ProjectContributor, error) {
+func (r *ProjectRepository) GetProjectContributors(ctx context.Context, projectID string) ([]*models.ProjectContributor, error) {
 	query := `
 		SELECT pc.id, pc.project_id, pc.user_id, pc.role, pc.permissions, pc.joined_at,
-			   u.corporate_id, u.github_username, u.full_name
+			   u.corporate_id, COALESCE(u.github_username, ''), u.full_name
 		FROM project_contributors pc
 		INNER JOIN users u ON pc.user_id = u.id
 		WHERE pc.project_id = $1
 		ORDER BY pc.joined_at ASC`
 
-	rows, err := r.db.Query(query, projectID)
+	rows, err := r.db.QueryContext(ctx, query, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +284,7 @@ func (r *ProjectRepository) GetProjectContributors(projectID string) ([]*models.
 }
 
 // SearchProjects searches public projects by name/description.
-func (r *ProjectRepository) SearchProjects(query string, limit, offset int) ([]*models.Project, error) {
+func (r *ProjectRepository) SearchProjects(ctx context.Context, query string, limit, offset int) ([]*models.Project, error) {
 	searchQuery := `
 		SELECT id, name, description, url, license, status, owner_id, language, stars, forks, is_public, created_at, updated_at
 		FROM projects 
@@ -211,7 +294,7 @@ func (r *ProjectRepository) SearchProjects(query string, limit, offset int) ([]*
 
 	searchTerm := "%" + query + "%"
 
-	rows, err := r.db.Query(searchQuery, searchTerm, limit, offset)
+	rows, err := r.db.QueryContext(ctx, searchQuery, searchTerm, limit, offset)
 	if err != nil {
 		return nil, err
 	}
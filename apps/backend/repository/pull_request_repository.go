@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"sourcestream/backend/models"
+)
+
+// Pull request event types.
+const (
+	PullRequestEventOpened  = "opened"
+	PullRequestEventUpdated = "updated"
+	PullRequestEventMerged  = "merged"
+	PullRequestEventClosed  = "closed"
+)
+
+// Statuses a pull request approval request moves to when its upstream pull request is merged or
+// closed.
+const (
+	RequestStatusMerged = "merged"
+	RequestStatusClosed = "closed"
+)
+
+// PullRequestStateInvalid marks a request whose project_url is not a recognizable pull request URL.
+const PullRequestStateInvalid = "invalid"
+
+// FlagPolicyViolation marks a request whose upstream pull request was merged without approval.
+const FlagPolicyViolation = "policy_violation"
+
+// PullRequestObservation is the result of checking a tracked pull request on its hosting provider.
+type PullRequestObservation struct {
+	RequestID string
+	Provider  string
+	Owner     string
+	Repo      string
+	Number    int
+	// State is empty while the pull request has never been observed upstream.
+	State             string
+	HeadSHA           string
+	UpstreamUpdatedAt *time.Time
+	LastError         string
+	Events            []*models.PullRequestEvent
+	// PolicyViolation, when non-empty, raises a policy_violation flag with this reason.
+	PolicyViolation string
+	// RequestStatus, when non-empty, moves the request to this status if it is still undecided or
+	// approved.
+	RequestStatus string
+}
+
+const trackedPullRequestColumns = `r.id, COALESCE(r.project_url, ''), r.status, r.approved_at,
+		COALESCE(t.provider, ''), COALESCE(t.owner, ''), COALESCE(t.repo, ''), COALESCE(t.number, 0),
+		t.state, t.head_sha, t.upstream_updated_at, t.last_error`
+
+// PullRequestRepository provides DB operations for upstream pull request tracking.
+type PullRequestRepository struct {
+	db *sql.DB
+}
+
+// NewPullRequestRepository creates a new PullRequestRepository with the given DB handle.
+func NewPullRequestRepository(db *sql.DB) *PullRequestRepository {
+	return &PullRequestRepository{db: db}
+}
+
+// GetTrackablePullRequests returns pull request approval requests whose upstream pull request
+// has not reached a terminal state, least recently checked first.
+func (r *PullRequestRepository) GetTrackablePullRequests(ctx context.Context, limit int) ([]*models.TrackedPullRequest, error) {
+	query := `
+		SELECT ` + trackedPullRequestColumns + `
+		FROM requests r
+		LEFT JOIN pull_request_tracking t ON t.request_id = r.id
+		WHERE r.type = 'pullrequest' AND (t.state IS NULL OR t.state = 'open')
+		ORDER BY t.last_checked_at ASC NULLS FIRST, r.created_at ASC
+		LIMIT $1`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return scanTrackedPullRequests(rows)
+}
+
+// GetTrackedPullRequestsByURL returns the pull request approval requests for an upstream pull request URL.
+// Trailing slashes are ignored when matching.
+func (r *PullRequestRepository) GetTrackedPullRequestsByURL(ctx context.Context, pullRequestURL string) ([]*models.TrackedPullRequest, error) {
+	query := `
+		SELECT ` + trackedPullRequestColumns + `
+		FROM requests r
+		LEFT JOIN pull_request_tracking t ON t.request_id = r.id
+		WHERE r.type = 'pullrequest' AND RTRIM(r.project_url, '/') = RTRIM($1, '/')
+		ORDER BY r.created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, pullRequestURL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return scanTrackedPullRequests(rows)
+}
+
+func scanTrackedPullRequests(rows *sql.Rows) ([]*models.TrackedPullRequest, error) {
+	var tracked []*models.TrackedPullRequest
+
+	for rows.Next() {
+		pr := &models.TrackedPullRequest{}
+
+		err := rows.Scan(
+			&pr.RequestID, &pr.PullRequestURL, &pr.RequestStatus, &pr.ApprovedAt,
+			&pr.Provider, &pr.Owner, &pr.Repo, &pr.Number,
+			&pr.State, &pr.HeadSHA, &pr.UpstreamUpdatedAt, &pr.LastError,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		tracked = append(tracked, pr)
+	}
+
+	return tracked, rows.Err()
+}
+
+// RecordPullRequestObservation stores the latest upstream state of a tracked pull request together
+// with any events, request status and policy violation flag derived from it.
+func (r *PullRequestRepository) RecordPullRequestObservation(ctx context.Context, obs *PullRequestObservation) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO pull_request_tracking (request_id, provider, owner, repo, number, state, head_sha, upstream_updated_at, last_error, last_checked_at)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), $8, NULLIF($9, ''), CURRENT_TIMESTAMP)
+		ON CONFLICT (request_id) DO UPDATE
+		SET provider = EXCLUDED.provider, owner = EXCLUDED.owner, repo = EXCLUDED.repo, number = EXCLUDED.number,
+			state = EXCLUDED.state, head_sha = EXCLUDED.head_sha, upstream_updated_at = EXCLUDED.upstream_updated_at,
+			last_error = EXCLUDED.last_error, last_checked_at = CURRENT_TIMESTAMP`,
+		obs.RequestID, obs.Provider, obs.Owner, obs.Repo, obs.Number, obs.State,
+		obs.HeadSHA, obs.UpstreamUpdatedAt, obs.LastError)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range obs.Events {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO pull_request_events (request_id, event_type, head_sha, occurred_at)
+			VALUES ($1, $2, $3, $4)`,
+			obs.RequestID, event.EventType, event.HeadSHA, event.OccurredAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	if obs.RequestStatus != "" {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE requests
+			SET status = $2
+			WHERE id = $1 AND status IN ('pending', 'in_review', 'approved')`,
+			obs.RequestID, obs.RequestStatus)
+		if err != nil {
+			return err
+		}
+	}
+
+	if obs.PolicyViolation != "" {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO request_flags (request_id, flag_type, reason)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (request_id, flag_type) WHERE resolved_at IS NULL DO NOTHING`,
+			obs.RequestID, FlagPolicyViolation, obs.PolicyViolation)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetPullRequestEvents returns the upstream pull request events recorded against a request in order.
+func (r *PullRequestRepository) GetPullRequestEvents(ctx context.Context, requestID string) ([]*models.PullRequestEvent, error) {
+	query := `
+		SELECT id, request_id, event_type, head_sha, occurred_at, created_at
+		FROM pull_request_events
+		WHERE request_id = $1
+		ORDER BY occurred_at ASC, created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var events []*models.PullRequestEvent
+
+	for rows.Next() {
+		event := &models.PullRequestEvent{}
+
+		err := rows.Scan(&event.ID, &event.RequestID, &event.EventType, &event.HeadSHA, &event.OccurredAt, &event.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
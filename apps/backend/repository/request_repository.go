@@ -2,14 +2,18 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
-	"fmt"
+	"time"
 
 	"sourcestream/backend/models"
 
 	"github.com/google/uuid"
 )
 
+// ErrRequestNotFound is returned when no request matches a lookup.
+var ErrRequestNotFound error = &NotFoundError{Resource: "request"}
+
 // RequestRepository provides DB operations for request records.
 type RequestRepository struct {
 	db *sql.DB
@@ -21,31 +25,33 @@ func NewRequestRepository(db *sql.DB) *RequestRepository {
 }
 
 // CreateRequest inserts a new request row.
-func (r *RequestRepository) CreateRequest(request *models.Request) error {
+func (r *RequestRepository) CreateRequest(ctx context.Context, request *models.Request) error {
 	query := `
-		INSERT INTO requests (id, type, title, status, requester_id, project_id, project_name, project_url, license, requested_role)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+		INSERT INTO requests (id, type, title, status, requester_id, project_id, project_name, project_url, license, requested_role,
+			approved_project_id, business_justification, contribution_kind)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NULLIF($13, ''))`
 
 	if request.ID == "" {
 		request.ID = uuid.New().String()
 	}
 
-	_, err := r.db.Exec(query, request.ID, request.Type, request.Title,
+	_, err := r.db.ExecContext(ctx, query, request.ID, request.Type, request.Title,
 		request.Status, request.RequesterID,
 		request.ProjectID, request.ProjectName, request.ProjectURL,
-		request.License, request.Role)
+		request.License, request.Role,
+		request.ApprovedProjectID, request.BusinessJustification, request.ContributionKind)
 
 	return err
 }
 
 // GetRequestByID returns a request by its ID.
-func (r *RequestRepository) GetRequestByID(id string) (*models.Request, error) {
+func (r *RequestRepository) GetRequestByID(ctx context.Context, id string) (*models.Request, error) {
 	query := `
 		SELECT id, type, title, status, requester_id, reviewer_id, project_id, project_name, project_url, license, requested_role, approved_at, rejected_at, rejection_reason, created_at, updated_at
 		FROM requests WHERE id = $1`
 
 	request := &models.Request{}
-	err := r.db.QueryRow(query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&request.ID, &request.Type, &request.Title,
 		&request.Status, &request.RequesterID, &request.ReviewerID,
 		&request.ProjectID, &request.ProjectName, &request.ProjectURL,
@@ -55,14 +61,83 @@ func (r *RequestRepository) GetRequestByID(id string) (*models.Request, error) {
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("request not found")
+		return nil, notFound(ErrRequestNotFound, id)
 	}
 
 	return request, err
 }
 
+// RequestHistoryFilter selects past requests for evaluating approval rules.
+type RequestHistoryFilter struct {
+	Type  string
+	From  *time.Time
+	To    *time.Time
+	Limit int
+}
+
+// ListRequestHistory returns past requests matching the filter, newest first, including the
+// contribution permission fields that rules can inspect.
+func (r *RequestRepository) ListRequestHistory(ctx context.Context, filter RequestHistoryFilter) ([]*models.Request, error) {
+	query := `
+		SELECT id, type, title, status, requester_id, reviewer_id, project_id, COALESCE(project_name, ''),
+			   COALESCE(project_url, ''), COALESCE(license, ''), COALESCE(requested_role, ''),
+			   approved_project_id, business_justification, COALESCE(contribution_kind, ''), decision_reason,
+			   approved_at, rejected_at, rejection_reason, created_at, updated_at
+		FROM requests
+		WHERE ($1 = '' OR type = $1)
+		  AND ($2::timestamptz IS NULL OR created_at >= $2)
+		  AND ($3::timestamptz IS NULL OR created_at < $3)
+		ORDER BY created_at DESC
+		LIMIT $4`
+
+	rows, err := r.db.QueryContext(ctx, query, filter.Type, filter.From, filter.To, filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var requests []*models.Request
+
+	for rows.Next() {
+		request := &models.Request{}
+
+		err := rows.Scan(
+			&request.ID, &request.Type, &request.Title,
+			&request.Status, &request.RequesterID, &request.ReviewerID,
+			&request.ProjectID, &request.ProjectName, &request.ProjectURL,
+			&request.License, &request.Role, &request.ApprovedProjectID,
+			&request.BusinessJustification, &request.ContributionKind,
+			&request.DecisionReason, &request.ApprovedAt,
+			&request.RejectedAt, &request.RejectionReason,
+			&request.CreatedAt, &request.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, request)
+	}
+
+	return requests, rows.Err()
+}
+
+// GetRequestRequesterID returns the requester of a request, or an empty ID if it does not exist.
+func (r *RequestRepository) GetRequestRequesterID(ctx context.Context, id string) (string, error) {
+	query := `SELECT requester_id FROM requests WHERE id::text = $1`
+
+	var requesterID string
+
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&requesterID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	return requesterID, err
+}
+
 // GetRequestsByRequesterID returns requests made by a specific requester, optionally filtered by status.
-func (r *RequestRepository) GetRequestsByRequesterID(requesterID string, status string, limit, offset int) ([]*models.Request, error) {
+func (r *RequestRepository) GetRequestsByRequesterID(ctx context.Context, requesterID string, status string, limit, offset int) ([]*models.Request, error) {
 	var query string
 
 	var args []interface{}
@@ -85,7 +160,7 @@ func (r *RequestRepository) GetRequestsByRequesterID(requesterID string, status
 		args = []interface{}{requesterID, limit, offset}
 	}
 
-	rows, err := r.db.Query(query, args...)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +171,7 @@ func (r *RequestRepository) GetRequestsByRequesterID(requesterID string, status
 }
 
 // GetRequestsByType lists requests filtered by type.
-func (r *RequestRepository) GetRequestsByType(requestType string, limit, offset int) ([]*models.Request, error) {
+func (r *RequestRepository) GetRequestsByType(ctx context.Context, requestType string, limit, offset int) ([]*models.Request, error) {
 	query := `
 		SELECT id, type, title, status, requester_id, reviewer_id, project_id, project_name, project_url, license, requested_role, approved_at, rejected_at, rejection_reason, created_at, updated_at
 		FROM requests 
@@ -104,7 +179,7 @@ func (r *RequestRepository) GetRequestsByType(requestType string, limit, offset
 		ORDER BY created_at DESC
 		LIMIT $2 OFFSET $3`
 
-	rows, err := r.db.Query(query, requestType, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, requestType, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +190,7 @@ func (r *RequestRepository) GetRequestsByType(requestType string, limit, offset
 }
 
 // GetPendingRequests lists requests currently in a pending state.
-func (r *RequestRepository) GetPendingRequests(limit, offset int) ([]*models.Request, error) {
+func (r *RequestRepository) GetPendingRequests(ctx context.Context, limit, offset int) ([]*models.Request, error) {
 	query := `
 		SELECT id, type, title, status, requester_id, reviewer_id, project_id, project_name, project_url, license, requested_role, approved_at, rejected_at, rejection_reason, created_at, updated_at
 		FROM requests 
@@ -123,7 +198,7 @@ func (r *RequestRepository) GetPendingRequests(limit, offset int) ([]*models.Req
 		ORDER BY created_at ASC
 		LIMIT $1 OFFSET $2`
 
-	rows, err := r.db.Query(query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -134,12 +209,12 @@ func (r *RequestRepository) GetPendingRequests(limit, offset int) ([]*models.Req
 }
 
 // GetRequestsByUser returns requests made by a specific user.
-func (r *RequestRepository) GetRequestsByUser(userID string) ([]*models.Request, error) {
+func (r *RequestRepository) GetRequestsByUser(ctx context.Context, userID string) ([]*models.Request, error) {
 	query := `
 		SELECT id, type, title, status, requester_id, reviewer_id, project_id, project_name, project_url, license, requested_role, approved_at, rejected_at, rejection_reason, created_at, updated_at
 		FROM requests WHERE requester_id = $1 ORDER BY created_at DESC`
 
-	rows, err := r.db.Query(query, userID)
+	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +245,7 @@ func (r *RequestRepository) GetRequestsByUser(userID string) ([]*models.Request,
 }
 
 // UpdateRequestStatus updates the status and reviewer info for a request.
-func (r *RequestRepository) UpdateRequestStatus(id string, status string, reviewerID *string, rejectionReason *string) error {
+func (r *RequestRepository) UpdateRequestStatus(ctx context.Context, id string, status string, reviewerID *string, rejectionReason *string) error {
 	query := `
 		UPDATE requests 
 		SET status = $2, reviewer_id = $3, rejection_reason = $4,
@@ -178,19 +253,119 @@ func (r *RequestRepository) UpdateRequestStatus(id string, status string, review
 			rejected_at = CASE WHEN $2 = 'rejected' THEN CURRENT_TIMESTAMP ELSE rejected_at END
 		WHERE id = $1`
 
-	_, err := r.db.Exec(query, id, status, reviewerID, rejectionReason)
+	_, err := r.db.ExecContext(ctx, query, id, status, reviewerID, rejectionReason)
 
 	return err
 }
 
+// AutoApproveRequest approves a pending request on behalf of an auto-approval rule, recording the
+// rule and the decision reason. It reports false when the request is no longer pending.
+func (r *RequestRepository) AutoApproveRequest(ctx context.Context, id, ruleID string, ruleVersion int, reason string) (bool, error) {
+	query := `
+		UPDATE requests
+		SET status = 'approved', approved_at = CURRENT_TIMESTAMP, decision_reason = $4,
+			auto_approved_rule_id = $2, auto_approved_rule_version = $3
+		WHERE id = $1 AND status = 'pending'`
+
+	result, err := r.db.ExecContext(ctx, query, id, ruleID, ruleVersion, reason)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+
+	return affected > 0, err
+}
+
+// AssignReviewer assigns a pending request that has no reviewer yet to a reviewer. It reports false
+// when the request is no longer pending or already has a reviewer.
+func (r *RequestRepository) AssignReviewer(ctx context.Context, id, reviewerID string) (bool, error) {
+	query := `
+		UPDATE requests
+		SET reviewer_id = $2
+		WHERE id = $1 AND status = 'pending' AND reviewer_id IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id, reviewerID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+
+	return affected > 0, err
+}
+
+// AutoApprovedFilter selects requests approved by auto-approval rules.
+type AutoApprovedFilter struct {
+	RuleID string
+	From   *time.Time
+	To     *time.Time
+	Limit  int
+	Offset int
+}
+
+// ListAutoApprovedRequests returns requests approved by auto-approval rules, most recently
+// approved first, together with the total number matching the filter.
+func (r *RequestRepository) ListAutoApprovedRequests(ctx context.Context, filter AutoApprovedFilter) ([]*models.AutoApprovedRequest, int, error) {
+	where := `
+		WHERE r.auto_approved_rule_version IS NOT NULL
+		  AND ($1 = '' OR r.auto_approved_rule_id::text = $1)
+		  AND ($2::timestamptz IS NULL OR r.approved_at >= $2)
+		  AND ($3::timestamptz IS NULL OR r.approved_at < $3)`
+
+	var total int
+
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM requests r`+where, filter.RuleID, filter.From, filter.To).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT r.id, r.type, r.title, r.status, r.requester_id, r.approved_project_id,
+			   COALESCE(r.contribution_kind, ''), r.decision_reason, r.approved_at, r.created_at, r.updated_at,
+			   r.auto_approved_rule_id, COALESCE(ar.name, ''), r.auto_approved_rule_version
+		FROM requests r
+		LEFT JOIN approval_rules ar ON ar.id = r.auto_approved_rule_id` + where + `
+		ORDER BY r.approved_at DESC, r.id
+		LIMIT $4 OFFSET $5`
+
+	rows, err := r.db.QueryContext(ctx, query, filter.RuleID, filter.From, filter.To, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var approved []*models.AutoApprovedRequest
+
+	for rows.Next() {
+		request := &models.Request{}
+		entry := &models.AutoApprovedRequest{Request: request}
+
+		err := rows.Scan(
+			&request.ID, &request.Type, &request.Title, &request.Status,
+			&request.RequesterID, &request.ApprovedProjectID, &request.ContributionKind,
+			&request.DecisionReason, &request.ApprovedAt, &request.CreatedAt, &request.UpdatedAt,
+			&entry.RuleID, &entry.RuleName, &entry.RuleVersion,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		approved = append(approved, entry)
+	}
+
+	return approved, total, rows.Err()
+}
+
 // UpdateRequest updates mutable fields on a request.
-func (r *RequestRepository) UpdateRequest(request *models.Request) error {
+func (r *RequestRepository) UpdateRequest(ctx context.Context, request *models.Request) error {
 	query := `
 		UPDATE requests 
 		SET title = $2, status = $3, project_name = $4, project_url = $5, license = $6, requested_role = $7
 		WHERE id = $1`
 
-	_, err := r.db.Exec(query, request.ID, request.Title,
+	_, err := r.db.ExecContext(ctx, query, request.ID, request.Title,
 		request.Status, request.ProjectName, request.ProjectURL,
 		request.License, request.Role)
 
@@ -198,35 +373,35 @@ func (r *RequestRepository) UpdateRequest(request *models.Request) error {
 }
 
 // DeleteRequest deletes a request by ID.
-func (r *RequestRepository) DeleteRequest(id string) error {
+func (r *RequestRepository) DeleteRequest(ctx context.Context, id string) error {
 	query := `DELETE FROM requests WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 
 	return err
 }
 
 // AddRequestComment creates a new comment for a request.
-func (r *RequestRepository) AddRequestComment(requestID, userID, comment string, isInternal bool) error {
+func (r *RequestRepository) AddRequestComment(ctx context.Context, requestID, userID, comment string, isInternal bool) error {
 	query := `
 		INSERT INTO request_comments (request_id, user_id, comment, is_internal)
 		VALUES ($1, $2, $3, $4)`
 
-	_, err := r.db.Exec(query, requestID, userID, comment, isInternal)
+	_, err := r.db.ExecContext(ctx, query, requestID, userID, comment, isInternal)
 
 	return err
 }
 
 // GetRequestComments returns comments for a request ordered by creation time.
-func (r *RequestRepository) GetRequestComments(requestID string) ([]*models.RequestComment, error) {
+func (r *RequestRepository) GetRequestComments(ctx context.Context, requestID string) ([]*models.RequestComment, error) {
 	query := `
 		SELECT rc.id, rc.request_id, rc.user_id, rc.comment, rc.is_internal, rc.created_at,
-			   u.github_username, u.full_name
+			   COALESCE(u.github_username, ''), u.full_name
 		FROM request_comments rc
 		INNER JOIN users u ON rc.user_id = u.id
 		WHERE rc.request_id = $1
 		ORDER BY rc.created_at ASC`
 
-	rows, err := r.db.Query(query, requestID)
+	rows, err := r.db.QueryContext(ctx, query, requestID)
 	if err != nil {
 		return nil, err
 	}
@@ -253,15 +428,56 @@ func (r *RequestRepository) GetRequestComments(requestID string) ([]*models.Requ
 	return comments, rows.Err()
 }
 
+// GetContributorGrants returns the contribution permissions and access grants a user currently holds:
+// approved contribution permissions on active catalog entries, which expire at the entry's next review,
+// and approved access requests for projects the user is still a contributor of.
+func (r *RequestRepository) GetContributorGrants(ctx context.Context, userID string) ([]*models.ContributorGrant, error) {
+	query := `
+		SELECT r.id, r.type, ap.id, ap.name, '', r.approved_at, ap.next_review_date
+		FROM requests r
+		INNER JOIN approved_projects ap ON r.approved_project_id = ap.id
+		WHERE r.requester_id = $1 AND r.type = 'contribution_permission' AND r.status = 'approved' AND ap.is_active
+		UNION ALL
+		SELECT DISTINCT ON (r.id) r.id, r.type, p.id, p.name, pc.role, r.approved_at, NULL::timestamptz
+		FROM requests r
+		INNER JOIN projects p ON p.id = r.project_id OR (r.project_id IS NULL AND p.name = r.project_name)
+		INNER JOIN project_contributors pc ON pc.project_id = p.id AND pc.user_id = r.requester_id
+		WHERE r.requester_id = $1 AND r.type = 'access' AND r.status = 'approved'
+		ORDER BY 6 DESC NULLS LAST`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var grants []*models.ContributorGrant
+
+	for rows.Next() {
+		grant := &models.ContributorGrant{}
+
+		err := rows.Scan(&grant.RequestID, &grant.Type, &grant.ProjectID, &grant.ProjectName,
+			&grant.Role, &grant.ApprovedAt, &grant.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+
+		grants = append(grants, grant)
+	}
+
+	return grants, rows.Err()
+}
+
 // GetRequestStats aggregates request counts by status for a user.
-func (r *RequestRepository) GetRequestStats(userID string) (map[string]int, error) {
+func (r *RequestRepository) GetRequestStats(ctx context.Context, userID string) (map[string]int, error) {
 	query := `
 		SELECT status, COUNT(*) as count
 		FROM requests 
 		WHERE requester_id = $1
 		GROUP BY status`
 
-	rows, err := r.db.Query(query, userID)
+	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,303 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"sourcestream/backend/models"
+
+	"github.com/lib/pq"
+)
+
+// ErrSCIMGroupNotFound is returned when no SCIM group matches a lookup.
+var ErrSCIMGroupNotFound error = &NotFoundError{Resource: "group"}
+
+// ErrDuplicateSCIMGroup is returned when a group's display name or external ID is already taken.
+var ErrDuplicateSCIMGroup error = &ConflictError{Resource: "group", Field: "displayName or externalId"}
+
+// ErrUnknownSCIMGroupMember is returned when a group member does not reference an existing user.
+var ErrUnknownSCIMGroupMember = errors.New("group member is not a known user")
+
+const scimGroupColumns = `id, display_name, COALESCE(external_id, ''), created_at, updated_at`
+
+// SCIMGroupRepository provides DB operations for groups provisioned over SCIM.
+type SCIMGroupRepository struct {
+	db *sql.DB
+}
+
+// NewSCIMGroupRepository creates a new SCIMGroupRepository with the given DB handle.
+func NewSCIMGroupRepository(db *sql.DB) *SCIMGroupRepository {
+	return &SCIMGroupRepository{db: db}
+}
+
+// CreateGroup inserts a group and its members.
+func (r *SCIMGroupRepository) CreateGroup(ctx context.Context, group *models.SCIMGroup) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO scim_groups (display_name, external_id)
+		VALUES ($1, NULLIF($2, ''))
+		RETURNING id, created_at, updated_at`,
+		group.DisplayName, group.ExternalID,
+	).Scan(&group.ID, &group.CreatedAt, &group.UpdatedAt)
+	if err != nil {
+		return scimGroupError(err)
+	}
+
+	if err := addSCIMGroupMembers(ctx, tx, group.ID, memberIDs(group.Members)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetGroup returns a group by ID, with its members when withMembers is set.
+func (r *SCIMGroupRepository) GetGroup(ctx context.Context, id string, withMembers bool) (*models.SCIMGroup, error) {
+	query := `SELECT ` + scimGroupColumns + ` FROM scim_groups WHERE id = $1`
+
+	group := &models.SCIMGroup{}
+
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&group.ID, &group.DisplayName, &group.ExternalID, &group.CreatedAt, &group.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, notFound(ErrSCIMGroupNotFound, id)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if withMembers {
+		if err := r.loadMembers(ctx, []*models.SCIMGroup{group}); err != nil {
+			return nil, err
+		}
+	}
+
+	return group, nil
+}
+
+// FindGroups returns the groups matching a condition, oldest first, together with the total
+// number of matches. Members are loaded when withMembers is set.
+func (r *SCIMGroupRepository) FindGroups(ctx context.Context, cond Condition, limit, offset int, withMembers bool) ([]*models.SCIMGroup, int, error) {
+	var total int
+
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM scim_groups`+cond.where(), cond.Args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	n := len(cond.Args)
+	query := fmt.Sprintf(`SELECT %s FROM scim_groups%s ORDER BY created_at ASC, id LIMIT $%d OFFSET $%d`,
+		scimGroupColumns, cond.where(), n+1, n+2)
+
+	rows, err := r.db.QueryContext(ctx, query, append(cond.Args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var groups []*models.SCIMGroup
+
+	for rows.Next() {
+		group := &models.SCIMGroup{}
+
+		err := rows.Scan(&group.ID, &group.DisplayName, &group.ExternalID, &group.CreatedAt, &group.UpdatedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		groups = append(groups, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if withMembers && len(groups) > 0 {
+		if err := r.loadMembers(ctx, groups); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return groups, total, nil
+}
+
+// UpdateGroup updates a group's attributes and, when members is non-nil, replaces its members.
+// Members to add and remove are applied after any replacement.
+func (r *SCIMGroupRepository) UpdateGroup(ctx context.Context, group *models.SCIMGroup, members, add, remove []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE scim_groups SET display_name = $2, external_id = NULLIF($3, '')
+		WHERE id = $1
+		RETURNING updated_at`,
+		group.ID, group.DisplayName, group.ExternalID,
+	).Scan(&group.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return notFound(ErrSCIMGroupNotFound, group.ID)
+	}
+
+	if err != nil {
+		return scimGroupError(err)
+	}
+
+	if members != nil {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM scim_group_members WHERE group_id = $1`, group.ID); err != nil {
+			return err
+		}
+
+		add = append(members, add...)
+	}
+
+	if err := addSCIMGroupMembers(ctx, tx, group.ID, add); err != nil {
+		return err
+	}
+
+	if len(remove) > 0 {
+		_, err := tx.ExecContext(ctx, `DELETE FROM scim_group_members WHERE group_id = $1 AND user_id::text = ANY($2)`,
+			group.ID, pq.Array(remove))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteGroup deletes a group and its memberships.
+func (r *SCIMGroupRepository) DeleteGroup(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM scim_groups WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return notFound(ErrSCIMGroupNotFound, id)
+	}
+
+	return err
+}
+
+// GetUserGroups returns the groups a user belongs to, without their members.
+func (r *SCIMGroupRepository) GetUserGroups(ctx context.Context, userID string) ([]*models.SCIMGroup, error) {
+	query := `
+		SELECT g.id, g.display_name, COALESCE(g.external_id, ''), g.created_at, g.updated_at
+		FROM scim_groups g
+		INNER JOIN scim_group_members m ON m.group_id = g.id
+		WHERE m.user_id = $1
+		ORDER BY g.display_name ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	var groups []*models.SCIMGroup
+
+	for rows.Next() {
+		group := &models.SCIMGroup{}
+
+		err := rows.Scan(&group.ID, &group.DisplayName, &group.ExternalID, &group.CreatedAt, &group.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups, rows.Err()
+}
+
+func (r *SCIMGroupRepository) loadMembers(ctx context.Context, groups []*models.SCIMGroup) error {
+	byID := make(map[string]*models.SCIMGroup, len(groups))
+	ids := make([]string, len(groups))
+
+	for i, group := range groups {
+		group.Members = []*models.SCIMGroupMember{}
+		byID[group.ID] = group
+		ids[i] = group.ID
+	}
+
+	query := `
+		SELECT m.group_id, u.id, u.corporate_id, u.full_name
+		FROM scim_group_members m
+		INNER JOIN users u ON u.id = m.user_id
+		WHERE m.group_id::text = ANY($1)
+		ORDER BY m.added_at ASC, u.corporate_id ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var groupID string
+
+		member := &models.SCIMGroupMember{}
+		if err := rows.Scan(&groupID, &member.UserID, &member.CorporateID, &member.FullName); err != nil {
+			return err
+		}
+
+		byID[groupID].Members = append(byID[groupID].Members, member)
+	}
+
+	return rows.Err()
+}
+
+func addSCIMGroupMembers(ctx context.Context, tx *sql.Tx, groupID string, userIDs []string) error {
+	for _, userID := range userIDs {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE id::text = $1)`, userID).Scan(&exists); err != nil {
+			return err
+		}
+
+		if !exists {
+			return fmt.Errorf("%w: %s", ErrUnknownSCIMGroupMember, userID)
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO scim_group_members (group_id, user_id)
+			VALUES ($1, $2)
+			ON CONFLICT (group_id, user_id) DO NOTHING`,
+			groupID, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func memberIDs(members []*models.SCIMGroupMember) []string {
+	ids := make([]string, len(members))
+	for i, member := range members {
+		ids[i] = member.UserID
+	}
+
+	return ids
+}
+
+func scimGroupError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return ErrDuplicateSCIMGroup
+	}
+
+	return TranslateError(err)
+}
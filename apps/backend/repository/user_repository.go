@@ -1,14 +1,49 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 
 	"sourcestream/backend/models"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+// ErrUserNotFound is returned when no user matches a lookup.
+var ErrUserNotFound error = &NotFoundError{Resource: "user"}
+
+// DuplicateUserError is returned by CreateUser when a unique user field is already taken.
+type DuplicateUserError struct {
+	// Field is corporate_id, github_username, email or external_id.
+	Field string
+}
+
+func (e *DuplicateUserError) Error() string {
+	return fmt.Sprintf("a user with this %s already exists", e.Field)
+}
+
+// Unwrap returns the DuplicateUserError as a ConflictError.
+func (e *DuplicateUserError) Unwrap() error {
+	return &ConflictError{Resource: "user", Field: e.Field}
+}
+
+// userUniqueConstraints maps the unique constraints on users to the field they cover.
+var userUniqueConstraints = map[string]string{
+	"users_corporate_id_key":    "corporate_id",
+	"users_github_username_key": "github_username",
+	"users_email_key":           "email",
+	"users_external_id_key":     "external_id",
+}
+
+// userColumns are the users columns read into models.User, in scanUser order.
+const userColumns = `id, corporate_id, COALESCE(github_username, ''), email, full_name, COALESCE(department, ''),
+		role, is_active, COALESCE(external_id, ''), manager_id, is_service_account,
+		github_verified_at, created_at, updated_at`
+
 // UserRepository provides DB operations for users.
 type UserRepository struct {
 	db *sql.DB
@@ -19,127 +54,247 @@ func NewUserRepository(db *sql.DB) *UserRepository {
 	return &UserRepository{db: db}
 }
 
-// CreateUser inserts a new user row.
-func (r *UserRepository) CreateUser(user *models.User) error {
+// CreateUser inserts a new user row. An empty GitHub username or external ID is stored as NULL.
+func (r *UserRepository) CreateUser(ctx context.Context, user *models.User) error {
 	query := `
-		INSERT INTO users (id, corporate_id, github_username, email, full_name, department, role)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+		INSERT INTO users (id, corporate_id, github_username, email, full_name, department, role, is_active, external_id,
+			is_service_account)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, $8, NULLIF($9, ''), $10)`
 
 	if user.ID == "" {
 		user.ID = uuid.New().String()
 	}
 
-	_, err := r.db.Exec(query, user.ID, user.CorporateID, user.GithubUsername,
-		user.Email, user.FullName, user.Department, user.Role)
+	_, err := r.db.ExecContext(ctx, query, user.ID, user.CorporateID, user.GithubUsername,
+		user.Email, user.FullName, user.Department, user.Role, user.IsActive, user.ExternalID, user.IsServiceAccount)
 
-	return err
+	return duplicateUserError(err)
 }
 
-// GetUserByID returns a user by their ID.
-func (r *UserRepository) GetUserByID(id string) (*models.User, error) {
-	query := `
-		SELECT id, corporate_id, github_username, email, full_name, department, role, is_active, created_at, updated_at
-		FROM users WHERE id = $1`
+// duplicateUserError converts a unique violation on a user field into a DuplicateUserError.
+func duplicateUserError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		if field, ok := userUniqueConstraints[pqErr.Constraint]; ok {
+			return &DuplicateUserError{Field: field}
+		}
+	}
 
-	user := &models.User{}
-	err := r.db.QueryRow(query, id).Scan(
-		&user.ID, &user.CorporateID, &user.GithubUsername, &user.Email,
-		&user.FullName, &user.Department, &user.Role, &user.IsActive,
-		&user.CreatedAt, &user.UpdatedAt,
-	)
+	return TranslateError(err)
+}
+
+// GetUserByID returns a user by their ID.
+func (r *UserRepository) GetUserByID(ctx context.Context, id string) (*models.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
 
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("user not found")
+		return nil, notFound(ErrUserNotFound, id)
 	}
 
 	return user, err
 }
 
 // GetUserByCorporateID returns a user by corporate ID.
-func (r *UserRepository) GetUserByCorporateID(corporateID string) (*models.User, error) {
-	query := `
-		SELECT id, corporate_id, github_username, email, full_name, department, role, is_active, created_at, updated_at
-		FROM users WHERE corporate_id = $1`
-
-	user := &models.User{}
-	err := r.db.QueryRow(query, corporateID).Scan(
-		&user.ID, &user.CorporateID, &user.GithubUsername, &user.Email,
-		&user.FullName, &user.Department, &user.Role, &user.IsActive,
-		&user.CreatedAt, &user.UpdatedAt,
-	)
+func (r *UserRepository) GetUserByCorporateID(ctx context.Context, corporateID string) (*models.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE corporate_id = $1`
 
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, corporateID))
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("user not found")
+		return nil, notFound(ErrUserNotFound, corporateID)
 	}
 
 	return user, err
 }
 
-// GetUserByGithubUsername returns a user by their GitHub username.
-func (r *UserRepository) GetUserByGithubUsername(username string) (*models.User, error) {
-	query := `
-		SELECT id, corporate_id, github_username, email, full_name, department, role, is_active, created_at, updated_at
-		FROM users WHERE github_username = $1`
-
-	user := &models.User{}
-	err := r.db.QueryRow(query, username).Scan(
-		&user.ID, &user.CorporateID, &user.GithubUsername, &user.Email,
-		&user.FullName, &user.Department, &user.Role, &user.IsActive,
-		&user.CreatedAt, &user.UpdatedAt,
-	)
+// GetUserByGithubUsername returns a user by their GitHub username, which is matched case-insensitively.
+func (r *UserRepository) GetUserByGithubUsername(ctx context.Context, username string) (*models.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE LOWER(github_username) = LOWER($1)`
 
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, username))
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("user not found")
+		return nil, notFound(ErrUserNotFound, username)
 	}
 
 	return user, err
 }
 
-// UpdateUser updates mutable fields on a user.
-func (r *UserRepository) UpdateUser(user *models.User) error {
+// GetUserActivityStats counts the projects a user owns and contributes to (as a non-owner) and their
+// approved contribution permissions on catalog entries that are still active.
+func (r *UserRepository) GetUserActivityStats(ctx context.Context, userID string) (*models.UserActivityStats, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM projects WHERE owner_id = $1),
+			(SELECT COUNT(*) FROM project_contributors WHERE user_id = $1 AND role != 'owner'),
+			(SELECT COUNT(*) FROM requests r
+			 INNER JOIN approved_projects ap ON r.approved_project_id = ap.id
+			 WHERE r.requester_id = $1 AND r.type = 'contribution_permission'
+			   AND r.status = 'approved' AND ap.is_active)`
+
+	stats := &models.UserActivityStats{}
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&stats.OwnedProjects, &stats.ContributedProjects, &stats.ActiveContributionPermissions,
+	)
+
+	return stats, err
+}
+
+// UpdateUser updates mutable fields on a user. The manager is set with OrgRepository.SetManager.
+// Changing the GitHub username, other than its case, clears its verification.
+func (r *UserRepository) UpdateUser(ctx context.Context, user *models.User) error {
 	query := `
 		UPDATE users 
-		SET github_username = $2, email = $3, full_name = $4, department = $5, role = $6, is_active = $7
+		SET corporate_id = $2, github_username = NULLIF($3, ''), email = $4, full_name = $5, department = $6,
+			role = $7, is_active = $8, external_id = NULLIF($9, ''),
+			github_verified_at = CASE
+				WHEN LOWER(github_username) IS NOT DISTINCT FROM LOWER(NULLIF($3, '')) THEN github_verified_at
+			END
 		WHERE id = $1`
 
-	_, err := r.db.Exec(query, user.ID, user.GithubUsername, user.Email,
-		user.FullName, user.Department, user.Role, user.IsActive)
+	_, err := r.db.ExecContext(ctx, query, user.ID, user.CorporateID, user.GithubUsername, user.Email,
+		user.FullName, user.Department, user.Role, user.IsActive, user.ExternalID)
 
-	return err
+	return duplicateUserError(err)
 }
 
-// DeleteUser deletes a user by ID.
-func (r *UserRepository) DeleteUser(id string) error {
+// DeleteUser deletes a user by ID. Users who own projects or have made requests cannot be
+// deleted; they are offboarded instead.
+func (r *UserRepository) DeleteUser(ctx context.Context, id string) error {
 	query := `DELETE FROM users WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 
 	return err
 }
 
-// ListUsers returns a paginated list of users.
-func (r *UserRepository) ListUsers(limit, offset int) ([]*models.User, error) {
-	query := `
-		SELECT id, corporate_id, github_username, email, full_name, department, role, is_active, created_at, updated_at
-		FROM users 
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2`
+// UserFilter selects users for the admin directory. Query matches users whose name, email, GitHub
+// username, department or corporate ID contain every word of it, case-insensitively; the other
+// fields are exact filters. Zero values do not filter.
+type UserFilter struct {
+	Query      string
+	Role       string
+	Department string
+	Active     *bool
+	// ServiceAccount selects service accounts when true and people when false
+	ServiceAccount *bool
+	Limit          int
+	Offset         int
+}
+
+// condition renders the filter as a Condition.
+func (f UserFilter) condition() Condition {
+	var (
+		clauses []string
+		args    []any
+	)
+
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	for _, word := range strings.Fields(f.Query) {
+		p := arg("%" + likeEscaper.Replace(word) + "%")
+		clauses = append(clauses, fmt.Sprintf(
+			"(full_name ILIKE %[1]s OR email ILIKE %[1]s OR github_username ILIKE %[1]s OR department ILIKE %[1]s OR corporate_id ILIKE %[1]s)", p))
+	}
 
-	rows, err := r.db.Query(query, limit, offset)
+	if f.Role != "" {
+		clauses = append(clauses, "role = "+arg(f.Role))
+	}
+
+	if f.Department != "" {
+		clauses = append(clauses, "department = "+arg(f.Department))
+	}
+
+	if f.Active != nil {
+		clauses = append(clauses, "is_active = "+arg(*f.Active))
+	}
+
+	if f.ServiceAccount != nil {
+		clauses = append(clauses, "is_service_account = "+arg(*f.ServiceAccount))
+	}
+
+	return Condition{SQL: strings.Join(clauses, " AND "), Args: args}
+}
+
+// likeEscaper escapes the LIKE wildcards in a search word.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ListUsers returns a page of the users matching a filter, by name, together with the total
+// number of matches.
+func (r *UserRepository) ListUsers(ctx context.Context, filter UserFilter) ([]*models.User, int, error) {
+	cond := filter.condition()
+
+	var total int
+
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM users`+cond.where(), cond.Args...).Scan(&total)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	n := len(cond.Args)
+	query := fmt.Sprintf(`SELECT %s FROM users%s ORDER BY LOWER(full_name), id LIMIT $%d OFFSET $%d`,
+		userColumns, cond.where(), n+1, n+2)
+
+	rows, err := r.db.QueryContext(ctx, query, append(cond.Args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, err
 	}
+
 	defer func() { _ = rows.Close() }()
 
+	users, err := scanUsers(rows)
+
+	return users, total, err
+}
+
+// FindUsers returns the users matching a condition, oldest first, together with the total number
+// of matches.
+func (r *UserRepository) FindUsers(ctx context.Context, cond Condition, limit, offset int) ([]*models.User, int, error) {
+	var total int
+
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM users`+cond.where(), cond.Args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	n := len(cond.Args)
+	query := fmt.Sprintf(`SELECT %s FROM users%s ORDER BY created_at ASC, id LIMIT $%d OFFSET $%d`,
+		userColumns, cond.where(), n+1, n+2)
+
+	rows, err := r.db.QueryContext(ctx, query, append(cond.Args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	users, err := scanUsers(rows)
+
+	return users, total, err
+}
+
+func scanUser(row rowScanner) (*models.User, error) {
+	user := &models.User{}
+
+	err := row.Scan(
+		&user.ID, &user.CorporateID, &user.GithubUsername, &user.Email,
+		&user.FullName, &user.Department, &user.Role, &user.IsActive,
+		&user.ExternalID, &user.ManagerID, &user.IsServiceAccount,
+		&user.GithubVerifiedAt, &user.CreatedAt, &user.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func scanUsers(rows *sql.Rows) ([]*models.User, error) {
 	var users []*models.User
 
 	for rows.Next() {
-		user := &models.User{}
-
-		err := rows.Scan(
-			&user.ID, &user.CorporateID, &user.GithubUsername, &user.Email,
-			&user.FullName, &user.Department, &user.Role, &user.IsActive,
-			&user.CreatedAt, &user.UpdatedAt,
-		)
+		user, err := scanUser(rows)
 		if err != nil {
 			return nil, err
 		}
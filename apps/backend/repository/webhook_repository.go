@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Webhook delivery statuses.
+const (
+	WebhookDeliveryProcessing = "processing"
+	WebhookDeliveryProcessed  = "processed"
+	WebhookDeliveryFailed     = "failed"
+	WebhookDeliveryIgnored    = "ignored"
+)
+
+// WebhookRepository provides DB operations for inbound webhook deliveries.
+type WebhookRepository struct {
+	db *sql.DB
+}
+
+// NewWebhookRepository creates a new WebhookRepository with the given DB handle.
+func NewWebhookRepository(db *sql.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+// BeginDelivery records a delivery as processing. It returns false when the delivery was already
+// received and is processing or done; deliveries that previously failed may be processed again.
+func (r *WebhookRepository) BeginDelivery(ctx context.Context, source, deliveryID, event string) (bool, error) {
+	query := `
+		INSERT INTO webhook_deliveries (source, delivery_id, event)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (source, delivery_id) DO UPDATE
+		SET status = 'processing', attempts = webhook_deliveries.attempts + 1, last_error = NULL
+		WHERE webhook_deliveries.status = 'failed'
+		RETURNING delivery_id`
+
+	var id string
+
+	err := r.db.QueryRowContext(ctx, query, source, deliveryID, event).Scan(&id)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// FinishDelivery records the outcome of processing a delivery.
+func (r *WebhookRepository) FinishDelivery(ctx context.Context, source, deliveryID, status, lastError string) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $3, last_error = NULLIF($4, ''), processed_at = CURRENT_TIMESTAMP
+		WHERE source = $1 AND delivery_id = $2`
+
+	_, err := r.db.ExecContext(ctx, query, source, deliveryID, status, lastError)
+
+	return err
+}
@@ -0,0 +1,134 @@
+// Package rpcerror converts the errors services return into gRPC status errors, so that clients
+// see a meaningful code and error details instead of Unknown.
+package rpcerror
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"sourcestream/backend/repository"
+
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain identifies SourceStream in ErrorInfo details.
+const Domain = "sourcestream"
+
+// queryCanceled is the Postgres error code for a statement cancelled by the client or by the
+// statement timeout.
+const queryCanceled = "57014"
+
+// UnaryInterceptor returns a unary server interceptor converting handler errors. It must run
+// first, so that it sees the errors of the other interceptors too.
+func UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, convert(ctx, info.FullMethod, err)
+		}
+
+		return resp, nil
+	}
+}
+
+// StreamInterceptor returns the stream server interceptor converting handler errors.
+func StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return convert(ss.Context(), info.FullMethod, err)
+		}
+
+		return nil
+	}
+}
+
+// convert returns the error of a call to method, made with ctx, as a gRPC status error:
+//
+//   - a call whose context was cancelled or ran out of time fails with Canceled or
+//     DeadlineExceeded, whatever failed as a result, and a statement stopped by the database's
+//     statement timeout fails with DeadlineExceeded;
+//   - status errors are returned unchanged;
+//   - the repository's typed errors, and the Postgres errors it translates, map to NotFound,
+//     AlreadyExists, FailedPrecondition or InvalidArgument with error details;
+//   - anything else is an Internal error whose message is not passed on.
+func convert(ctx context.Context, method string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	code := status.Code(err)
+
+	if ctx.Err() != nil && code != codes.Canceled && code != codes.DeadlineExceeded {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == queryCanceled {
+		return status.Error(codes.DeadlineExceeded, "database statement timed out")
+	}
+
+	var (
+		notFound  *repository.NotFoundError
+		conflict  *repository.ConflictError
+		reference *repository.ReferenceError
+		invalid   *repository.InvalidError
+	)
+
+	translated := repository.TranslateError(err)
+
+	switch {
+	case errors.As(translated, &notFound):
+		return withDetails(codes.NotFound, err.Error(), &errdetails.ResourceInfo{
+			ResourceType: notFound.Resource,
+			ResourceName: notFound.ID,
+			Description:  notFound.Error(),
+		})
+	case errors.As(translated, &conflict):
+		return withDetails(codes.AlreadyExists, conflict.Error(),
+			&errdetails.ErrorInfo{Reason: "ALREADY_EXISTS", Domain: Domain, Metadata: map[string]string{"field": conflict.Field}},
+			&errdetails.ResourceInfo{ResourceType: conflict.Resource, Description: conflict.Error()},
+		)
+	case errors.As(translated, &reference):
+		return withDetails(codes.FailedPrecondition, reference.Error(),
+			&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{
+				{Type: "REFERENCE", Subject: reference.Field, Description: reference.Error()},
+			}},
+			&errdetails.ResourceInfo{ResourceType: reference.Resource, Description: reference.Error()},
+		)
+	case errors.As(translated, &invalid):
+		return withDetails(codes.InvalidArgument, invalid.Error(), &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: invalid.Field, Description: invalid.Reason}},
+		})
+	}
+
+	log.Printf("%s failed: %v", method, err)
+
+	return status.Error(codes.Internal, "internal error")
+}
+
+// withDetails returns a status error with code, message and details. The details are dropped if
+// they cannot be attached.
+func withDetails(code codes.Code, msg string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, msg)
+
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
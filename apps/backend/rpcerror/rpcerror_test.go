@@ -0,0 +1,92 @@
+package rpcerror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sourcestream/backend/repository"
+)
+
+func TestConvert(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	expired, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	active := context.Background()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want codes.Code
+	}{
+		{"status errors pass through", active, status.Error(codes.NotFound, "no such user"), codes.NotFound},
+		{"cancelled query", active, fmt.Errorf("failed to get user: %w", context.Canceled), codes.Canceled},
+		{"expired query", active, fmt.Errorf("failed to get user: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+		{"statement timeout", active, fmt.Errorf("failed to list: %w", &pq.Error{Code: queryCanceled}), codes.DeadlineExceeded},
+		{"caller cancelled", canceled, fmt.Errorf("failed to list: %w", &pq.Error{Code: queryCanceled}), codes.Canceled},
+		{"caller ran out of time", expired, status.Error(codes.Internal, "failed to look up caller"), codes.DeadlineExceeded},
+		{"missing row", active, fmt.Errorf("contributor not found: %w", repository.ErrUserNotFound), codes.NotFound},
+		{"unique violation", active, &pq.Error{Code: "23505", Table: "teams", Detail: "Key (name)=(core) already exists."}, codes.AlreadyExists},
+		{"foreign key violation", active, &pq.Error{Code: "23503", Detail: `Key (user_id)=(u1) is not present in table "users".`}, codes.FailedPrecondition},
+		{"malformed value", active, &pq.Error{Code: "22P02", Message: "invalid input syntax for type uuid"}, codes.InvalidArgument},
+		{"other errors are internal", active, errors.New("pq: connection refused"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, status.Code(convert(tt.ctx, "/test/Method", tt.err)), tt.name)
+	}
+
+	assert.NoError(t, convert(active, "/test/Method", nil))
+}
+
+func TestConvert_Details(t *testing.T) {
+	ctx := context.Background()
+
+	st := status.Convert(convert(ctx, "/test/Method", fmt.Errorf("failed to get project: %w",
+		&repository.NotFoundError{Resource: "project", ID: "p1"})))
+	assert.Equal(t, "failed to get project: project not found", st.Message())
+	require.Len(t, st.Details(), 1)
+	assert.Equal(t, "p1", st.Details()[0].(*errdetails.ResourceInfo).GetResourceName())
+
+	st = status.Convert(convert(ctx, "/test/Method", &pq.Error{Code: "23505", Table: "users", Detail: "Key (email)=(a@b.c) already exists."}))
+	assert.Equal(t, "a user with this email already exists", st.Message())
+	assert.Equal(t, "email", st.Details()[0].(*errdetails.ErrorInfo).GetMetadata()["field"])
+
+	st = status.Convert(convert(ctx, "/test/Method", &pq.Error{Code: "23503", Detail: `Key (owner_id)=(u1) is not present in table "users".`}))
+	violation := st.Details()[0].(*errdetails.PreconditionFailure).GetViolations()[0]
+	assert.Equal(t, "owner_id", violation.GetSubject())
+	assert.Equal(t, "users", st.Details()[1].(*errdetails.ResourceInfo).GetResourceType())
+
+	st = status.Convert(convert(ctx, "/test/Method", &pq.Error{Code: "23514", Constraint: "requests_status_check"}))
+	assert.Equal(t, "requests_status_check", st.Details()[0].(*errdetails.BadRequest).GetFieldViolations()[0].GetField())
+
+	st = status.Convert(convert(ctx, "/test/Method", errors.New("dial tcp: connection refused")))
+	assert.Equal(t, "internal error", st.Message())
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	interceptor := UnaryInterceptor()
+
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		return nil, fmt.Errorf("failed to get user: %w", context.DeadlineExceeded)
+	})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		return "ok", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
@@ -0,0 +1,222 @@
+// Package rules evaluates attribute-based approval rules, written as CEL expressions, against a
+// request and the user, project and approved project it concerns.
+package rules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sourcestream/backend/models"
+
+	"github.com/google/cel-go/cel"
+)
+
+// Rule actions.
+const (
+	// ActionRequireReview adds a required review by the target group, e.g. security.
+	ActionRequireReview = "require_review"
+	// ActionRoute routes the request to the target team, e.g. legal.
+	ActionRoute = "route"
+	// ActionAutoApprove approves the request at submission unless another matching rule requires
+	// human review. It applies only to contribution permission requests.
+	ActionAutoApprove = "auto_approve"
+)
+
+// Rule targets that are resolved to a user when the rule matches, rather than naming a reviewer
+// group or team.
+const (
+	// TargetRequesterManager is the requester's manager.
+	TargetRequesterManager = "requester_manager"
+	// TargetDepartmentChampion is the OSPO champion of the requester's department.
+	TargetDepartmentChampion = "department_ospo_champion"
+)
+
+// IsAction reports whether action is a known rule action.
+func IsAction(action string) bool {
+	return action == ActionRequireReview || action == ActionRoute || action == ActionAutoApprove
+}
+
+// Input is the data a rule is evaluated against. Project and ApprovedProject are nil when the
+// request does not reference one; they are then presented to expressions as empty objects. Teams
+// are the names of the requester's teams, presented as user.teams.
+type Input struct {
+	Request         *models.Request
+	User            *models.User
+	Teams           []string
+	Project         *models.Project
+	ApprovedProject *models.ApprovedProject
+}
+
+// Limits on evaluating an expression, so that a costly rule, such as nested comprehensions over a
+// long list, cannot stall request submission. Rules are written by OSPO admins, but a mistake
+// should fail the rule rather than the service.
+const (
+	// maxCost bounds the CEL cost units, roughly the operations, an evaluation may take.
+	maxCost = 100_000
+	// evalTimeout bounds the wall-clock time an evaluation may take.
+	evalTimeout = 100 * time.Millisecond
+)
+
+// Program is a compiled rule expression.
+type Program struct {
+	program cel.Program
+}
+
+// env declares the variables available to expressions. Each is a map so that expressions can
+// test for optional attributes with has(), e.g. has(project.license).
+var env = func() *cel.Env {
+	e, err := cel.NewEnv(
+		cel.Variable("request", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("user", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("project", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("approved_project", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		panic(fmt.Sprintf("rules: invalid CEL environment: %v", err))
+	}
+
+	return e
+}()
+
+// Compile parses and type-checks an expression, which must evaluate to a bool.
+func Compile(expression string) (*Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("invalid expression: %w", issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("invalid expression: must evaluate to a bool, not %s", ast.OutputType())
+	}
+
+	program, err := env.Program(ast, cel.EvalOptions(cel.OptOptimize),
+		cel.CostLimit(maxCost), cel.InterruptCheckFrequency(10))
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression: %w", err)
+	}
+
+	return &Program{program: program}, nil
+}
+
+// Evaluate runs the program against the input and reports whether the rule matches. Evaluation
+// that exceeds its cost limit or time limit, or outlives ctx, fails.
+func (p *Program) Evaluate(ctx context.Context, input Input) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, evalTimeout)
+	defer cancel()
+
+	out, _, err := p.program.ContextEval(ctx, map[string]any{
+		"request":          requestValues(input.Request),
+		"user":             userValues(input.User, input.Teams),
+		"project":          projectValues(input.Project),
+		"approved_project": approvedProjectValues(input.ApprovedProject),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	matched, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %s, not a bool", out.Type().TypeName())
+	}
+
+	return matched, nil
+}
+
+func requestValues(r *models.Request) map[string]any {
+	values := map[string]any{}
+	if r == nil {
+		return values
+	}
+
+	values["id"] = r.ID
+	values["type"] = r.Type
+	values["title"] = r.Title
+	values["status"] = r.Status
+	values["requester_id"] = r.RequesterID
+	values["project_name"] = r.ProjectName
+	values["project_url"] = r.ProjectURL
+	values["license"] = r.License
+	values["role"] = r.Role
+	values["created_at"] = r.CreatedAt
+
+	if r.ContributionKind != "" {
+		values["contribution_kind"] = r.ContributionKind
+	}
+
+	setString(values, "project_id", r.ProjectID)
+	setString(values, "approved_project_id", r.ApprovedProjectID)
+	setString(values, "business_justification", r.BusinessJustification)
+
+	return values
+}
+
+func userValues(u *models.User, teams []string) map[string]any {
+	values := map[string]any{}
+	if u == nil {
+		return values
+	}
+
+	if teams == nil {
+		teams = []string{}
+	}
+
+	values["id"] = u.ID
+	values["corporate_id"] = u.CorporateID
+	values["github_username"] = u.GithubUsername
+	values["email"] = u.Email
+	values["full_name"] = u.FullName
+	values["department"] = u.Department
+	values["role"] = u.Role
+	values["is_active"] = u.IsActive
+	values["teams"] = teams
+
+	setString(values, "manager_id", u.ManagerID)
+
+	return values
+}
+
+func projectValues(p *models.Project) map[string]any {
+	values := map[string]any{}
+	if p == nil {
+		return values
+	}
+
+	values["id"] = p.ID
+	values["name"] = p.Name
+	values["url"] = p.URL
+	values["license"] = p.License
+	values["status"] = p.Status
+	values["owner_id"] = p.OwnerID
+	values["language"] = p.Language
+	values["stars"] = p.Stars
+	values["forks"] = p.Forks
+	values["is_public"] = p.IsPublic
+
+	return values
+}
+
+func approvedProjectValues(p *models.ApprovedProject) map[string]any {
+	values := map[string]any{}
+	if p == nil {
+		return values
+	}
+
+	values["id"] = p.ID
+	values["name"] = p.Name
+	values["repository_url"] = p.RepositoryURL
+	values["license"] = p.License
+	values["contribution_type"] = p.ContributionType
+	values["allowed_contribution_types"] = p.AllowedContributionTypes
+	values["is_active"] = p.IsActive
+	values["approval_date"] = p.ApprovalDate
+	values["next_review_date"] = p.NextReviewDate
+
+	return values
+}
+
+func setString(values map[string]any, key string, value *string) {
+	if value != nil {
+		values[key] = *value
+	}
+}
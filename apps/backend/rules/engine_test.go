@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sourcestream/backend/models"
+)
+
+func TestProgram_Evaluate(t *testing.T) {
+	approvedProjectID := "ap-1"
+	input := Input{
+		Request: &models.Request{
+			ID: "req-1", Type: "contribution_permission", License: "GPL-3.0",
+			ApprovedProjectID: &approvedProjectID, ContributionKind: "documentation", CreatedAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		User:  &models.User{ID: "user-1", Department: "Sales", Role: "contributor"},
+		Teams: []string{"Field Engineering"},
+		ApprovedProject: &models.ApprovedProject{
+			ID: "ap-1", License: "AGPL-3.0", ContributionType: "DCO",
+			AllowedContributionTypes: []string{"documentation", "bug-fix"}, IsActive: true,
+		},
+	}
+
+	tests := []struct {
+		expression string
+		want       bool
+	}{
+		{`request.license.startsWith("GPL") || approved_project.license.matches("^(A|L)?GPL")`, true},
+		{`user.department == "Sales"`, true},
+		{`user.department == "Engineering"`, false},
+		{`approved_project.contribution_type == "DCO" && "documentation" in approved_project.allowed_contribution_types`, true},
+		{`has(project.license) && project.license == "MIT"`, false},
+		{`request.created_at > timestamp("2025-01-01T00:00:00Z")`, true},
+		{`has(request.business_justification)`, false},
+		{`request.contribution_kind in approved_project.allowed_contribution_types`, true},
+		{`"Field Engineering" in user.teams`, true},
+		{`has(user.manager_id)`, false},
+	}
+
+	for _, tt := range tests {
+		program, err := Compile(tt.expression)
+		require.NoError(t, err, tt.expression)
+
+		matched, err := program.Evaluate(context.Background(), input)
+		require.NoError(t, err, tt.expression)
+		assert.Equal(t, tt.want, matched, tt.expression)
+	}
+}
+
+func TestCompile_Invalid(t *testing.T) {
+	for _, expression := range []string{
+		`request.license ==`,
+		`"not a bool"`,
+		`unknown_variable == 1`,
+	} {
+		_, err := Compile(expression)
+		assert.Error(t, err, expression)
+	}
+}
+
+func TestProgram_EvaluateMissingAttribute(t *testing.T) {
+	program, err := Compile(`project.license == "MIT"`)
+	require.NoError(t, err)
+
+	_, err = program.Evaluate(context.Background(), Input{Request: &models.Request{}})
+	assert.Error(t, err)
+}
+
+func TestProgram_EvaluateCostLimit(t *testing.T) {
+	// Each level of nesting multiplies the work by the length of the list
+	program, err := Compile(`user.teams.all(a, user.teams.all(b, user.teams.all(c, a + b + c != "")))`)
+	require.NoError(t, err)
+
+	teams := make([]string, 100)
+	for i := range teams {
+		teams[i] = fmt.Sprintf("team-%d", i)
+	}
+
+	_, err = program.Evaluate(context.Background(), Input{Request: &models.Request{}, User: &models.User{}, Teams: teams})
+	assert.ErrorContains(t, err, "cost limit")
+}
+
+func TestProgram_EvaluateCancelled(t *testing.T) {
+	program, err := Compile(`user.teams.all(a, user.teams.all(b, a != b || a == b))`)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = program.Evaluate(ctx, Input{Request: &models.Request{}, User: &models.User{}, Teams: make([]string, 500)})
+	assert.Error(t, err)
+}
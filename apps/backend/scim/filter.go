@@ -0,0 +1,428 @@
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"sourcestream/backend/repository"
+)
+
+// attributeKind is the SCIM type of a filterable attribute.
+type attributeKind int
+
+const (
+	kindString attributeKind = iota
+	kindBool
+	kindTime
+	// kindMember is a multi-valued reference to users, filterable with eq only.
+	kindMember
+)
+
+// column maps a SCIM attribute path to the SQL expression that holds it.
+type column struct {
+	expr string
+	kind attributeKind
+}
+
+// attributes maps lower-cased SCIM attribute paths to columns. Paths inside a value filter such
+// as emails[type eq "work"] are looked up with the multi-valued attribute as prefix.
+type attributes map[string]column
+
+var userAttributes = attributes{
+	"id":                             {"id::text", kindString},
+	"username":                       {"corporate_id", kindString},
+	"externalid":                     {"external_id", kindString},
+	"displayname":                    {"full_name", kindString},
+	"name.formatted":                 {"full_name", kindString},
+	"emails":                         {"email", kindString},
+	"emails.value":                   {"email", kindString},
+	"emails.type":                    {"'work'", kindString},
+	"emails.primary":                 {"true", kindBool},
+	"active":                         {"is_active", kindBool},
+	"department":                     {"department", kindString},
+	"githubusername":                 {"github_username", kindString},
+	"meta.created":                   {"created_at", kindTime},
+	"meta.lastmodified":              {"updated_at", kindTime},
+	enterpriseKey + ":department":    {"department", kindString},
+	extensionKey + ":githubusername": {"github_username", kindString},
+}
+
+var groupAttributes = attributes{
+	"id":                {"id::text", kindString},
+	"displayname":       {"display_name", kindString},
+	"externalid":        {"external_id", kindString},
+	"members":           {"scim_groups.id", kindMember},
+	"members.value":     {"scim_groups.id", kindMember},
+	"meta.created":      {"created_at", kindTime},
+	"meta.lastmodified": {"updated_at", kindTime},
+}
+
+// filterError is an invalid or unsupported filter, reported with the invalidFilter SCIM type.
+type filterError struct {
+	msg string
+}
+
+func (e *filterError) Error() string {
+	return e.msg
+}
+
+func invalidFilter(format string, args ...any) error {
+	return &filterError{msg: fmt.Sprintf(format, args...)}
+}
+
+// parseFilter translates a SCIM filter (RFC 7644 section 3.4.2.2) into a SQL condition over
+// the given attributes. Attribute names and operators are case-insensitive, and so are string
+// comparisons. An empty filter matches everything.
+func parseFilter(filter string, attrs attributes) (repository.Condition, error) {
+	if strings.TrimSpace(filter) == "" {
+		return repository.Condition{}, nil
+	}
+
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return repository.Condition{}, err
+	}
+
+	p := &filterParser{tokens: tokens, attrs: attrs}
+
+	sql, err := p.parseOr("")
+	if err != nil {
+		return repository.Condition{}, err
+	}
+
+	if !p.done() {
+		return repository.Condition{}, invalidFilter("unexpected %q", p.peek().text)
+	}
+
+	return repository.Condition{SQL: sql, Args: p.args}, nil
+}
+
+type tokenType int
+
+const (
+	tokenWord tokenType = iota
+	tokenString
+	tokenOpenParen
+	tokenCloseParen
+	tokenOpenBracket
+	tokenCloseBracket
+)
+
+type token struct {
+	typ  tokenType
+	text string
+}
+
+func tokenize(filter string) ([]token, error) {
+	var tokens []token
+
+	runes := []rune(filter)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{tokenOpenParen, "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{tokenCloseParen, ")"})
+			i++
+		case r == '[':
+			tokens = append(tokens, token{tokenOpenBracket, "["})
+			i++
+		case r == ']':
+			tokens = append(tokens, token{tokenCloseBracket, "]"})
+			i++
+		case r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				if runes[j] == '\\' {
+					j++
+				}
+				j++
+			}
+
+			if j >= len(runes) {
+				return nil, invalidFilter("unterminated string")
+			}
+
+			value, err := strconv.Unquote(string(runes[i : j+1]))
+			if err != nil {
+				return nil, invalidFilter("invalid string %s", string(runes[i:j+1]))
+			}
+
+			tokens = append(tokens, token{tokenString, value})
+			i = j + 1
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune(`()[]"`, runes[j]) {
+				j++
+			}
+
+			tokens = append(tokens, token{tokenWord, string(runes[i:j])})
+			i = j
+		}
+	}
+
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []token
+	pos    int
+	attrs  attributes
+	args   []any
+}
+
+func (p *filterParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *filterParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) peekWord(word string) bool {
+	return !p.done() && p.peek().typ == tokenWord && strings.EqualFold(p.peek().text, word)
+}
+
+func (p *filterParser) expect(typ tokenType, text string) error {
+	if p.done() || p.peek().typ != typ {
+		return invalidFilter("expected %q", text)
+	}
+
+	p.pos++
+
+	return nil
+}
+
+// parseOr parses "and" terms joined by "or". prefix is the multi-valued attribute inside a
+// value filter, e.g. "emails".
+func (p *filterParser) parseOr(prefix string) (string, error) {
+	left, err := p.parseAnd(prefix)
+	if err != nil {
+		return "", err
+	}
+
+	for p.peekWord("or") {
+		p.pos++
+
+		right, err := p.parseAnd(prefix)
+		if err != nil {
+			return "", err
+		}
+
+		left = fmt.Sprintf("(%s OR %s)", left, right)
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd(prefix string) (string, error) {
+	left, err := p.parseTerm(prefix)
+	if err != nil {
+		return "", err
+	}
+
+	for p.peekWord("and") {
+		p.pos++
+
+		right, err := p.parseTerm(prefix)
+		if err != nil {
+			return "", err
+		}
+
+		left = fmt.Sprintf("(%s AND %s)", left, right)
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseTerm(prefix string) (string, error) {
+	if p.done() {
+		return "", invalidFilter("unexpected end of filter")
+	}
+
+	negate := false
+	if p.peekWord("not") {
+		p.pos++
+		negate = true
+	}
+
+	if !p.done() && p.peek().typ == tokenOpenParen {
+		p.pos++
+
+		inner, err := p.parseOr(prefix)
+		if err != nil {
+			return "", err
+		}
+
+		if err := p.expect(tokenCloseParen, ")"); err != nil {
+			return "", err
+		}
+
+		if negate {
+			return fmt.Sprintf("NOT COALESCE(%s, false)", inner), nil
+		}
+
+		return inner, nil
+	}
+
+	if negate {
+		return "", invalidFilter("not must be followed by a parenthesized filter")
+	}
+
+	return p.parseComparison(prefix)
+}
+
+func (p *filterParser) parseComparison(prefix string) (string, error) {
+	if p.peek().typ != tokenWord {
+		return "", invalidFilter("expected an attribute, got %q", p.peek().text)
+	}
+
+	path := strings.ToLower(p.peek().text)
+	p.pos++
+
+	if prefix != "" {
+		path = prefix + "." + path
+	}
+
+	// A value filter, e.g. emails[type eq "work"]
+	if !p.done() && p.peek().typ == tokenOpenBracket {
+		if prefix != "" {
+			return "", invalidFilter("nested value filters are not supported")
+		}
+
+		p.pos++
+
+		inner, err := p.parseOr(path)
+		if err != nil {
+			return "", err
+		}
+
+		if err := p.expect(tokenCloseBracket, "]"); err != nil {
+			return "", err
+		}
+
+		return inner, nil
+	}
+
+	col, ok := p.attrs[path]
+	if !ok {
+		return "", invalidFilter("unsupported attribute %q", path)
+	}
+
+	if p.done() || p.peek().typ != tokenWord {
+		return "", invalidFilter("expected an operator after %q", path)
+	}
+
+	op := strings.ToLower(p.peek().text)
+	p.pos++
+
+	if op == "pr" {
+		return presentSQL(col), nil
+	}
+
+	if p.done() {
+		return "", invalidFilter("expected a value after %q", op)
+	}
+
+	value := p.peek()
+	p.pos++
+
+	return p.compareSQL(path, col, op, value)
+}
+
+func presentSQL(col column) string {
+	switch col.kind {
+	case kindString:
+		return fmt.Sprintf("(%s IS NOT NULL AND %s <> '')", col.expr, col.expr)
+	case kindMember:
+		return fmt.Sprintf("EXISTS (SELECT 1 FROM scim_group_members m WHERE m.group_id = %s)", col.expr)
+	default:
+		return fmt.Sprintf("(%s IS NOT NULL)", col.expr)
+	}
+}
+
+var comparisonOperators = map[string]string{"eq": "=", "ne": "<>", "gt": ">", "ge": ">=", "lt": "<", "le": "<="}
+
+func (p *filterParser) compareSQL(path string, col column, op string, value token) (string, error) {
+	param := func(v any) string {
+		p.args = append(p.args, v)
+		return fmt.Sprintf("$%d", len(p.args))
+	}
+
+	switch col.kind {
+	case kindMember:
+		if op != "eq" || value.typ != tokenString {
+			return "", invalidFilter("%s supports only eq with a user ID", path)
+		}
+
+		return fmt.Sprintf("EXISTS (SELECT 1 FROM scim_group_members m WHERE m.group_id = %s AND m.user_id::text = %s)",
+			col.expr, param(value.text)), nil
+
+	case kindBool:
+		if op != "eq" && op != "ne" {
+			return "", invalidFilter("%s supports only eq and ne", path)
+		}
+
+		b, err := strconv.ParseBool(strings.ToLower(value.text))
+		if value.typ != tokenWord || err != nil {
+			return "", invalidFilter("%s must be compared with true or false", path)
+		}
+
+		if op == "ne" {
+			return fmt.Sprintf("(%s IS DISTINCT FROM %s)", col.expr, param(b)), nil
+		}
+
+		return fmt.Sprintf("(%s = %s)", col.expr, param(b)), nil
+
+	case kindTime:
+		sqlOp, ok := comparisonOperators[op]
+		if !ok {
+			return "", invalidFilter("%s does not support %s", path, op)
+		}
+
+		t, err := time.Parse(time.RFC3339, value.text)
+		if value.typ != tokenString || err != nil {
+			return "", invalidFilter("%s must be compared with an RFC 3339 date-time", path)
+		}
+
+		return fmt.Sprintf("(%s %s %s)", col.expr, sqlOp, param(t)), nil
+	}
+
+	if value.typ != tokenString {
+		return "", invalidFilter("%s must be compared with a string", path)
+	}
+
+	switch op {
+	case "eq":
+		return fmt.Sprintf("(LOWER(%s) = LOWER(%s))", col.expr, param(value.text)), nil
+	case "ne":
+		return fmt.Sprintf("(%s IS NULL OR LOWER(%s) <> LOWER(%s))", col.expr, col.expr, param(value.text)), nil
+	case "co":
+		return fmt.Sprintf("(%s ILIKE %s)", col.expr, param("%"+escapeLike(value.text)+"%")), nil
+	case "sw":
+		return fmt.Sprintf("(%s ILIKE %s)", col.expr, param(escapeLike(value.text)+"%")), nil
+	case "ew":
+		return fmt.Sprintf("(%s ILIKE %s)", col.expr, param("%"+escapeLike(value.text))), nil
+	}
+
+	sqlOp, ok := comparisonOperators[op]
+	if !ok {
+		return "", invalidFilter("unsupported operator %q", op)
+	}
+
+	return fmt.Sprintf("(LOWER(%s) %s LOWER(%s))", col.expr, sqlOp, param(value.text)), nil
+}
+
+// escapeLike escapes the LIKE wildcards in a value so that it matches literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -0,0 +1,320 @@
+package scim
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"sourcestream/backend/models"
+
+	"github.com/google/uuid"
+)
+
+type groupResource struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []reference `json:"members,omitempty"`
+	Meta        meta        `json:"meta"`
+}
+
+func toGroupResource(group *models.SCIMGroup, base string) *groupResource {
+	resource := &groupResource{
+		Schemas:     []string{GroupSchema},
+		ID:          group.ID,
+		ExternalID:  group.ExternalID,
+		DisplayName: group.DisplayName,
+		Meta: meta{
+			ResourceType: "Group",
+			Created:      group.CreatedAt.Format(time.RFC3339),
+			LastModified: group.UpdatedAt.Format(time.RFC3339),
+			Location:     base + "/Groups/" + group.ID,
+		},
+	}
+
+	for _, member := range group.Members {
+		resource.Members = append(resource.Members, reference{
+			Value: member.UserID, Display: member.FullName, Ref: base + "/Users/" + member.UserID,
+		})
+	}
+
+	return resource
+}
+
+// memberFilterPath matches a path selecting one member, e.g. members[value eq "2819c223-..."].
+var memberFilterPath = regexp.MustCompile(`(?i)^members\[\s*value\s+eq\s+"([^"]+)"\s*\]$`)
+
+// groupEdit collects changes to a group. members is nil unless the member list is replaced;
+// add and remove are applied after any replacement.
+type groupEdit struct {
+	group   *models.SCIMGroup
+	members []string
+	add     []string
+	remove  []string
+}
+
+// set applies an operation to the attribute at path, which is not lower-cased so that member IDs
+// in a value filter are kept intact.
+func (e *groupEdit) set(op, path string, value any) error {
+	if m := memberFilterPath.FindStringSubmatch(path); m != nil {
+		if op != opRemove {
+			return badRequest("invalidPath", "members can only be removed by value filter")
+		}
+
+		e.remove = append(e.remove, m[1])
+
+		return nil
+	}
+
+	switch strings.ToLower(path) {
+	case "schemas", "id", "meta":
+		return nil
+	case "displayname":
+		if op == opRemove {
+			return badRequest("mutability", "displayName is required")
+		}
+
+		return setString(&e.group.DisplayName, "displayName", value)
+	case "externalid":
+		return setString(&e.group.ExternalID, "externalId", value)
+	case "members":
+		ids, err := memberValues(value)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case op == opAdd:
+			e.add = append(e.add, ids...)
+		case op == opReplace:
+			e.members, e.add, e.remove = ids, nil, nil
+		case value == nil:
+			e.members, e.add, e.remove = []string{}, nil, nil
+		default:
+			e.remove = append(e.remove, ids...)
+		}
+
+		return nil
+	}
+
+	return badRequest("invalidPath", fmt.Sprintf("unsupported group attribute %q", path))
+}
+
+func (e *groupEdit) setAll(op string, body map[string]any) error {
+	for key, value := range body {
+		if err := e.set(op, key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *groupEdit) finish() error {
+	e.group.DisplayName = strings.TrimSpace(e.group.DisplayName)
+	if e.group.DisplayName == "" {
+		return badRequest("invalidValue", "displayName is required")
+	}
+
+	return nil
+}
+
+// memberValues reads the user IDs from a list of member references.
+func memberValues(value any) ([]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	list, ok := value.([]any)
+	if !ok {
+		return nil, badRequest("invalidValue", "members must be a list")
+	}
+
+	ids := make([]string, 0, len(list))
+
+	for _, item := range list {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			return nil, badRequest("invalidValue", "members must be a list of objects")
+		}
+
+		id, _ := entry["value"].(string)
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, badRequest("invalidValue", fmt.Sprintf("member %q is not a user ID", id))
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) error {
+	cond, err := parseFilter(r.URL.Query().Get("filter"), groupAttributes)
+	if err != nil {
+		return err
+	}
+
+	limit, offset, startIndex := pageParams(r)
+
+	groups, total, err := s.groups.FindGroups(r.Context(), cond, limit, offset, !excluded(r, "members"))
+	if err != nil {
+		return err
+	}
+
+	base := baseURL(r)
+	resources := make([]any, len(groups))
+
+	for i, group := range groups {
+		resources[i] = toGroupResource(group, base)
+	}
+
+	writeJSON(w, http.StatusOK, &listResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: total,
+		ItemsPerPage: len(resources),
+		StartIndex:   startIndex,
+		Resources:    resources,
+	})
+
+	return nil
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) error {
+	var body map[string]any
+	if err := readJSON(r, &body); err != nil {
+		return err
+	}
+
+	edit := &groupEdit{group: &models.SCIMGroup{}}
+	if err := edit.setAll(opReplace, body); err != nil {
+		return err
+	}
+
+	if err := edit.finish(); err != nil {
+		return err
+	}
+
+	for _, id := range edit.members {
+		edit.group.Members = append(edit.group.Members, &models.SCIMGroupMember{UserID: id})
+	}
+
+	if err := s.groups.CreateGroup(r.Context(), edit.group); err != nil {
+		return err
+	}
+
+	return s.writeGroup(w, r, http.StatusCreated, edit.group.ID)
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request) error {
+	group, err := s.lookupGroup(r, !excluded(r, "members"))
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, toGroupResource(group, baseURL(r)))
+
+	return nil
+}
+
+func (s *Server) replaceGroup(w http.ResponseWriter, r *http.Request) error {
+	group, err := s.lookupGroup(r, false)
+	if err != nil {
+		return err
+	}
+
+	var body map[string]any
+	if err := readJSON(r, &body); err != nil {
+		return err
+	}
+
+	group.ExternalID = ""
+
+	edit := &groupEdit{group: group, members: []string{}}
+	if err := edit.setAll(opReplace, body); err != nil {
+		return err
+	}
+
+	return s.saveGroup(w, r, edit)
+}
+
+// patchGroup applies a PatchOp, which identity providers mostly use to add and remove members.
+func (s *Server) patchGroup(w http.ResponseWriter, r *http.Request) error {
+	group, err := s.lookupGroup(r, false)
+	if err != nil {
+		return err
+	}
+
+	ops, err := readPatch(r)
+	if err != nil {
+		return err
+	}
+
+	edit := &groupEdit{group: group}
+
+	for _, op := range ops {
+		if op.Path != "" {
+			err = edit.set(op.Op, op.Path, op.Value)
+		} else if object, ok := op.Value.(map[string]any); ok {
+			err = edit.setAll(op.Op, object)
+		} else {
+			err = badRequest("invalidValue", "an operation without a path needs an object value")
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.saveGroup(w, r, edit)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request) error {
+	group, err := s.lookupGroup(r, false)
+	if err != nil {
+		return err
+	}
+
+	if err := s.groups.DeleteGroup(r.Context(), group.ID); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+func (s *Server) lookupGroup(r *http.Request, withMembers bool) (*models.SCIMGroup, error) {
+	id := r.PathValue("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, &scimError{status: http.StatusNotFound, detail: fmt.Sprintf("group %s not found", id)}
+	}
+
+	return s.groups.GetGroup(r.Context(), id, withMembers)
+}
+
+func (s *Server) saveGroup(w http.ResponseWriter, r *http.Request, edit *groupEdit) error {
+	if err := edit.finish(); err != nil {
+		return err
+	}
+
+	if err := s.groups.UpdateGroup(r.Context(), edit.group, edit.members, edit.add, edit.remove); err != nil {
+		return err
+	}
+
+	return s.writeGroup(w, r, http.StatusOK, edit.group.ID)
+}
+
+func (s *Server) writeGroup(w http.ResponseWriter, r *http.Request, status int, id string) error {
+	group, err := s.groups.GetGroup(r.Context(), id, true)
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, status, toGroupResource(group, baseURL(r)))
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package scim
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Patch operations (RFC 7644 section 3.5.2).
+const (
+	opAdd     = "add"
+	opReplace = "replace"
+	opRemove  = "remove"
+)
+
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+// patchOperation is one operation of a PatchOp request. Op is lower-cased on reading since some
+// identity providers capitalize it.
+type patchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+func readPatch(r *http.Request) ([]patchOperation, error) {
+	var req patchRequest
+	if err := readJSON(r, &req); err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(req.Schemas, PatchOpSchema) {
+		return nil, badRequest("invalidValue", "schemas must contain "+PatchOpSchema)
+	}
+
+	for i := range req.Operations {
+		op := &req.Operations[i]
+		op.Op = strings.ToLower(op.Op)
+
+		switch op.Op {
+		case opAdd, opReplace:
+		case opRemove:
+			if op.Path == "" {
+				return nil, badRequest("noTarget", "remove requires a path")
+			}
+		default:
+			return nil, badRequest("invalidValue", "unsupported patch operation "+op.Op)
+		}
+	}
+
+	return req.Operations, nil
+}
+
+// apply applies an operation on single-valued attributes, for which add and replace are the
+// same: set sets the attribute at a lower-cased path and setAll applies a whole object.
+func (op patchOperation) apply(set func(path string, value any) error, setAll func(map[string]any) error) error {
+	if op.Op == opRemove {
+		return set(strings.ToLower(op.Path), nil)
+	}
+
+	if op.Path != "" {
+		return set(strings.ToLower(op.Path), op.Value)
+	}
+
+	object, ok := op.Value.(map[string]any)
+	if !ok {
+		return badRequest("invalidValue", "an operation without a path needs an object value")
+	}
+
+	return setAll(object)
+}
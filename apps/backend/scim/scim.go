@@ -0,0 +1,286 @@
+// Package scim serves a SCIM 2.0 (RFC 7643, RFC 7644) provisioning endpoint so that the corporate
+// identity provider can create, update and deactivate SourceStream users and maintain groups.
+// Deactivating a user offboards them.
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sourcestream/backend/config"
+	"sourcestream/backend/models"
+	"sourcestream/backend/offboarding"
+	"sourcestream/backend/repository"
+)
+
+// PathPrefix is where the server is mounted, e.g. /scim/v2/Users.
+const PathPrefix = "/scim/v2/"
+
+// Schema URNs.
+const (
+	UserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	EnterpriseUserSchema        = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	ExtensionSchema             = "urn:sourcestream:params:scim:schemas:extension:2.0:User"
+	ListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	ServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+// Lower-cased extension URNs, used as attribute path prefixes.
+var (
+	enterpriseKey = strings.ToLower(EnterpriseUserSchema)
+	extensionKey  = strings.ToLower(ExtensionSchema)
+)
+
+const (
+	contentType     = "application/scim+json"
+	maxRequestBytes = 1 << 20
+	defaultCount    = 100
+	maxCount        = 500
+)
+
+// UserStore persists provisioned users.
+type UserStore interface {
+	CreateUser(ctx context.Context, user *models.User) error
+	GetUserByID(ctx context.Context, id string) (*models.User, error)
+	UpdateUser(ctx context.Context, user *models.User) error
+	FindUsers(ctx context.Context, cond repository.Condition, limit, offset int) ([]*models.User, int, error)
+}
+
+// GroupStore persists provisioned groups.
+type GroupStore interface {
+	CreateGroup(ctx context.Context, group *models.SCIMGroup) error
+	GetGroup(ctx context.Context, id string, withMembers bool) (*models.SCIMGroup, error)
+	FindGroups(ctx context.Context, cond repository.Condition, limit, offset int, withMembers bool) ([]*models.SCIMGroup, int, error)
+	UpdateGroup(ctx context.Context, group *models.SCIMGroup, members, add, remove []string) error
+	DeleteGroup(ctx context.Context, id string) error
+	GetUserGroups(ctx context.Context, userID string) ([]*models.SCIMGroup, error)
+}
+
+// Offboarder offboards users the identity provider deactivates.
+type Offboarder interface {
+	Offboard(ctx context.Context, req offboarding.Request) (*models.Offboarding, error)
+}
+
+// Server is the http.Handler for the SCIM endpoint.
+type Server struct {
+	users      UserStore
+	groups     GroupStore
+	offboarder Offboarder
+	token      string
+	mux        *http.ServeMux
+}
+
+// NewServer creates a Server backed by the user and SCIM group repositories.
+func NewServer(db *sql.DB, cfg *config.SCIMConfig) *Server {
+	return newServer(repository.NewUserRepository(db), repository.NewSCIMGroupRepository(db),
+		offboarding.NewOffboarder(db), cfg)
+}
+
+func newServer(users UserStore, groups GroupStore, offboarder Offboarder, cfg *config.SCIMConfig) *Server {
+	s := &Server{users: users, groups: groups, offboarder: offboarder, token: cfg.Token}
+
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("GET "+PathPrefix+"ServiceProviderConfig", s.handle(s.serviceProviderConfig))
+	s.mux.HandleFunc("GET "+PathPrefix+"Users", s.handle(s.listUsers))
+	s.mux.HandleFunc("POST "+PathPrefix+"Users", s.handle(s.createUser))
+	s.mux.HandleFunc("GET "+PathPrefix+"Users/{id}", s.handle(s.getUser))
+	s.mux.HandleFunc("PUT "+PathPrefix+"Users/{id}", s.handle(s.replaceUser))
+	s.mux.HandleFunc("PATCH "+PathPrefix+"Users/{id}", s.handle(s.patchUser))
+	s.mux.HandleFunc("DELETE "+PathPrefix+"Users/{id}", s.handle(s.deleteUser))
+	s.mux.HandleFunc("GET "+PathPrefix+"Groups", s.handle(s.listGroups))
+	s.mux.HandleFunc("POST "+PathPrefix+"Groups", s.handle(s.createGroup))
+	s.mux.HandleFunc("GET "+PathPrefix+"Groups/{id}", s.handle(s.getGroup))
+	s.mux.HandleFunc("PUT "+PathPrefix+"Groups/{id}", s.handle(s.replaceGroup))
+	s.mux.HandleFunc("PATCH "+PathPrefix+"Groups/{id}", s.handle(s.patchGroup))
+	s.mux.HandleFunc("DELETE "+PathPrefix+"Groups/{id}", s.handle(s.deleteGroup))
+
+	return s
+}
+
+// ServeHTTP authenticates the identity provider's bearer token and serves the request. The
+// endpoint does not exist unless a token is configured.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.token == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(strings.TrimSpace(token)), []byte(s.token)) != 1 {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+		writeError(w, &scimError{status: http.StatusUnauthorized, detail: "invalid bearer token"})
+
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// scimError is an error response (RFC 7644 section 3.12).
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func badRequest(scimType, detail string) error {
+	return &scimError{status: http.StatusBadRequest, scimType: scimType, detail: detail}
+}
+
+func (s *Server) handle(fn func(w http.ResponseWriter, r *http.Request) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := fn(w, r); err != nil {
+			writeError(w, err)
+		}
+	}
+}
+
+// writeError answers with the SCIM error for err; unexpected errors are logged and hidden.
+func writeError(w http.ResponseWriter, err error) {
+	var (
+		scimErr   *scimError
+		filterErr *filterError
+		duplicate *repository.DuplicateUserError
+	)
+
+	switch {
+	case errors.As(err, &scimErr):
+	case errors.As(err, &filterErr):
+		scimErr = &scimError{status: http.StatusBadRequest, scimType: "invalidFilter", detail: filterErr.msg}
+	case errors.Is(err, repository.ErrUserNotFound), errors.Is(err, repository.ErrSCIMGroupNotFound):
+		scimErr = &scimError{status: http.StatusNotFound, detail: "resource not found"}
+	case errors.As(err, &duplicate):
+		scimErr = &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: duplicate.Error()}
+	case errors.Is(err, repository.ErrDuplicateSCIMGroup):
+		scimErr = &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: err.Error()}
+	case errors.Is(err, repository.ErrUnknownSCIMGroupMember):
+		scimErr = &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: err.Error()}
+	default:
+		log.Printf("scim: %v", err)
+
+		scimErr = &scimError{status: http.StatusInternalServerError, detail: "internal error"}
+	}
+
+	writeJSON(w, scimErr.status, map[string]any{
+		"schemas":  []string{ErrorSchema},
+		"status":   strconv.Itoa(scimErr.status),
+		"scimType": scimErr.scimType,
+		"detail":   scimErr.detail,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("scim: failed to write response: %v", err)
+	}
+}
+
+// readJSON decodes a request body into v.
+func readJSON(r *http.Request, v any) error {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBytes))
+	if err != nil {
+		return badRequest("invalidSyntax", "failed to read request body")
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return badRequest("invalidSyntax", "request body is not valid JSON")
+	}
+
+	return nil
+}
+
+// listResponse is a page of resources (RFC 7644 section 3.4.2).
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	StartIndex   int      `json:"startIndex"`
+	Resources    []any    `json:"Resources"`
+}
+
+// pageParams reads the 1-based startIndex and count query parameters.
+func pageParams(r *http.Request) (limit, offset, startIndex int) {
+	startIndex, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+
+	limit, err = strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || limit < 0 {
+		limit = defaultCount
+	}
+
+	return min(limit, maxCount), startIndex - 1, startIndex
+}
+
+// excluded reports whether the excludedAttributes query parameter names attr.
+func excluded(r *http.Request, attr string) bool {
+	for _, name := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(name), attr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// baseURL is the absolute URL of the SCIM endpoint as the client addressed it.
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+
+	return scheme + "://" + r.Host + strings.TrimSuffix(PathPrefix, "/")
+}
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created"`
+	LastModified string `json:"lastModified"`
+	Location     string `json:"location"`
+}
+
+func (s *Server) serviceProviderConfig(w http.ResponseWriter, _ *http.Request) error {
+	supported := func(v bool) map[string]bool { return map[string]bool{"supported": v} }
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{ServiceProviderConfigSchema},
+		"patch":          supported(true),
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxCount},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer token",
+			"description": "A static bearer token shared with the identity provider",
+			"primary":     true,
+		}},
+	})
+
+	return nil
+}
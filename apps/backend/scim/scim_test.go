@@ -0,0 +1,401 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sourcestream/backend/config"
+	"sourcestream/backend/models"
+	"sourcestream/backend/offboarding"
+	"sourcestream/backend/repository"
+)
+
+const (
+	testToken  = "scim-t0ken"
+	aliceID    = "6f1c3a58-3f0e-4a83-9d5c-3b0f0e3a9c01"
+	bobID      = "6f1c3a58-3f0e-4a83-9d5c-3b0f0e3a9c02"
+	platformID = "9a7e2b4c-1d3f-4e5a-8b6c-7d8e9f0a1b2c"
+)
+
+// fakeUserStore ignores filter conditions; filter translation is tested separately.
+type fakeUserStore struct {
+	users map[string]*models.User
+}
+
+func (f *fakeUserStore) CreateUser(_ context.Context, user *models.User) error {
+	for _, existing := range f.users {
+		if existing.CorporateID == user.CorporateID {
+			return &repository.DuplicateUserError{Field: "corporate_id"}
+		}
+	}
+
+	f.users[user.ID] = user
+
+	return nil
+}
+
+func (f *fakeUserStore) GetUserByID(_ context.Context, id string) (*models.User, error) {
+	user, ok := f.users[id]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+
+	copied := *user
+
+	return &copied, nil
+}
+
+func (f *fakeUserStore) UpdateUser(_ context.Context, user *models.User) error {
+	f.users[user.ID] = user
+	return nil
+}
+
+func (f *fakeUserStore) FindUsers(_ context.Context, _ repository.Condition, limit, offset int) ([]*models.User, int, error) {
+	var users []*models.User
+	for _, user := range f.users {
+		users = append(users, user)
+	}
+
+	slices.SortFunc(users, func(a, b *models.User) int { return strings.Compare(a.ID, b.ID) })
+
+	total := len(users)
+	users = users[min(offset, total):min(offset+limit, total)]
+
+	return users, total, nil
+}
+
+// fakeOffboarder records offboardings and deactivates the user, as the real offboarding does.
+type fakeOffboarder struct {
+	users    *fakeUserStore
+	requests []offboarding.Request
+	err      error
+}
+
+func (f *fakeOffboarder) Offboard(_ context.Context, req offboarding.Request) (*models.Offboarding, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.requests = append(f.requests, req)
+	f.users.users[req.UserID].IsActive = false
+
+	return &models.Offboarding{UserID: req.UserID, Source: req.Source}, nil
+}
+
+type fakeGroupStore struct {
+	groups map[string]*models.SCIMGroup
+}
+
+func (f *fakeGroupStore) CreateGroup(_ context.Context, group *models.SCIMGroup) error {
+	group.ID = platformID
+	f.groups[group.ID] = group
+
+	return nil
+}
+
+func (f *fakeGroupStore) GetGroup(_ context.Context, id string, _ bool) (*models.SCIMGroup, error) {
+	group, ok := f.groups[id]
+	if !ok {
+		return nil, repository.ErrSCIMGroupNotFound
+	}
+
+	copied := *group
+
+	return &copied, nil
+}
+
+func (f *fakeGroupStore) FindGroups(_ context.Context, _ repository.Condition, _, _ int, _ bool) ([]*models.SCIMGroup, int, error) {
+	var groups []*models.SCIMGroup
+	for _, group := range f.groups {
+		groups = append(groups, group)
+	}
+
+	return groups, len(groups), nil
+}
+
+func (f *fakeGroupStore) UpdateGroup(_ context.Context, group *models.SCIMGroup, members, add, remove []string) error {
+	ids := memberIDs(f.groups[group.ID].Members)
+	if members != nil {
+		ids = members
+	}
+
+	ids = append(ids, add...)
+	ids = slices.DeleteFunc(ids, func(id string) bool { return slices.Contains(remove, id) })
+
+	group.Members = nil
+	for _, id := range ids {
+		group.Members = append(group.Members, &models.SCIMGroupMember{UserID: id})
+	}
+
+	f.groups[group.ID] = group
+
+	return nil
+}
+
+func (f *fakeGroupStore) DeleteGroup(_ context.Context, id string) error {
+	delete(f.groups, id)
+	return nil
+}
+
+func (f *fakeGroupStore) GetUserGroups(_ context.Context, userID string) ([]*models.SCIMGroup, error) {
+	var groups []*models.SCIMGroup
+
+	for _, group := range f.groups {
+		if slices.Contains(memberIDs(group.Members), userID) {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups, nil
+}
+
+func memberIDs(members []*models.SCIMGroupMember) []string {
+	var ids []string
+	for _, member := range members {
+		ids = append(ids, member.UserID)
+	}
+
+	return ids
+}
+
+func newTestServer() (*Server, *fakeUserStore, *fakeGroupStore) {
+	users := &fakeUserStore{users: map[string]*models.User{
+		aliceID: {
+			ID: aliceID, CorporateID: "alice", GithubUsername: "alice-gh", Email: "alice@example.com",
+			FullName: "Alice Smith", Department: "Platform", Role: "contributor", IsActive: true,
+		},
+	}}
+	groups := &fakeGroupStore{groups: map[string]*models.SCIMGroup{}}
+
+	offboarder := &fakeOffboarder{users: users}
+
+	return newServer(users, groups, offboarder, &config.SCIMConfig{Token: testToken}), users, groups
+}
+
+func do(t *testing.T, s *Server, method, path, body string) (*httptest.ResponseRecorder, map[string]any) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	req.Header.Set("Content-Type", contentType)
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	var decoded map[string]any
+	if rec.Body.Len() > 0 {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &decoded), rec.Body.String())
+	}
+
+	return rec, decoded
+}
+
+func TestServer_Authentication(t *testing.T) {
+	s, _, _ := newTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/scim/v2/Users", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), ErrorSchema)
+
+	disabled := newServer(&fakeUserStore{}, &fakeGroupStore{}, &fakeOffboarder{}, &config.SCIMConfig{})
+	rec = httptest.NewRecorder()
+	disabled.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/scim/v2/Users", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestServer_CreateUser(t *testing.T) {
+	s, users, _ := newTestServer()
+
+	rec, body := do(t, s, http.MethodPost, "/scim/v2/Users", `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User", "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"],
+		"userName": "bob",
+		"externalId": "00u1abcd",
+		"name": {"givenName": "Bob", "familyName": "Jones"},
+		"emails": [{"value": "bob.home@example.net", "type": "home"}, {"value": "bob@example.com", "type": "work", "primary": true}],
+		"phoneNumbers": [{"value": "555-0100"}],
+		"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": {"department": "Sales"}
+	}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	user := users.users[body["id"].(string)]
+	require.NotNil(t, user)
+	assert.Equal(t, "bob", user.CorporateID)
+	assert.Equal(t, "00u1abcd", user.ExternalID)
+	assert.Equal(t, "Bob Jones", user.FullName)
+	assert.Equal(t, "bob@example.com", user.Email)
+	assert.Equal(t, "Sales", user.Department)
+	assert.Empty(t, user.GithubUsername)
+	assert.True(t, user.IsActive)
+	assert.Equal(t, "contributor", user.Role)
+
+	rec, body = do(t, s, http.MethodPost, "/scim/v2/Users", `{"userName": "alice", "emails": [{"value": "a2@example.com"}]}`)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, "uniqueness", body["scimType"])
+
+	rec, body = do(t, s, http.MethodPost, "/scim/v2/Users", `{"userName": "carol"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "invalidValue", body["scimType"])
+}
+
+func TestServer_PatchUser(t *testing.T) {
+	s, users, _ := newTestServer()
+
+	// Azure AD style: capitalized ops, string booleans and dotted keys in a path-less value
+	rec, body := do(t, s, http.MethodPatch, "/scim/v2/Users/"+aliceID, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "active", "value": "False"},
+			{"op": "Replace", "value": {"name.familyName": "Jones", "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department": "Legal"}},
+			{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "alice.jones@example.com"},
+			{"op": "remove", "path": "externalId"}
+		]
+	}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, false, body["active"])
+
+	alice := users.users[aliceID]
+	assert.False(t, alice.IsActive)
+	assert.Equal(t, "Alice Jones", alice.FullName)
+	assert.Equal(t, "Legal", alice.Department)
+	assert.Equal(t, "alice.jones@example.com", alice.Email)
+	assert.Equal(t, "alice-gh", alice.GithubUsername)
+
+	offboarder := s.offboarder.(*fakeOffboarder)
+	require.Len(t, offboarder.requests, 1)
+	assert.Equal(t, repository.OffboardingSourceSCIM, offboarder.requests[0].Source)
+
+	rec, _ = do(t, s, http.MethodPatch, "/scim/v2/Users/"+aliceID, `{"Operations": [{"op": "replace", "path": "active", "value": true}]}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, _ = do(t, s, http.MethodPatch, "/scim/v2/Users/"+bobID, `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": []}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestServer_DeleteUserDeactivates(t *testing.T) {
+	s, users, _ := newTestServer()
+
+	rec, _ := do(t, s, http.MethodDelete, "/scim/v2/Users/"+aliceID, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.False(t, users.users[aliceID].IsActive)
+	assert.Len(t, s.offboarder.(*fakeOffboarder).requests, 1)
+
+	// Already deactivated
+	rec, _ = do(t, s, http.MethodDelete, "/scim/v2/Users/"+aliceID, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Len(t, s.offboarder.(*fakeOffboarder).requests, 1)
+}
+
+func TestServer_OffboardingFailureLeavesUserActive(t *testing.T) {
+	s, users, _ := newTestServer()
+	s.offboarder.(*fakeOffboarder).err = errors.New("connection reset")
+
+	rec, _ := do(t, s, http.MethodPatch, "/scim/v2/Users/"+aliceID, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "replace", "path": "active", "value": false}]
+	}`)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.True(t, users.users[aliceID].IsActive)
+}
+
+func TestServer_ListUsersPaging(t *testing.T) {
+	s, users, _ := newTestServer()
+	users.users[bobID] = &models.User{ID: bobID, CorporateID: "bob", Email: "bob@example.com", FullName: "Bob"}
+
+	rec, body := do(t, s, http.MethodGet, "/scim/v2/Users?startIndex=2&count=1", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.EqualValues(t, 2, body["totalResults"])
+	assert.EqualValues(t, 1, body["itemsPerPage"])
+	assert.EqualValues(t, 2, body["startIndex"])
+
+	rec, body = do(t, s, http.MethodGet, `/scim/v2/Users?filter=`+strings.ReplaceAll(`userName eq`, " ", "+"), "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "invalidFilter", body["scimType"])
+}
+
+func TestServer_GroupMembership(t *testing.T) {
+	s, users, groups := newTestServer()
+	users.users[bobID] = &models.User{ID: bobID, CorporateID: "bob", Email: "bob@example.com", FullName: "Bob"}
+
+	rec, _ := do(t, s, http.MethodPost, "/scim/v2/Groups", `{"displayName": "Platform", "members": [{"value": "`+aliceID+`"}]}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec, _ = do(t, s, http.MethodPatch, "/scim/v2/Groups/"+platformID, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "add", "path": "members", "value": [{"value": "`+bobID+`"}]},
+			{"op": "remove", "path": "members[value eq \"`+aliceID+`\"]"}
+		]
+	}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, []string{bobID}, memberIDs(groups.groups[platformID].Members))
+
+	_, body := do(t, s, http.MethodGet, "/scim/v2/Users/"+bobID, "")
+	require.Len(t, body["groups"], 1)
+	assert.Equal(t, "Platform", body["groups"].([]any)[0].(map[string]any)["display"])
+
+	rec, _ = do(t, s, http.MethodPatch, "/scim/v2/Groups/"+platformID, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "add", "path": "members", "value": [{"value": "not-a-user"}]}]
+	}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, _ = do(t, s, http.MethodDelete, "/scim/v2/Groups/"+platformID, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, groups.groups)
+}
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		sql    string
+		args   []any
+	}{
+		{`userName eq "Alice"`, `(LOWER(corporate_id) = LOWER($1))`, []any{"Alice"}},
+		{`externalId eq "00u1" and active eq true`, `((LOWER(external_id) = LOWER($1)) AND (is_active = $2))`, []any{"00u1", true}},
+		{`emails[type eq "work" and value co "@example.com"]`, `((LOWER('work') = LOWER($1)) AND (email ILIKE $2))`, []any{"work", "%@example.com%"}},
+		{`displayName sw "A_" or not (department pr)`, `((full_name ILIKE $1) OR NOT COALESCE((department IS NOT NULL AND department <> ''), false))`, []any{`A\_%`}},
+		{`urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department EQ "Sales"`, `(LOWER(department) = LOWER($1))`, []any{"Sales"}},
+	}
+
+	for _, tt := range tests {
+		cond, err := parseFilter(tt.filter, userAttributes)
+		require.NoError(t, err, tt.filter)
+		assert.Equal(t, tt.sql, cond.SQL, tt.filter)
+		assert.Equal(t, tt.args, cond.Args, tt.filter)
+	}
+
+	cond, err := parseFilter(`members eq "`+aliceID+`"`, groupAttributes)
+	require.NoError(t, err)
+	assert.Contains(t, cond.SQL, "m.user_id::text = $1")
+
+	for _, filter := range []string{
+		`userName eq`,
+		`password eq "x"`,
+		`active eq "yes"`,
+		`userName eq "a" and`,
+		`(userName eq "a"`,
+		`userName xx "a"`,
+		`meta.created gt "yesterday"`,
+		`members co "a"`,
+	} {
+		attrs := userAttributes
+		if strings.HasPrefix(filter, "members") {
+			attrs = groupAttributes
+		}
+
+		_, err := parseFilter(filter, attrs)
+		assert.Error(t, err, filter)
+	}
+}
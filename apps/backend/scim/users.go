@@ -0,0 +1,474 @@
+package scim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"sourcestream/backend/models"
+	"sourcestream/backend/offboarding"
+	"sourcestream/backend/repository"
+
+	"github.com/google/uuid"
+)
+
+type userResource struct {
+	Schemas     []string          `json:"schemas"`
+	ID          string            `json:"id"`
+	ExternalID  string            `json:"externalId,omitempty"`
+	UserName    string            `json:"userName"`
+	Name        map[string]string `json:"name"`
+	DisplayName string            `json:"displayName"`
+	Emails      []email           `json:"emails"`
+	Active      bool              `json:"active"`
+	Groups      []reference       `json:"groups,omitempty"`
+	Enterprise  *enterpriseUser   `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	Extension   sourceStreamUser  `json:"urn:sourcestream:params:scim:schemas:extension:2.0:User"`
+	Meta        meta              `json:"meta"`
+}
+
+type email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type"`
+	Primary bool   `json:"primary"`
+}
+
+type enterpriseUser struct {
+	Department string `json:"department,omitempty"`
+}
+
+// sourceStreamUser carries the SourceStream attributes that SCIM has no standard place for.
+// The role is read-only over SCIM.
+type sourceStreamUser struct {
+	GithubUsername string `json:"githubUsername,omitempty"`
+	Role           string `json:"role"`
+}
+
+// reference is a link to another resource, such as a group member or a user's group.
+type reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+func toUserResource(user *models.User, groups []*models.SCIMGroup, base string) *userResource {
+	resource := &userResource{
+		Schemas:     []string{UserSchema, EnterpriseUserSchema, ExtensionSchema},
+		ID:          user.ID,
+		ExternalID:  user.ExternalID,
+		UserName:    user.CorporateID,
+		Name:        map[string]string{"formatted": user.FullName},
+		DisplayName: user.FullName,
+		Emails:      []email{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      user.IsActive,
+		Extension:   sourceStreamUser{GithubUsername: user.GithubUsername, Role: user.Role},
+		Meta: meta{
+			ResourceType: "User",
+			Created:      user.CreatedAt.Format(time.RFC3339),
+			LastModified: user.UpdatedAt.Format(time.RFC3339),
+			Location:     base + "/Users/" + user.ID,
+		},
+	}
+
+	if user.Department != "" {
+		resource.Enterprise = &enterpriseUser{Department: user.Department}
+	}
+
+	for _, group := range groups {
+		resource.Groups = append(resource.Groups, reference{
+			Value: group.ID, Display: group.DisplayName, Ref: base + "/Groups/" + group.ID,
+		})
+	}
+
+	return resource
+}
+
+// userEdit applies SCIM attributes to a user. Attributes SourceStream does not store, such as
+// phone numbers or addresses, are accepted and ignored so that provisioning does not fail on them.
+type userEdit struct {
+	user       *models.User
+	formatted  bool
+	givenName  *string
+	familyName *string
+}
+
+// set sets the attribute at a lower-cased path from a decoded JSON value; a nil value clears it.
+func (e *userEdit) set(path string, value any) error {
+	switch path {
+	case "schemas", "id", "meta", "groups", "password":
+		// Read-only or not stored
+		return nil
+	case "username":
+		return setString(&e.user.CorporateID, path, value)
+	case "externalid":
+		return setString(&e.user.ExternalID, path, value)
+	case "displayname", "name.formatted":
+		e.formatted = true
+		return setString(&e.user.FullName, path, value)
+	case "name.givenname":
+		e.givenName = new(string)
+		return setString(e.givenName, path, value)
+	case "name.familyname":
+		e.familyName = new(string)
+		return setString(e.familyName, path, value)
+	case "active":
+		active, err := boolValue(path, value)
+		if err != nil {
+			return err
+		}
+
+		e.user.IsActive = active
+
+		return nil
+	case "emails":
+		return e.setEmails(value)
+	case "emails.value":
+		return setString(&e.user.Email, path, value)
+	case "department", enterpriseKey + ":department":
+		return setString(&e.user.Department, path, value)
+	case "githubusername", extensionKey + ":githubusername":
+		return setString(&e.user.GithubUsername, path, value)
+	case "name", enterpriseKey, extensionKey:
+		return setObject(path, value, func(key string, v any) error {
+			separator := "."
+			if path != "name" {
+				separator = ":"
+			}
+
+			return e.set(path+separator+key, v)
+		})
+	}
+
+	// e.g. emails[type eq "work"].value
+	if strings.HasPrefix(path, "emails[") && strings.HasSuffix(path, "].value") {
+		return setString(&e.user.Email, path, value)
+	}
+
+	return nil
+}
+
+// setEmails takes the primary email, or the first one, from a list of emails.
+func (e *userEdit) setEmails(value any) error {
+	if value == nil {
+		e.user.Email = ""
+		return nil
+	}
+
+	list, ok := value.([]any)
+	if !ok {
+		return badRequest("invalidValue", "emails must be a list")
+	}
+
+	for i, item := range list {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			return badRequest("invalidValue", "emails must be a list of objects")
+		}
+
+		if primary, _ := entry["primary"].(bool); primary || i == 0 {
+			if err := setString(&e.user.Email, "emails.value", entry["value"]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// finish composes the full name from its parts when only the parts were given, and checks that
+// the user has every attribute SourceStream requires.
+func (e *userEdit) finish() error {
+	if !e.formatted && (e.givenName != nil || e.familyName != nil) {
+		given, family, _ := strings.Cut(e.user.FullName, " ")
+		if e.givenName != nil {
+			given = *e.givenName
+		}
+
+		if e.familyName != nil {
+			family = *e.familyName
+		}
+
+		e.user.FullName = strings.TrimSpace(given + " " + family)
+	}
+
+	e.user.CorporateID = strings.TrimSpace(e.user.CorporateID)
+	e.user.FullName = strings.TrimSpace(e.user.FullName)
+
+	if e.user.CorporateID == "" {
+		return badRequest("invalidValue", "userName is required")
+	}
+
+	if e.user.Email == "" {
+		return badRequest("invalidValue", "a work email is required")
+	}
+
+	if e.user.FullName == "" {
+		e.user.FullName = e.user.CorporateID
+	}
+
+	return nil
+}
+
+// setAll applies every attribute of a resource body.
+func (e *userEdit) setAll(body map[string]any) error {
+	for key, value := range body {
+		if err := e.set(strings.ToLower(key), value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) error {
+	cond, err := parseFilter(r.URL.Query().Get("filter"), userAttributes)
+	if err != nil {
+		return err
+	}
+
+	limit, offset, startIndex := pageParams(r)
+
+	users, total, err := s.users.FindUsers(r.Context(), cond, limit, offset)
+	if err != nil {
+		return err
+	}
+
+	base := baseURL(r)
+	resources := make([]any, len(users))
+
+	for i, user := range users {
+		resources[i] = toUserResource(user, nil, base)
+	}
+
+	writeJSON(w, http.StatusOK, &listResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: total,
+		ItemsPerPage: len(resources),
+		StartIndex:   startIndex,
+		Resources:    resources,
+	})
+
+	return nil
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) error {
+	var body map[string]any
+	if err := readJSON(r, &body); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	user := &models.User{
+		ID:        uuid.New().String(),
+		Role:      "contributor",
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	edit := &userEdit{user: user}
+	if err := edit.setAll(body); err != nil {
+		return err
+	}
+
+	if err := edit.finish(); err != nil {
+		return err
+	}
+
+	if err := s.users.CreateUser(r.Context(), user); err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusCreated, toUserResource(user, nil, baseURL(r)))
+
+	return nil
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) error {
+	user, err := s.lookupUser(r)
+	if err != nil {
+		return err
+	}
+
+	return s.writeUser(w, r, http.StatusOK, user)
+}
+
+// replaceUser replaces the user's provisioned attributes. The GitHub username is kept unless the
+// body sets it, since it is normally linked by the user rather than the identity provider.
+func (s *Server) replaceUser(w http.ResponseWriter, r *http.Request) error {
+	user, err := s.lookupUser(r)
+	if err != nil {
+		return err
+	}
+
+	var body map[string]any
+	if err := readJSON(r, &body); err != nil {
+		return err
+	}
+
+	wasActive := user.IsActive
+	user.ExternalID = ""
+	user.Department = ""
+	user.IsActive = true
+
+	edit := &userEdit{user: user}
+	if err := edit.setAll(body); err != nil {
+		return err
+	}
+
+	return s.saveUser(w, r, edit, wasActive)
+}
+
+func (s *Server) patchUser(w http.ResponseWriter, r *http.Request) error {
+	user, err := s.lookupUser(r)
+	if err != nil {
+		return err
+	}
+
+	ops, err := readPatch(r)
+	if err != nil {
+		return err
+	}
+
+	wasActive := user.IsActive
+	edit := &userEdit{user: user}
+
+	for _, op := range ops {
+		if err := op.apply(edit.set, edit.setAll); err != nil {
+			return err
+		}
+	}
+
+	return s.saveUser(w, r, edit, wasActive)
+}
+
+// deleteUser offboards the user rather than deleting them, so that their requests, projects and
+// audit history are kept. An offboarded user can no longer sign in.
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) error {
+	user, err := s.lookupUser(r)
+	if err != nil {
+		return err
+	}
+
+	if user.IsActive {
+		if err := s.offboard(r.Context(), user); err != nil {
+			return err
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+func (s *Server) lookupUser(r *http.Request) (*models.User, error) {
+	id := r.PathValue("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, &scimError{status: http.StatusNotFound, detail: fmt.Sprintf("user %s not found", id)}
+	}
+
+	return s.users.GetUserByID(r.Context(), id)
+}
+
+// saveUser stores an edited user. A user who was active and is deactivated by the edit is
+// offboarded first, so that a failed offboarding leaves the user unchanged for the identity
+// provider to retry.
+func (s *Server) saveUser(w http.ResponseWriter, r *http.Request, edit *userEdit, wasActive bool) error {
+	if err := edit.finish(); err != nil {
+		return err
+	}
+
+	if wasActive && !edit.user.IsActive {
+		if err := s.offboard(r.Context(), edit.user); err != nil {
+			return err
+		}
+	}
+
+	edit.user.UpdatedAt = time.Now()
+
+	if err := s.users.UpdateUser(r.Context(), edit.user); err != nil {
+		return err
+	}
+
+	return s.writeUser(w, r, http.StatusOK, edit.user)
+}
+
+func (s *Server) offboard(ctx context.Context, user *models.User) error {
+	_, err := s.offboarder.Offboard(ctx, offboarding.Request{
+		UserID: user.ID,
+		Source: repository.OffboardingSourceSCIM,
+		Reason: "deactivated by the identity provider",
+	})
+
+	return err
+}
+
+func (s *Server) writeUser(w http.ResponseWriter, r *http.Request, status int, user *models.User) error {
+	var groups []*models.SCIMGroup
+
+	if !excluded(r, "groups") {
+		var err error
+
+		groups, err = s.groups.GetUserGroups(r.Context(), user.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	writeJSON(w, status, toUserResource(user, groups, baseURL(r)))
+
+	return nil
+}
+
+func setString(dst *string, path string, value any) error {
+	switch v := value.(type) {
+	case nil:
+		*dst = ""
+	case string:
+		*dst = v
+	default:
+		return badRequest("invalidValue", fmt.Sprintf("%s must be a string", path))
+	}
+
+	return nil
+}
+
+// boolValue reads a boolean, also accepting "true" and "false" strings as some identity
+// providers send them.
+func boolValue(path string, value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		switch strings.ToLower(v) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+
+	return false, badRequest("invalidValue", fmt.Sprintf("%s must be a boolean", path))
+}
+
+// setObject calls set for each attribute of a complex value, with lower-cased keys.
+func setObject(path string, value any, set func(key string, v any) error) error {
+	if value == nil {
+		return nil
+	}
+
+	object, ok := value.(map[string]any)
+	if !ok {
+		return badRequest("invalidValue", fmt.Sprintf("%s must be an object", path))
+	}
+
+	for key, v := range object {
+		if err := set(strings.ToLower(key), v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
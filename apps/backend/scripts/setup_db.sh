@@ -24,10 +24,28 @@ echo "Creating database '$DB_NAME' if it doesn't exist..."
 psql -h $DB_HOST -p $DB_PORT -U $DB_USER -tc "SELECT 1 FROM pg_database WHERE datname = '$DB_NAME'" | grep -q 1 || \
     psql -h $DB_HOST -p $DB_PORT -U $DB_USER -c "CREATE DATABASE $DB_NAME"
 
-# Run migrations
+PSQL="psql -h $DB_HOST -p $DB_PORT -U $DB_USER -d $DB_NAME -v ON_ERROR_STOP=1"
+
+# Databases set up before migration 016 have no record of their applied migrations
+if [ "$($PSQL -tAc "SELECT to_regclass('users') IS NOT NULL AND to_regclass('schema_migrations') IS NULL")" = "t" ]; then
+    echo "Error: database '$DB_NAME' predates schema_migrations"
+    echo "Apply any pending migrations up to migrations/016_schema_migrations.sql by hand, then rerun this script"
+    exit 1
+fi
+
+# Run the migrations that have not been applied yet, in order
 echo "Running database migrations..."
-psql -h $DB_HOST -p $DB_PORT -U $DB_USER -d $DB_NAME -f migrations/001_initial_schema.sql
-psql -h $DB_HOST -p $DB_PORT -U $DB_USER -d $DB_NAME -f migrations/002_seed_data.sql
+for migration in migrations/[0-9]*.sql; do
+    version=$((10#$(basename "$migration" | cut -d_ -f1)))
+
+    if [ "$($PSQL -tAc "SELECT to_regclass('schema_migrations') IS NOT NULL")" = "t" ] &&
+        [ "$($PSQL -tAc "SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $version)")" = "t" ]; then
+        continue
+    fi
+
+    echo "Applying $migration"
+    $PSQL -q -f "$migration"
+done
 
 echo "Database setup completed successfully!"
 echo ""
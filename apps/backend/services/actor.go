@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"sourcestream/backend/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// actingUserID returns the ID of the user an RPC acts as. When the call is authenticated this
+// is always the caller; a request field naming anyone else is rejected rather than trusted.
+// Without authentication (development only) the request field is used as given.
+func actingUserID(ctx context.Context, field, claimed string) (string, error) {
+	actor, ok := auth.FromContext(ctx)
+	if !ok {
+		if claimed == "" {
+			return "", invalidArgument(field, "is required")
+		}
+
+		return claimed, nil
+	}
+
+	if actor.User == nil {
+		return "", status.Error(codes.PermissionDenied, "caller is not a registered contributor")
+	}
+
+	if claimed != "" && claimed != actor.User.ID {
+		return "", status.Error(codes.PermissionDenied, fmt.Sprintf("%s must be the caller", field))
+	}
+
+	return actor.User.ID, nil
+}
+
+// subjectUserID returns the user a read RPC is about: the request field when set, which the
+// authorization policy has already checked, and otherwise the caller.
+func subjectUserID(ctx context.Context, field, requested string) (string, error) {
+	if requested != "" {
+		return requested, nil
+	}
+
+	if actor, ok := auth.FromContext(ctx); ok && actor.User != nil {
+		return actor.User.ID, nil
+	}
+
+	return "", invalidArgument(field, "is required")
+}
+
+// actingCorporateID is actingUserID for RPCs that identify the caller by corporate ID.
+func actingCorporateID(ctx context.Context, field, claimed string) (string, error) {
+	actor, ok := auth.FromContext(ctx)
+	if !ok {
+		return claimed, nil
+	}
+
+	if claimed != "" && claimed != actor.Identity.CorporateID {
+		return "", status.Error(codes.PermissionDenied, fmt.Sprintf("%s must be the caller", field))
+	}
+
+	return actor.Identity.CorporateID, nil
+}
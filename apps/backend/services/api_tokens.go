@@ -0,0 +1,226 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"sourcestream/backend/auth"
+	"sourcestream/backend/authz"
+	"sourcestream/backend/models"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/repository"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// API token lifetimes, in days.
+const (
+	defaultAPITokenDays = 90
+	maxAPITokenDays     = 365
+)
+
+// serviceAccountEmailDomain is used for service accounts created without a contact address. The
+// .invalid TLD is reserved, so mail to it is never delivered.
+const serviceAccountEmailDomain = "service-accounts.invalid"
+
+// CreateServiceAccount creates a service account, a user that CI pipelines and bots act as. It
+// cannot sign in through the identity provider and authenticates only with API tokens.
+func (s *UserService) CreateServiceAccount(ctx context.Context, req *pb.CreateServiceAccountRequest) (*pb.CreateServiceAccountResponse, error) {
+	user := newServiceAccount(req)
+
+	err := s.userRepo.CreateUser(ctx, user)
+
+	var duplicate *repository.DuplicateUserError
+	if errors.As(err, &duplicate) {
+		return nil, alreadyExists(duplicate.Field,
+			fmt.Sprintf("%s is already registered to another user", duplicate.Field))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create service account: %w", err)
+	}
+
+	return &pb.CreateServiceAccountResponse{User: userToPB(user)}, nil
+}
+
+// CreateAPIToken issues an API token for a service account. The secret is returned only in this
+// response; only its hash is stored.
+func (s *UserService) CreateAPIToken(ctx context.Context, req *pb.CreateAPITokenRequest) (*pb.CreateAPITokenResponse, error) {
+	token, err := newAPIToken(req)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.directoryUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsServiceAccount {
+		return nil, status.Error(codes.FailedPrecondition, "API tokens can only be issued to service accounts")
+	}
+
+	if !user.IsActive {
+		return nil, status.Error(codes.FailedPrecondition, "service account is deactivated")
+	}
+
+	secret, prefix, err := auth.GenerateAPIToken()
+	if err != nil {
+		return nil, err
+	}
+
+	token.UserID = user.ID
+	token.Prefix = prefix
+	token.TokenHash = auth.HashAPIToken(secret)
+
+	if actor, ok := auth.FromContext(ctx); ok && actor.User != nil {
+		token.CreatedBy = &actor.User.ID
+	}
+
+	if err := s.tokenRepo.CreateAPIToken(ctx, token); err != nil {
+		return nil, fmt.Errorf("failed to create API token: %w", err)
+	}
+
+	return &pb.CreateAPITokenResponse{Token: apiTokenToPB(token), Secret: secret}, nil
+}
+
+// ListAPITokens returns a user's API tokens, newest first, without their secrets.
+func (s *UserService) ListAPITokens(ctx context.Context, req *pb.ListAPITokensRequest) (*pb.ListAPITokensResponse, error) {
+	user, err := s.directoryUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.tokenRepo.ListAPITokens(ctx, user.ID, req.GetIncludeRevoked())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API tokens: %w", err)
+	}
+
+	pbTokens := make([]*pb.APIToken, len(tokens))
+	for i, token := range tokens {
+		pbTokens[i] = apiTokenToPB(token)
+	}
+
+	return &pb.ListAPITokensResponse{Tokens: pbTokens}, nil
+}
+
+// RevokeAPIToken revokes one of a user's API tokens. It takes effect on the token's next call.
+func (s *UserService) RevokeAPIToken(ctx context.Context, req *pb.RevokeAPITokenRequest) (*pb.RevokeAPITokenResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	if req.GetTokenId() == "" {
+		return nil, invalidArgument("token_id", "is required")
+	}
+
+	token, err := s.tokenRepo.RevokeAPIToken(ctx, req.GetUserId(), req.GetTokenId())
+	if errors.Is(err, repository.ErrAPITokenNotFound) {
+		return nil, status.Error(codes.NotFound, "API token not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke API token: %w", err)
+	}
+
+	return &pb.RevokeAPITokenResponse{Token: apiTokenToPB(token)}, nil
+}
+
+// newServiceAccount returns the user to create for a CreateServiceAccount request, whose fields
+// the validation interceptor has checked.
+func newServiceAccount(req *pb.CreateServiceAccountRequest) *models.User {
+	email := req.GetEmail()
+	if email == "" {
+		email = strings.ToLower(req.GetCorporateId()) + "@" + serviceAccountEmailDomain
+	}
+
+	return &models.User{
+		CorporateID:      req.GetCorporateId(),
+		GithubUsername:   req.GetGithubUsername(),
+		Email:            email,
+		FullName:         strings.TrimSpace(req.GetFullName()),
+		Department:       strings.TrimSpace(req.GetDepartment()),
+		Role:             authz.RoleContributor,
+		IsActive:         true,
+		IsServiceAccount: true,
+	}
+}
+
+// newAPIToken validates a CreateAPIToken request and returns the token to create, without its
+// user and secret.
+func newAPIToken(req *pb.CreateAPITokenRequest) (*models.APIToken, error) {
+	name := strings.TrimSpace(req.GetName())
+
+	switch {
+	case name == "":
+		return nil, invalidArgument("name", "is required")
+	case len(name) > 100:
+		return nil, invalidArgument("name", "must be at most 100 characters")
+	}
+
+	if len(req.GetScopes()) == 0 {
+		return nil, invalidArgument("scopes", "at least one scope is required")
+	}
+
+	var scopes []string
+
+	for _, scope := range req.GetScopes() {
+		if !auth.IsScope(scope) {
+			return nil, invalidArgument("scopes",
+				fmt.Sprintf("unknown scope %q; must be one of %s", scope, strings.Join(auth.Scopes(), ", ")))
+		}
+
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	days := req.GetExpiresInDays()
+
+	switch {
+	case days == 0:
+		days = defaultAPITokenDays
+	case days < 0 || days > maxAPITokenDays:
+		return nil, invalidArgument("expires_in_days", fmt.Sprintf("must be between 1 and %d", maxAPITokenDays))
+	}
+
+	return &models.APIToken{
+		Name:      name,
+		Scopes:    scopes,
+		ExpiresAt: time.Now().AddDate(0, 0, int(days)),
+	}, nil
+}
+
+func apiTokenToPB(token *models.APIToken) *pb.APIToken {
+	pbToken := &pb.APIToken{
+		Id:        token.ID,
+		UserId:    token.UserID,
+		Name:      token.Name,
+		Prefix:    token.Prefix,
+		Scopes:    token.Scopes,
+		ExpiresAt: token.ExpiresAt.Format(time.RFC3339),
+	}
+
+	if !token.CreatedAt.IsZero() {
+		pbToken.CreatedAt = token.CreatedAt.Format(time.RFC3339)
+	}
+
+	if token.LastUsedAt != nil {
+		pbToken.LastUsedAt = token.LastUsedAt.Format(time.RFC3339)
+	}
+
+	if token.RevokedAt != nil {
+		pbToken.RevokedAt = token.RevokedAt.Format(time.RFC3339)
+	}
+
+	if token.CreatedBy != nil {
+		pbToken.CreatedBy = *token.CreatedBy
+	}
+
+	return pbToken
+}
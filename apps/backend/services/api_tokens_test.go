@@ -0,0 +1,46 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sourcestream/backend/auth"
+	pb "sourcestream/backend/pb"
+)
+
+func TestNewAPIToken(t *testing.T) {
+	token, err := newAPIToken(&pb.CreateAPITokenRequest{
+		Name:   " release pipeline ",
+		Scopes: []string{auth.ScopeRequestsWrite, auth.ScopeCatalogRead, auth.ScopeRequestsWrite},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "release pipeline", token.Name)
+	assert.Equal(t, []string{auth.ScopeRequestsWrite, auth.ScopeCatalogRead}, token.Scopes)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, defaultAPITokenDays), token.ExpiresAt, time.Minute)
+}
+
+func TestNewAPIToken_Invalid(t *testing.T) {
+	for field, req := range map[string]*pb.CreateAPITokenRequest{
+		"name":            {Scopes: []string{auth.ScopeCatalogRead}},
+		"scopes":          {Name: "ci", Scopes: []string{"everything"}},
+		"expires_in_days": {Name: "ci", Scopes: []string{auth.ScopeCatalogRead}, ExpiresInDays: maxAPITokenDays + 1},
+	} {
+		_, err := newAPIToken(req)
+		require.Error(t, err, field)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), field)
+		assert.Contains(t, err.Error(), field)
+	}
+}
+
+func TestNewServiceAccount(t *testing.T) {
+	user := newServiceAccount(&pb.CreateServiceAccountRequest{CorporateId: "svc-CI", FullName: "CI bot"})
+
+	assert.True(t, user.IsServiceAccount)
+	assert.True(t, user.IsActive)
+	assert.Equal(t, "svc-ci@"+serviceAccountEmailDomain, user.Email)
+}
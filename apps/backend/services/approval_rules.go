@@ -0,0 +1,598 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"sourcestream/backend/models"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/repository"
+	"sourcestream/backend/rules"
+)
+
+const (
+	defaultDryRunLimit = 500
+	maxDryRunLimit     = 5000
+)
+
+// CreateApprovalRule stores a new approval rule at version 1.
+func (s *RequestService) CreateApprovalRule(ctx context.Context, req *pb.CreateApprovalRuleRequest) (*pb.CreateApprovalRuleResponse, error) {
+	rule := &models.ApprovalRule{
+		Name:        strings.TrimSpace(req.GetName()),
+		Description: req.GetDescription(),
+		IsEnabled:   req.GetIsEnabled(),
+		RequestType: req.GetRequestType(),
+		Expression:  req.GetExpression(),
+		Action:      req.GetAction(),
+		Target:      strings.TrimSpace(req.GetTarget()),
+	}
+
+	if err := s.prepareApprovalRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.approvalRuleRepo.CreateApprovalRule(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to create approval rule: %w", err)
+	}
+
+	return &pb.CreateApprovalRuleResponse{Rule: approvalRuleToPB(rule)}, nil
+}
+
+// UpdateApprovalRule replaces a rule's definition with a new version. Earlier versions are kept so
+// that recorded matches still refer to the expression that produced them.
+func (s *RequestService) UpdateApprovalRule(ctx context.Context, req *pb.UpdateApprovalRuleRequest) (*pb.UpdateApprovalRuleResponse, error) {
+	if req.GetRuleId() == "" {
+		return nil, invalidArgument("rule_id", "is required")
+	}
+
+	rule := &models.ApprovalRule{
+		ID:          req.GetRuleId(),
+		Name:        strings.TrimSpace(req.GetName()),
+		Description: req.GetDescription(),
+		IsEnabled:   req.GetIsEnabled(),
+		RequestType: req.GetRequestType(),
+		Expression:  req.GetExpression(),
+		Action:      req.GetAction(),
+		Target:      strings.TrimSpace(req.GetTarget()),
+	}
+
+	if err := s.prepareApprovalRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.approvalRuleRepo.UpdateApprovalRule(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to update approval rule: %w", err)
+	}
+
+	return &pb.UpdateApprovalRuleResponse{Rule: approvalRuleToPB(rule)}, nil
+}
+
+// ListApprovalRules returns every approval rule at its current version.
+func (s *RequestService) ListApprovalRules(ctx context.Context, req *pb.ListApprovalRulesRequest) (*pb.ListApprovalRulesResponse, error) {
+	stored, err := s.approvalRuleRepo.ListApprovalRules(ctx, req.GetEnabledOnly())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approval rules: %w", err)
+	}
+
+	pbRules := make([]*pb.ApprovalRule, len(stored))
+	for i, rule := range stored {
+		pbRules[i] = approvalRuleToPB(rule)
+	}
+
+	return &pb.ListApprovalRulesResponse{Rules: pbRules}, nil
+}
+
+// DryRunPolicy evaluates a stored rule, or an ad-hoc expression, against past requests and reports
+// which of them it would have matched. Nothing is recorded.
+func (s *RequestService) DryRunPolicy(ctx context.Context, req *pb.DryRunPolicyRequest) (*pb.DryRunPolicyResponse, error) {
+	expression := req.GetExpression()
+	requestType := req.GetRequestType()
+
+	if req.GetRuleId() != "" {
+		rule, err := s.approvalRuleRepo.GetApprovalRule(ctx, req.GetRuleId(), int(req.GetRuleVersion()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get approval rule: %w", err)
+		}
+
+		expression = rule.Expression
+
+		if requestType == "" {
+			requestType = rule.RequestType
+		}
+	}
+
+	if expression == "" {
+		return nil, invalidArgument("policy", "a rule_id or expression is required")
+	}
+
+	program, err := rules.Compile(expression)
+	if err != nil {
+		return nil, invalidArgument("expression", err.Error())
+	}
+
+	filter := repository.RequestHistoryFilter{Type: requestType, Limit: defaultDryRunLimit}
+
+	if req.GetLimit() > 0 {
+		filter.Limit = min(int(req.GetLimit()), maxDryRunLimit)
+	}
+
+	if filter.From, err = parseOptionalTime("from", req.GetFrom()); err != nil {
+		return nil, err
+	}
+
+	if filter.To, err = parseOptionalTime("to", req.GetTo()); err != nil {
+		return nil, err
+	}
+
+	history, err := s.requestRepo.ListRequestHistory(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requests: %w", err)
+	}
+
+	loader := newRuleInputLoader(s)
+	resp := &pb.DryRunPolicyResponse{Evaluated: clampInt32(len(history))}
+
+	for _, request := range history {
+		input, err := loader.load(ctx, request)
+		if err != nil {
+			result := dryRunResultToPB(request)
+			result.Error = err.Error()
+			resp.Failures = append(resp.Failures, result)
+
+			continue
+		}
+
+		matched, err := program.Evaluate(ctx, input)
+		if err != nil {
+			result := dryRunResultToPB(request)
+			result.Error = err.Error()
+			resp.Failures = append(resp.Failures, result)
+
+			continue
+		}
+
+		if matched {
+			resp.Matches = append(resp.Matches, dryRunResultToPB(request))
+		}
+	}
+
+	resp.Matched = clampInt32(len(resp.Matches))
+	resp.Errors = clampInt32(len(resp.Failures))
+
+	return resp, nil
+}
+
+// prepareApprovalRule validates a rule definition and records the acting user as its author.
+func (s *RequestService) prepareApprovalRule(ctx context.Context, rule *models.ApprovalRule) error {
+	if rule.Name == "" {
+		return invalidArgument("name", "is required")
+	}
+
+	if !rules.IsAction(rule.Action) {
+		return invalidArgument("action", fmt.Sprintf("must be %s, %s or %s",
+			rules.ActionRequireReview, rules.ActionRoute, rules.ActionAutoApprove))
+	}
+
+	if rule.Action == rules.ActionAutoApprove && rule.RequestType != "contribution_permission" {
+		return invalidArgument("request_type", "auto_approve rules apply only to contribution_permission requests")
+	}
+
+	if rule.Target == "" && rule.Action != rules.ActionAutoApprove {
+		return invalidArgument("target", "is required")
+	}
+
+	if _, err := rules.Compile(rule.Expression); err != nil {
+		return invalidArgument("expression", err.Error())
+	}
+
+	if userID, err := actingUserID(ctx, "created_by", ""); err == nil {
+		rule.CreatedBy = &userID
+	}
+
+	return nil
+}
+
+// applyApprovalRules evaluates the enabled approval rules against a newly submitted request and
+// records the ones that match, resolving targets such as the requester's manager to a user. When
+// an auto-approval rule decides the request it is approved immediately, and otherwise a routing
+// rule that resolved to a user makes them the reviewer; request is updated to match. Rules only
+// add review requirements or skip a human rubber stamp, so a failure here is logged and leaves
+// the request pending for review. In particular, a rule that fails to compile or evaluate, or
+// whose input cannot be loaded, might have required review, so it rules out auto-approval. The request is already stored, so the
+// rules are applied even if the caller cancels.
+func (s *RequestService) applyApprovalRules(ctx context.Context, request *models.Request) {
+	ctx = context.WithoutCancel(ctx)
+
+	enabled, err := s.approvalRuleRepo.ListApprovalRules(ctx, true)
+	if err != nil {
+		log.Printf("rules: failed to load approval rules for request %s: %v", request.ID, err)
+		return
+	}
+
+	loader := newRuleInputLoader(s)
+
+	input, loadErr := loader.load(ctx, request)
+	if loadErr != nil {
+		log.Printf("rules: failed to load approval rule input for request %s: %v", request.ID, loadErr)
+	}
+
+	var matched, failed []*models.ApprovalRule
+
+	for _, rule := range enabled {
+		if rule.RequestType != "" && rule.RequestType != request.Type {
+			continue
+		}
+
+		if loadErr != nil {
+			failed = append(failed, rule)
+			continue
+		}
+
+		program, err := rules.Compile(rule.Expression)
+		if err != nil {
+			log.Printf("rules: approval rule %s v%d does not compile: %v", rule.Name, rule.Version, err)
+			failed = append(failed, rule)
+			continue
+		}
+
+		ok, err := program.Evaluate(ctx, input)
+		if err != nil {
+			log.Printf("rules: failed to evaluate approval rule %s v%d for request %s: %v", rule.Name, rule.Version, request.ID, err)
+			failed = append(failed, rule)
+			continue
+		}
+
+		if ok {
+			matched = append(matched, rule)
+		}
+	}
+
+	if len(matched) == 0 {
+		return
+	}
+
+	matches := make([]*models.RequestRuleMatch, len(matched))
+	for i, rule := range matched {
+		matches[i] = &models.RequestRuleMatch{
+			RequestID:   request.ID,
+			RuleID:      rule.ID,
+			RuleVersion: rule.Version,
+			Action:      rule.Action,
+			Target:      rule.Target,
+			AssigneeID:  loader.assignee(ctx, rule, input.User),
+		}
+	}
+
+	if err := s.approvalRuleRepo.RecordRuleMatches(ctx, matches); err != nil {
+		log.Printf("rules: failed to record approval rule matches for request %s: %v", request.ID, err)
+		return
+	}
+
+	rule := autoApprovalRule(request, matched, failed)
+	if rule == nil {
+		s.assignRoutedReviewer(ctx, request, matches)
+		return
+	}
+
+	reason := fmt.Sprintf("auto-approved by rule %q (version %d)", rule.Name, rule.Version)
+
+	approved, err := s.requestRepo.AutoApproveRequest(ctx, request.ID, rule.ID, rule.Version, reason)
+	if err != nil {
+		log.Printf("rules: failed to auto-approve request %s: %v", request.ID, err)
+		return
+	}
+
+	if approved {
+		request.Status = "approved"
+		request.DecisionReason = &reason
+	}
+}
+
+// autoApprovalRule returns the auto-approval rule that decides a request, or nil when the request
+// needs a human decision. Any matching rule that requires review or routes the request takes
+// precedence over auto-approval, and so does any applicable rule that failed, since it might have
+// matched; otherwise the first matching auto-approval rule by name wins.
+func autoApprovalRule(request *models.Request, matched, failed []*models.ApprovalRule) *models.ApprovalRule {
+	if request.Type != "contribution_permission" || len(failed) > 0 {
+		return nil
+	}
+
+	var decider *models.ApprovalRule
+
+	for _, rule := range matched {
+		if rule.Action != rules.ActionAutoApprove {
+			return nil
+		}
+
+		if decider == nil {
+			decider = rule
+		}
+	}
+
+	return decider
+}
+
+// assignRoutedReviewer makes the user a routing rule resolved to the request's reviewer.
+func (s *RequestService) assignRoutedReviewer(ctx context.Context, request *models.Request, matches []*models.RequestRuleMatch) {
+	reviewerID := routedReviewer(matches)
+	if reviewerID == "" {
+		return
+	}
+
+	assigned, err := s.requestRepo.AssignReviewer(ctx, request.ID, reviewerID)
+	if err != nil {
+		log.Printf("rules: failed to assign reviewer to request %s: %v", request.ID, err)
+		return
+	}
+
+	if assigned {
+		request.ReviewerID = &reviewerID
+	}
+}
+
+// routedReviewer returns the user the first matching routing rule resolved to, or an empty string
+// when no routing rule targets a user. Rules that require review by a user do not assign the
+// request; the review is recorded with the match.
+func routedReviewer(matches []*models.RequestRuleMatch) string {
+	for _, match := range matches {
+		if match.Action == rules.ActionRoute && match.AssigneeID != nil {
+			return *match.AssigneeID
+		}
+	}
+
+	return ""
+}
+
+// ListAutoApprovedRequests returns the requests approved by auto-approval rules, with the rule and
+// version that approved each one.
+func (s *RequestService) ListAutoApprovedRequests(ctx context.Context, req *pb.ListAutoApprovedRequestsRequest) (*pb.ListAutoApprovedRequestsResponse, error) {
+	limit, offset := pageBounds(req.GetPage(), req.GetLimit())
+	filter := repository.AutoApprovedFilter{RuleID: req.GetRuleId(), Limit: limit, Offset: offset}
+
+	var err error
+
+	if filter.From, err = parseOptionalTime("from", req.GetFrom()); err != nil {
+		return nil, err
+	}
+
+	if filter.To, err = parseOptionalTime("to", req.GetTo()); err != nil {
+		return nil, err
+	}
+
+	approved, total, err := s.requestRepo.ListAutoApprovedRequests(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list auto-approved requests: %w", err)
+	}
+
+	pbRequests := make([]*pb.AutoApprovedRequest, len(approved))
+	for i, entry := range approved {
+		pbRequests[i] = autoApprovedRequestToPB(entry)
+	}
+
+	return &pb.ListAutoApprovedRequestsResponse{
+		Requests: pbRequests,
+		Total:    clampInt32(total),
+	}, nil
+}
+
+// ruleInputLoader resolves the user, teams, project and approved project a request concerns,
+// caching lookups so that dry runs over many requests do not repeat them.
+type ruleInputLoader struct {
+	service          *RequestService
+	users            map[string]*models.User
+	teams            map[string][]string
+	projects         map[string]*models.Project
+	approvedProjects map[string]*models.ApprovedProject
+}
+
+func newRuleInputLoader(s *RequestService) *ruleInputLoader {
+	return &ruleInputLoader{
+		service:          s,
+		users:            map[string]*models.User{},
+		teams:            map[string][]string{},
+		projects:         map[string]*models.Project{},
+		approvedProjects: map[string]*models.ApprovedProject{},
+	}
+}
+
+// user returns the user with id, or nil when there is none.
+func (l *ruleInputLoader) user(ctx context.Context, id string) (*models.User, error) {
+	user, ok := l.users[id]
+	if !ok {
+		var err error
+		if user, err = l.service.userRepo.GetUserByID(ctx, id); lookupFailed(err) {
+			return nil, fmt.Errorf("failed to get user %s: %w", id, err)
+		}
+
+		l.users[id] = user
+	}
+
+	return user, nil
+}
+
+// assignee resolves a rule targeting the requester's manager or department OSPO champion to that
+// user. It returns nil for other targets and when the user is missing, inactive or the requester
+// themselves, in which case the request stays with the OSPO review queue.
+func (l *ruleInputLoader) assignee(ctx context.Context, rule *models.ApprovalRule, requester *models.User) *string {
+	if requester == nil || rule.Action == rules.ActionAutoApprove {
+		return nil
+	}
+
+	var assigneeID *string
+
+	switch rule.Target {
+	case rules.TargetRequesterManager:
+		assigneeID = requester.ManagerID
+	case rules.TargetDepartmentChampion:
+		if requester.Department == "" {
+			break
+		}
+
+		department, err := l.service.orgRepo.GetDepartmentByName(ctx, requester.Department)
+		if err == nil {
+			assigneeID = department.OSPOChampionID
+		}
+	default:
+		return nil
+	}
+
+	if assigneeID == nil || *assigneeID == requester.ID {
+		log.Printf("rules: approval rule %s targets %s, which requester %s does not have", rule.Name, rule.Target, requester.CorporateID)
+		return nil
+	}
+
+	assignee, err := l.user(ctx, *assigneeID)
+	if err != nil {
+		log.Printf("rules: approval rule %s targets %s, who could not be looked up: %v", rule.Name, rule.Target, err)
+		return nil
+	}
+
+	if assignee == nil || !assignee.IsActive {
+		log.Printf("rules: approval rule %s targets %s, who is not an active user", rule.Name, rule.Target)
+		return nil
+	}
+
+	return assigneeID
+}
+
+// load builds the rule input for a request. Related objects that do not exist are left nil and
+// appear to expressions as empty objects; any other lookup failure is returned, since evaluating
+// a rule without its input could wrongly match or miss.
+func (l *ruleInputLoader) load(ctx context.Context, request *models.Request) (rules.Input, error) {
+	input := rules.Input{Request: request}
+
+	var err error
+	if input.User, err = l.user(ctx, request.RequesterID); err != nil {
+		return rules.Input{}, err
+	}
+
+	if input.User != nil {
+		teams, ok := l.teams[input.User.ID]
+		if !ok {
+			userTeams, err := l.service.orgRepo.GetUserTeams(ctx, input.User.ID)
+			if err != nil {
+				return rules.Input{}, fmt.Errorf("failed to get teams of user %s: %w", input.User.ID, err)
+			}
+
+			for _, team := range userTeams {
+				teams = append(teams, team.Name)
+			}
+
+			l.teams[input.User.ID] = teams
+		}
+
+		input.Teams = teams
+	}
+
+	projectKey := request.ProjectName
+	if request.ProjectID != nil {
+		projectKey = "id:" + *request.ProjectID
+	}
+
+	if projectKey != "" {
+		project, ok := l.projects[projectKey]
+		if !ok {
+			if request.ProjectID != nil {
+				project, err = l.service.projectRepo.GetProjectByID(ctx, *request.ProjectID)
+			} else {
+				project, err = l.service.projectRepo.GetProjectByName(ctx, request.ProjectName)
+			}
+
+			if lookupFailed(err) {
+				return rules.Input{}, fmt.Errorf("failed to get project %s: %w", projectKey, err)
+			}
+
+			l.projects[projectKey] = project
+		}
+
+		input.Project = project
+	}
+
+	if request.ApprovedProjectID != nil && *request.ApprovedProjectID != "" {
+		approved, ok := l.approvedProjects[*request.ApprovedProjectID]
+		if !ok {
+			approved, err = l.service.approvedProjectRepo.GetApprovedProjectByID(ctx, *request.ApprovedProjectID)
+			if lookupFailed(err) {
+				return rules.Input{}, fmt.Errorf("failed to get approved project %s: %w", *request.ApprovedProjectID, err)
+			}
+
+			l.approvedProjects[*request.ApprovedProjectID] = approved
+		}
+
+		input.ApprovedProject = approved
+	}
+
+	return input, nil
+}
+
+// lookupFailed reports whether err is a lookup failure other than finding no row.
+func lookupFailed(err error) bool {
+	var notFound *repository.NotFoundError
+	return err != nil && !errors.As(err, &notFound)
+}
+
+func approvalRuleToPB(rule *models.ApprovalRule) *pb.ApprovalRule {
+	pbRule := &pb.ApprovalRule{
+		Id:          rule.ID,
+		Name:        rule.Name,
+		Description: rule.Description,
+		IsEnabled:   rule.IsEnabled,
+		Version:     clampInt32(rule.Version),
+		RequestType: rule.RequestType,
+		Expression:  rule.Expression,
+		Action:      rule.Action,
+		Target:      rule.Target,
+		UpdatedAt:   rule.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if rule.CreatedBy != nil {
+		pbRule.CreatedBy = *rule.CreatedBy
+	}
+
+	return pbRule
+}
+
+func dryRunResultToPB(request *models.Request) *pb.DryRunPolicyResult {
+	return &pb.DryRunPolicyResult{
+		RequestId: request.ID,
+		Type:      request.Type,
+		Title:     request.Title,
+		Status:    request.Status,
+		CreatedAt: request.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func autoApprovedRequestToPB(entry *models.AutoApprovedRequest) *pb.AutoApprovedRequest {
+	request := entry.Request
+	pbRequest := &pb.AutoApprovedRequest{
+		RequestId:        request.ID,
+		Title:            request.Title,
+		RequesterId:      request.RequesterID,
+		ContributionKind: request.ContributionKind,
+		RuleName:         entry.RuleName,
+		RuleVersion:      clampInt32(entry.RuleVersion),
+		CreatedAt:        request.CreatedAt.Format(time.RFC3339),
+	}
+
+	if request.ApprovedProjectID != nil {
+		pbRequest.ApprovedProjectId = *request.ApprovedProjectID
+	}
+
+	if entry.RuleID != nil {
+		pbRequest.RuleId = *entry.RuleID
+	}
+
+	if request.DecisionReason != nil {
+		pbRequest.DecisionReason = *request.DecisionReason
+	}
+
+	if request.ApprovedAt != nil {
+		pbRequest.ApprovedAt = request.ApprovedAt.Format(time.RFC3339)
+	}
+
+	return pbRequest
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sourcestream/backend/models"
+	"sourcestream/backend/repository"
+	"sourcestream/backend/rules"
+)
+
+var errDatabaseDown = errors.New("database is down")
+
+// downConnector is a database that cannot be connected to.
+type downConnector struct{}
+
+func (downConnector) Connect(context.Context) (driver.Conn, error) { return nil, errDatabaseDown }
+func (downConnector) Driver() driver.Driver                        { return nil }
+
+func TestAutoApprovalRule(t *testing.T) {
+	docs := &models.ApprovalRule{ID: "rule-docs", Name: "dco-docs", Action: rules.ActionAutoApprove}
+	bugfix := &models.ApprovalRule{ID: "rule-bugfix", Name: "dco-bug-fix", Action: rules.ActionAutoApprove}
+	security := &models.ApprovalRule{ID: "rule-gpl", Name: "gpl-security-review", Action: rules.ActionRequireReview, Target: "security"}
+
+	permission := &models.Request{Type: "contribution_permission"}
+	access := &models.Request{Type: "access"}
+
+	tests := []struct {
+		name    string
+		request *models.Request
+		matched []*models.ApprovalRule
+		failed  []*models.ApprovalRule
+		want    *models.ApprovalRule
+	}{
+		{"no matches", permission, nil, nil, nil},
+		{"single auto-approval", permission, []*models.ApprovalRule{docs}, nil, docs},
+		{"first auto-approval wins", permission, []*models.ApprovalRule{bugfix, docs}, nil, bugfix},
+		{"review requirement takes precedence", permission, []*models.ApprovalRule{docs, security}, nil, nil},
+		{"failed rule takes precedence", permission, []*models.ApprovalRule{docs}, []*models.ApprovalRule{security}, nil},
+		{"only contribution permissions", access, []*models.ApprovalRule{docs}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, autoApprovalRule(tt.request, tt.matched, tt.failed))
+		})
+	}
+}
+
+func TestRoutedReviewer(t *testing.T) {
+	manager := "manager-1"
+	champion := "champion-1"
+
+	assert.Empty(t, routedReviewer(nil))
+	assert.Empty(t, routedReviewer([]*models.RequestRuleMatch{
+		{Action: rules.ActionRoute, Target: "legal"},
+		{Action: rules.ActionRequireReview, Target: rules.TargetDepartmentChampion, AssigneeID: &champion},
+	}))
+	assert.Equal(t, manager, routedReviewer([]*models.RequestRuleMatch{
+		{Action: rules.ActionRequireReview, Target: rules.TargetDepartmentChampion, AssigneeID: &champion},
+		{Action: rules.ActionRoute, Target: rules.TargetRequesterManager, AssigneeID: &manager},
+	}))
+}
+
+func TestRuleInputLoader_LookupFailure(t *testing.T) {
+	db := sql.OpenDB(downConnector{})
+	defer func() { _ = db.Close() }()
+
+	s := &RequestService{userRepo: repository.NewUserRepository(db)}
+
+	// Rules evaluated without the requester could wrongly auto-approve, so the load fails
+	_, err := newRuleInputLoader(s).load(context.Background(), &models.Request{RequesterID: "user-1"})
+	assert.ErrorIs(t, err, errDatabaseDown)
+
+	assert.False(t, lookupFailed(nil))
+	assert.False(t, lookupFailed(repository.ErrUserNotFound))
+	assert.True(t, lookupFailed(errDatabaseDown))
+}
@@ -0,0 +1,286 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sourcestream/backend/models"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/repository"
+
+	"google.golang.org/grpc/status"
+)
+
+// RecordContribution records an upstream pull request or commit against its approving request.
+func (s *RequestService) RecordContribution(ctx context.Context, req *pb.RecordContributionRequest) (*pb.RecordContributionResponse, error) {
+	contribution, err := contributionFromPB(req, repository.ContributionSourceRPC)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.contributionRepo.RecordContribution(ctx, contribution); err != nil {
+		return nil, fmt.Errorf("failed to record contribution: %w", err)
+	}
+
+	return &pb.RecordContributionResponse{
+		Contribution: contributionToPB(contribution),
+		Message:      "Contribution recorded successfully",
+	}, nil
+}
+
+// ImportContributions records a batch of ledger entries. Entries are validated and recorded
+// independently; failures are reported per entry and do not abort the rest of the batch.
+func (s *RequestService) ImportContributions(ctx context.Context, req *pb.ImportContributionsRequest) (*pb.ImportContributionsResponse, error) {
+	resp := &pb.ImportContributionsResponse{}
+
+	for i, entry := range req.GetEntries() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		err := s.entryRules.Validate(entry)
+		if err == nil {
+			var contribution *models.Contribution
+
+			contribution, err = contributionFromPB(entry, repository.ContributionSourceImport)
+			if err == nil {
+				err = s.contributionRepo.RecordContribution(ctx, contribution)
+			}
+		}
+
+		if err != nil {
+			resp.Errors = append(resp.Errors, &pb.ContributionImportError{
+				Index:   clampInt32(i),
+				Url:     entry.GetUrl(),
+				Message: status.Convert(err).Message(),
+			})
+
+			continue
+		}
+
+		resp.Imported++
+	}
+
+	return resp, nil
+}
+
+// ListContributions returns ledger entries, optionally filtered by user, project, request, outcome and date range.
+func (s *RequestService) ListContributions(ctx context.Context, req *pb.ListContributionsRequest) (*pb.ListContributionsResponse, error) {
+	limit, offset := pageBounds(req.GetPage(), req.GetLimit())
+
+	filter := repository.ContributionFilter{
+		UserID:            req.GetUserId(),
+		ApprovedProjectID: req.GetApprovedProjectId(),
+		RequestID:         req.GetRequestId(),
+		Outcome:           req.GetOutcome(),
+		Limit:             limit,
+		Offset:            offset,
+	}
+
+	var err error
+
+	if filter.From, err = parseOptionalTime("from", req.GetFrom()); err != nil {
+		return nil, err
+	}
+
+	if filter.To, err = parseOptionalTime("to", req.GetTo()); err != nil {
+		return nil, err
+	}
+
+	contributions, err := s.contributionRepo.ListContributions(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contributions: %w", err)
+	}
+
+	pbContributions := make([]*pb.Contribution, len(contributions))
+	for i, contribution := range contributions {
+		pbContributions[i] = contributionToPB(contribution)
+	}
+
+	return &pb.ListContributionsResponse{
+		Contributions: pbContributions,
+	}, nil
+}
+
+// GetContributionReport aggregates a calendar year of ledger entries by approved project or by
+// the contributor's team. The totals count each entry once either way.
+func (s *RequestService) GetContributionReport(ctx context.Context, req *pb.GetContributionReportRequest) (*pb.GetContributionReportResponse, error) {
+	byTeam := false
+
+	switch req.GetGroupBy() {
+	case "", "project":
+	case "team":
+		byTeam = true
+	default:
+		return nil, invalidArgument("group_by", "must be project or team")
+	}
+
+	year := int(req.GetYear())
+	if year == 0 {
+		year = time.Now().UTC().Year()
+	}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+
+	summaries, err := s.contributionRepo.GetContributionSummary(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build contribution report: %w", err)
+	}
+
+	resp := &pb.GetContributionReportResponse{
+		Year:     clampInt32(year),
+		Projects: make([]*pb.ContributionSummary, len(summaries)),
+	}
+
+	total, merged := 0, 0
+
+	for i, summary := range summaries {
+		pbSummary := &pb.ContributionSummary{
+			ProjectName:  summary.ProjectName,
+			Total:        clampInt32(summary.Total),
+			Merged:       clampInt32(summary.Merged),
+			Closed:       clampInt32(summary.Closed),
+			Open:         clampInt32(summary.Open),
+			Contributors: clampInt32(summary.Contributors),
+		}
+
+		if summary.ApprovedProjectID != nil {
+			pbSummary.ApprovedProjectId = *summary.ApprovedProjectID
+		}
+
+		resp.Projects[i] = pbSummary
+		total += summary.Total
+		merged += summary.Merged
+	}
+
+	resp.Total = clampInt32(total)
+	resp.Merged = clampInt32(merged)
+
+	if !byTeam {
+		return resp, nil
+	}
+
+	teams, err := s.contributionRepo.GetTeamContributionSummary(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build contribution report: %w", err)
+	}
+
+	resp.Projects = nil
+	resp.Teams = make([]*pb.TeamContributionSummary, len(teams))
+
+	for i, summary := range teams {
+		pbSummary := &pb.TeamContributionSummary{
+			TeamName:     summary.TeamName,
+			Total:        clampInt32(summary.Total),
+			Merged:       clampInt32(summary.Merged),
+			Closed:       clampInt32(summary.Closed),
+			Open:         clampInt32(summary.Open),
+			Contributors: clampInt32(summary.Contributors),
+		}
+
+		if summary.TeamID != nil {
+			pbSummary.TeamId = *summary.TeamID
+		}
+
+		resp.Teams[i] = pbSummary
+	}
+
+	return resp, nil
+}
+
+func contributionFromPB(req *pb.RecordContributionRequest, source string) (*models.Contribution, error) {
+	if req.GetRequestId() == "" {
+		return nil, invalidArgument("request_id", "is required")
+	}
+
+	if req.GetUrl() == "" {
+		return nil, invalidArgument("url", "is required")
+	}
+
+	kind := req.GetKind()
+	if kind == "" {
+		kind = repository.ContributionKindPullRequest
+	}
+
+	if kind != repository.ContributionKindPullRequest && kind != repository.ContributionKindCommit {
+		return nil, invalidArgument("kind", "must be pull_request or commit")
+	}
+
+	outcome := req.GetOutcome()
+	if outcome == "" {
+		outcome = repository.ContributionOutcomeOpen
+	}
+
+	switch outcome {
+	case repository.ContributionOutcomeOpen, repository.ContributionOutcomeMerged, repository.ContributionOutcomeClosed:
+	default:
+		return nil, invalidArgument("outcome", "must be open, merged or closed")
+	}
+
+	contributedAt, err := parseOptionalTime("contributed_at", req.GetContributedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedAt, err := parseOptionalTime("resolved_at", req.GetResolvedAt())
+	if err != nil {
+		return nil, err
+	}
+
+	contribution := &models.Contribution{
+		RequestID:     req.GetRequestId(),
+		Kind:          kind,
+		URL:           req.GetUrl(),
+		Title:         req.GetTitle(),
+		Outcome:       outcome,
+		ContributedAt: time.Now(),
+		ResolvedAt:    resolvedAt,
+		Source:        source,
+	}
+
+	if contributedAt != nil {
+		contribution.ContributedAt = *contributedAt
+	}
+
+	return contribution, nil
+}
+
+func contributionToPB(contribution *models.Contribution) *pb.Contribution {
+	pbContribution := &pb.Contribution{
+		Id:            contribution.ID,
+		RequestId:     contribution.RequestID,
+		UserId:        contribution.UserID,
+		Kind:          contribution.Kind,
+		Url:           contribution.URL,
+		Title:         contribution.Title,
+		Outcome:       contribution.Outcome,
+		ContributedAt: contribution.ContributedAt.Format(time.RFC3339),
+		Source:        contribution.Source,
+	}
+
+	if contribution.ApprovedProjectID != nil {
+		pbContribution.ApprovedProjectId = *contribution.ApprovedProjectID
+	}
+
+	if contribution.ResolvedAt != nil {
+		pbContribution.ResolvedAt = contribution.ResolvedAt.Format(time.RFC3339)
+	}
+
+	return pbContribution
+}
+
+// parseOptionalTime parses an optional RFC 3339 request field.
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, invalidArgument(field, "must be an RFC 3339 timestamp")
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/repository"
+	"sourcestream/backend/validation"
+)
+
+func TestImportContributions_ReportsInvalidEntries(t *testing.T) {
+	s := &RequestService{entryRules: validation.DefaultRules()}
+
+	resp, err := s.ImportContributions(context.Background(), &pb.ImportContributionsRequest{
+		Entries: []*pb.RecordContributionRequest{
+			{RequestId: "req-1", Url: "https://github.com/o/r/pull/1"},
+			{RequestId: "6f1c2d3e-4b5a-4c6d-8e7f-901a2b3c4d5e", Url: "not a url"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Zero(t, resp.GetImported())
+	require.Len(t, resp.GetErrors(), 2)
+	assert.Equal(t, int32(0), resp.GetErrors()[0].GetIndex())
+	assert.Equal(t, "invalid request_id: must be a UUID", resp.GetErrors()[0].GetMessage())
+	assert.Equal(t, int32(1), resp.GetErrors()[1].GetIndex())
+	assert.Equal(t, "invalid url: must be an http or https URL", resp.GetErrors()[1].GetMessage())
+}
+
+func TestContributionFromPB_InvalidArgument(t *testing.T) {
+	for field, req := range map[string]*pb.RecordContributionRequest{
+		"request_id":     {Url: "https://github.com/o/r/pull/1"},
+		"kind":           {RequestId: "req-1", Url: "https://github.com/o/r/pull/1", Kind: "issue"},
+		"contributed_at": {RequestId: "req-1", Url: "https://github.com/o/r/pull/1", ContributedAt: "yesterday"},
+	} {
+		_, err := contributionFromPB(req, repository.ContributionSourceRPC)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), field)
+		assert.Contains(t, status.Convert(err).Message(), "invalid "+field, field)
+	}
+}
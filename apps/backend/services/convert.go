@@ -0,0 +1,16 @@
+package services
+
+import "math"
+
+// clampInt32 converts a count to int32 for protobuf responses, saturating at the int32 range.
+func clampInt32(n int) int32 {
+	if n > math.MaxInt32 {
+		return math.MaxInt32
+	}
+
+	if n < math.MinInt32 {
+		return math.MinInt32
+	}
+
+	return int32(n) // #nosec G115 -- n is clamped to int32 range above
+}
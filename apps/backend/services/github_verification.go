@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"sourcestream/backend/models"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/provider"
+	"sourcestream/backend/repository"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartGithubVerification starts an OAuth device flow proving that the user owns their GitHub
+// username. The user enters the returned code at the verification URI, then calls
+// CompleteGithubVerification.
+func (s *UserService) StartGithubVerification(ctx context.Context, req *pb.StartGithubVerificationRequest) (*pb.StartGithubVerificationResponse, error) {
+	if s.accountVerifier == nil {
+		return nil, status.Error(codes.Unimplemented, "GitHub verification is not configured")
+	}
+
+	userID, err := subjectUserID(ctx, "user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.directoryUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.GithubUsername == "" {
+		return nil, failedPrecondition("GITHUB_USERNAME_MISSING", "github_username", "set a GitHub username before verifying it")
+	}
+
+	flow, err := s.accountVerifier.StartDeviceFlow(ctx, user.GithubUsername)
+	if err != nil {
+		log.Printf("failed to start GitHub verification for user %s: %v", user.ID, err)
+		return nil, status.Error(codes.Unavailable, "unable to start GitHub verification")
+	}
+
+	verification := &models.GithubVerification{
+		UserID:          user.ID,
+		GithubUsername:  user.GithubUsername,
+		DeviceCode:      flow.DeviceCode,
+		UserCode:        flow.UserCode,
+		VerificationURI: flow.VerificationURI,
+		IntervalSeconds: int(flow.Interval / time.Second),
+		ExpiresAt:       flow.ExpiresAt,
+	}
+
+	if err := s.verificationRepo.CreateGithubVerification(ctx, verification); err != nil {
+		return nil, fmt.Errorf("failed to create GitHub verification: %w", err)
+	}
+
+	return &pb.StartGithubVerificationResponse{Verification: githubVerificationToPB(verification)}, nil
+}
+
+// CompleteGithubVerification checks a pending verification with GitHub. It is verified when the
+// account that authorized the device flow is the user's GitHub username, and fails when another
+// account authorized it or the user declined.
+func (s *UserService) CompleteGithubVerification(ctx context.Context, req *pb.CompleteGithubVerificationRequest) (*pb.CompleteGithubVerificationResponse, error) {
+	if s.accountVerifier == nil {
+		return nil, status.Error(codes.Unimplemented, "GitHub verification is not configured")
+	}
+
+	userID, err := subjectUserID(ctx, "user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetVerificationId() == "" {
+		return nil, invalidArgument("verification_id", "is required")
+	}
+
+	verification, err := s.verificationRepo.GetGithubVerification(ctx, userID, req.GetVerificationId())
+	if errors.Is(err, repository.ErrGithubVerificationNotFound) {
+		return nil, status.Error(codes.NotFound, "GitHub verification not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub verification: %w", err)
+	}
+
+	if verification.Status == repository.GithubVerificationPending {
+		if err := s.pollGithubVerification(ctx, verification); err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := s.directoryUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CompleteGithubVerificationResponse{
+		Verification: githubVerificationToPB(verification),
+		User:         userToPB(user),
+	}, nil
+}
+
+// pollGithubVerification polls the device flow of a pending verification once and records the
+// outcome, if there is one yet.
+func (s *UserService) pollGithubVerification(ctx context.Context, verification *models.GithubVerification) error {
+	if !time.Now().Before(verification.ExpiresAt) {
+		return s.failGithubVerification(ctx, verification, repository.GithubVerificationExpired, "the code expired before it was entered")
+	}
+
+	login, err := s.accountVerifier.PollDeviceFlow(ctx, verification.DeviceCode)
+
+	switch {
+	case errors.Is(err, provider.ErrAuthorizationPending):
+		return nil
+	case errors.Is(err, provider.ErrAuthorizationExpired):
+		return s.failGithubVerification(ctx, verification, repository.GithubVerificationExpired, "the code expired before it was entered")
+	case errors.Is(err, provider.ErrAuthorizationDenied):
+		return s.failGithubVerification(ctx, verification, repository.GithubVerificationFailed, "the authorization was declined on GitHub")
+	case err != nil:
+		log.Printf("failed to poll GitHub verification %s: %v", verification.ID, err)
+		return status.Error(codes.Unavailable, "unable to check GitHub verification")
+	case !strings.EqualFold(login, verification.GithubUsername):
+		return s.failGithubVerification(ctx, verification, repository.GithubVerificationFailed,
+			fmt.Sprintf("authorized by GitHub account %s, not %s", login, verification.GithubUsername))
+	}
+
+	err = s.verificationRepo.VerifyGithubUsername(ctx, verification)
+	if errors.Is(err, repository.ErrGithubUsernameChanged) {
+		return s.failGithubVerification(ctx, verification, repository.GithubVerificationFailed,
+			"the GitHub username changed during verification")
+	}
+
+	if err != nil && !errors.Is(err, repository.ErrGithubVerificationNotFound) {
+		return fmt.Errorf("failed to record GitHub verification: %w", err)
+	}
+
+	return nil
+}
+
+func (s *UserService) failGithubVerification(ctx context.Context, verification *models.GithubVerification, result, reason string) error {
+	err := s.verificationRepo.FailGithubVerification(ctx, verification, result, reason)
+
+	// A concurrent poll already completed it
+	if err != nil && !errors.Is(err, repository.ErrGithubVerificationNotFound) {
+		return fmt.Errorf("failed to record GitHub verification: %w", err)
+	}
+
+	return nil
+}
+
+func githubVerificationToPB(v *models.GithubVerification) *pb.GithubVerification {
+	pbVerification := &pb.GithubVerification{
+		Id:              v.ID,
+		GithubUsername:  v.GithubUsername,
+		UserCode:        v.UserCode,
+		VerificationUri: v.VerificationURI,
+		ExpiresAt:       v.ExpiresAt.Format(time.RFC3339),
+		IntervalSeconds: clampInt32(v.IntervalSeconds),
+		Status:          v.Status,
+		FailureReason:   v.FailureReason,
+	}
+
+	if v.CompletedAt != nil {
+		pbVerification.CompletedAt = v.CompletedAt.Format(time.RFC3339)
+	}
+
+	return pbVerification
+}
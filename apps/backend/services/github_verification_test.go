@@ -0,0 +1,54 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"sourcestream/backend/models"
+	pb "sourcestream/backend/pb"
+)
+
+func TestRequireVerifiedGithub(t *testing.T) {
+	verifiedAt := time.Now()
+
+	tests := []struct {
+		name      string
+		user      *models.User
+		violation string
+	}{
+		{"verified", &models.User{GithubUsername: "alice", GithubVerifiedAt: &verifiedAt}, ""},
+		{"service account", &models.User{GithubUsername: "ci-bot", IsServiceAccount: true}, ""},
+		{"unverified", &models.User{GithubUsername: "alice"}, "GITHUB_UNVERIFIED"},
+		{"no username", &models.User{}, "GITHUB_USERNAME_MISSING"},
+	}
+
+	for _, tt := range tests {
+		err := requireVerifiedGithub(tt.user)
+		if tt.violation == "" {
+			assert.NoError(t, err, tt.name)
+			continue
+		}
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code(), tt.name)
+		require.Len(t, st.Details(), 1, tt.name)
+		assert.Equal(t, tt.violation, st.Details()[0].(*errdetails.PreconditionFailure).GetViolations()[0].GetType(), tt.name)
+	}
+}
+
+func TestApplyUserUpdate_ChangingGithubUsernameClearsVerification(t *testing.T) {
+	verifiedAt := time.Now()
+	user := &models.User{GithubUsername: "alice", GithubVerifiedAt: &verifiedAt}
+
+	applyUserUpdate(user, &pb.UpdateUserRequest{GithubUsername: proto.String("Alice")})
+	assert.NotNil(t, user.GithubVerifiedAt, "a change of case is the same account")
+
+	applyUserUpdate(user, &pb.UpdateUserRequest{GithubUsername: proto.String("alice-2")})
+	assert.Nil(t, user.GithubVerifiedAt)
+}
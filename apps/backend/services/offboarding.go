@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"sourcestream/backend/models"
+	"sourcestream/backend/offboarding"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/repository"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OffboardUser offboards a user who has left: they are deactivated, their projects and pending
+// reviews go to the successor (by default their department lead), their project access is revoked
+// and their pending requests are cancelled.
+func (s *UserService) OffboardUser(ctx context.Context, req *pb.OffboardUserRequest) (*pb.OffboardUserResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	initiatedBy, _ := actingUserID(ctx, "initiated_by", "")
+
+	result, err := s.offboarder.Offboard(ctx, offboarding.Request{
+		UserID:      req.GetUserId(),
+		SuccessorID: req.GetSuccessorId(),
+		InitiatedBy: initiatedBy,
+		Source:      repository.OffboardingSourceAdmin,
+		Reason:      strings.TrimSpace(req.GetReason()),
+	})
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	if errors.Is(err, offboarding.ErrInvalidSuccessor) {
+		return nil, invalidArgument("successor_id", err.Error())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to offboard user: %w", err)
+	}
+
+	return &pb.OffboardUserResponse{Report: offboardingToPB(result)}, nil
+}
+
+// GetOffboardingReport returns the report of a user's most recent offboarding.
+func (s *UserService) GetOffboardingReport(ctx context.Context, req *pb.GetOffboardingReportRequest) (*pb.GetOffboardingReportResponse, error) {
+	result, err := s.offboardingRepo.GetLatestOffboarding(ctx, req.GetUserId())
+	if errors.Is(err, repository.ErrOffboardingNotFound) {
+		return nil, status.Error(codes.NotFound, "user has not been offboarded")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get offboarding report: %w", err)
+	}
+
+	return &pb.GetOffboardingReportResponse{Report: offboardingToPB(result)}, nil
+}
+
+func offboardingToPB(o *models.Offboarding) *pb.OffboardingReport {
+	report := &pb.OffboardingReport{
+		Id:                    o.ID,
+		UserId:                o.UserID,
+		Source:                o.Source,
+		Reason:                o.Reason,
+		TransferredProjects:   offboardingItemsToPB(o.Report.TransferredProjects),
+		UntransferredProjects: offboardingItemsToPB(o.Report.UntransferredProjects),
+		RevokedAccess:         offboardingItemsToPB(o.Report.RevokedAccess),
+		CancelledRequests:     offboardingItemsToPB(o.Report.CancelledRequests),
+		ReassignedReviews:     offboardingItemsToPB(o.Report.ReassignedReviews),
+		CreatedAt:             o.CreatedAt.Format(time.RFC3339),
+	}
+
+	if o.SuccessorID != nil {
+		report.SuccessorId = *o.SuccessorID
+	}
+
+	if o.InitiatedBy != nil {
+		report.InitiatedBy = *o.InitiatedBy
+	}
+
+	return report
+}
+
+func offboardingItemsToPB(items []models.OffboardingItem) []*pb.OffboardingItem {
+	pbItems := make([]*pb.OffboardingItem, len(items))
+	for i, item := range items {
+		pbItems[i] = &pb.OffboardingItem{Id: item.ID, Name: item.Name, Detail: item.Detail}
+	}
+
+	return pbItems
+}
@@ -0,0 +1,481 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"sourcestream/backend/models"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/repository"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetDepartmentLead sets the user who takes over from offboarded members of a department.
+func (s *UserService) SetDepartmentLead(ctx context.Context, req *pb.SetDepartmentLeadRequest) (*pb.SetDepartmentLeadResponse, error) {
+	department := strings.TrimSpace(req.GetDepartment())
+	if department == "" {
+		return nil, invalidArgument("department", "is required")
+	}
+
+	lead, err := s.existingUser(ctx, "user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.orgRepo.SetDepartmentLead(ctx, department, lead.ID); err != nil {
+		return nil, fmt.Errorf("failed to set department lead: %w", err)
+	}
+
+	return &pb.SetDepartmentLeadResponse{
+		Message: fmt.Sprintf("%s is now the lead of %s", lead.CorporateID, department),
+	}, nil
+}
+
+// SetDepartmentChampion sets the OSPO champion of a department, to whom approval rules targeting
+// department_ospo_champion assign requests. An empty user_id clears the champion.
+func (s *UserService) SetDepartmentChampion(ctx context.Context, req *pb.SetDepartmentChampionRequest) (*pb.SetDepartmentChampionResponse, error) {
+	department := strings.TrimSpace(req.GetDepartment())
+	if department == "" {
+		return nil, invalidArgument("department", "is required")
+	}
+
+	var championID *string
+
+	if req.GetUserId() != "" {
+		champion, err := s.existingUser(ctx, "user_id", req.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+
+		championID = &champion.ID
+	}
+
+	if err := s.orgRepo.SetDepartmentChampion(ctx, department, championID); err != nil {
+		return nil, fmt.Errorf("failed to set department champion: %w", err)
+	}
+
+	updated, err := s.orgRepo.GetDepartmentByName(ctx, department)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get department: %w", err)
+	}
+
+	return &pb.SetDepartmentChampionResponse{Department: departmentToPB(updated)}, nil
+}
+
+// ListDepartments returns every department.
+func (s *UserService) ListDepartments(ctx context.Context, _ *pb.ListDepartmentsRequest) (*pb.ListDepartmentsResponse, error) {
+	departments, err := s.orgRepo.ListDepartments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list departments: %w", err)
+	}
+
+	pbDepartments := make([]*pb.Department, len(departments))
+	for i, department := range departments {
+		pbDepartments[i] = departmentToPB(department)
+	}
+
+	return &pb.ListDepartmentsResponse{Departments: pbDepartments}, nil
+}
+
+// CreateTeam creates a team, in a department when one is given.
+func (s *UserService) CreateTeam(ctx context.Context, req *pb.CreateTeamRequest) (*pb.CreateTeamResponse, error) {
+	team := &models.Team{
+		Name:           strings.TrimSpace(req.GetName()),
+		DepartmentName: strings.TrimSpace(req.GetDepartment()),
+		MemberIDs:      []string{},
+	}
+
+	if team.Name == "" {
+		return nil, invalidArgument("name", "is required")
+	}
+
+	err := s.orgRepo.CreateTeam(ctx, team)
+	if errors.Is(err, repository.ErrDuplicateTeam) {
+		return nil, alreadyExists("name", err.Error())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create team: %w", err)
+	}
+
+	return &pb.CreateTeamResponse{Team: teamToPB(team)}, nil
+}
+
+// GetTeam returns a team with its members.
+func (s *UserService) GetTeam(ctx context.Context, req *pb.GetTeamRequest) (*pb.GetTeamResponse, error) {
+	team, err := s.team(ctx, req.GetTeamId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTeamResponse{Team: teamToPB(team)}, nil
+}
+
+// ListTeams returns the teams, optionally only those of a department or a user.
+func (s *UserService) ListTeams(ctx context.Context, req *pb.ListTeamsRequest) (*pb.ListTeamsResponse, error) {
+	department := strings.TrimSpace(req.GetDepartment())
+
+	var (
+		teams []*models.Team
+		err   error
+	)
+
+	if req.GetUserId() != "" {
+		teams, err = s.orgRepo.GetUserTeams(ctx, req.GetUserId())
+	} else {
+		teams, err = s.orgRepo.ListTeams(ctx, department)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	pbTeams := make([]*pb.Team, 0, len(teams))
+
+	for _, team := range teams {
+		// A user's teams are not filtered by department in the query
+		if department != "" && team.DepartmentName != department {
+			continue
+		}
+
+		pbTeams = append(pbTeams, teamToPB(team))
+	}
+
+	return &pb.ListTeamsResponse{Teams: pbTeams}, nil
+}
+
+// AddTeamMember adds a user to a team.
+func (s *UserService) AddTeamMember(ctx context.Context, req *pb.AddTeamMemberRequest) (*pb.AddTeamMemberResponse, error) {
+	if req.GetTeamId() == "" {
+		return nil, invalidArgument("team_id", "is required")
+	}
+
+	member, err := s.existingUser(ctx, "user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.orgRepo.AddTeamMember(ctx, req.GetTeamId(), member.ID)
+	if errors.Is(err, repository.ErrTeamNotFound) {
+		return nil, status.Error(codes.NotFound, "team not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to add team member: %w", err)
+	}
+
+	team, err := s.team(ctx, req.GetTeamId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddTeamMemberResponse{Team: teamToPB(team)}, nil
+}
+
+// RemoveTeamMember removes a user from a team.
+func (s *UserService) RemoveTeamMember(ctx context.Context, req *pb.RemoveTeamMemberRequest) (*pb.RemoveTeamMemberResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	team, err := s.team(ctx, req.GetTeamId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.orgRepo.RemoveTeamMember(ctx, team.ID, req.GetUserId()); err != nil {
+		return nil, fmt.Errorf("failed to remove team member: %w", err)
+	}
+
+	if team, err = s.team(ctx, team.ID); err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveTeamMemberResponse{Team: teamToPB(team)}, nil
+}
+
+// SetManager sets or, with an empty manager_id, clears a user's manager, to whom approval rules
+// targeting requester_manager assign the user's requests.
+func (s *UserService) SetManager(ctx context.Context, req *pb.SetManagerRequest) (*pb.SetManagerResponse, error) {
+	user, err := s.existingUser(ctx, "user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	var managerID *string
+
+	if req.GetManagerId() != "" {
+		manager, err := s.existingUser(ctx, "manager_id", req.GetManagerId())
+		if err != nil {
+			return nil, err
+		}
+
+		managerID = &manager.ID
+	}
+
+	err = s.orgRepo.SetManager(ctx, user.ID, managerID)
+	if errors.Is(err, repository.ErrManagerCycle) {
+		return nil, invalidArgument("manager_id", err.Error())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to set manager: %w", err)
+	}
+
+	user.ManagerID = managerID
+
+	return &pb.SetManagerResponse{User: userToPB(user)}, nil
+}
+
+// ImportOrgChart applies an HR org chart export. Users are placed in their departments and teams
+// first and managers are set afterwards, so that a manager may appear after their reports.
+// Entries are applied independently; failures are reported per line and do not abort the rest of
+// the import.
+func (s *UserService) ImportOrgChart(ctx context.Context, req *pb.ImportOrgChartRequest) (*pb.ImportOrgChartResponse, error) {
+	rows, err := parseOrgChart(req.GetCsv())
+	if err != nil {
+		return nil, invalidArgument("csv", err.Error())
+	}
+
+	resp := &pb.ImportOrgChartResponse{}
+	failed := map[int]bool{}
+	userIDs := map[string]string{}
+
+	fail := func(row orgChartRow, prefix string, err error) {
+		failed[row.line] = true
+		resp.Errors = append(resp.Errors, &pb.OrgChartImportError{
+			Line:        clampInt32(row.line),
+			CorporateId: row.entry.CorporateID,
+			Message:     prefix + orgChartRowMessage(row, err),
+		})
+	}
+
+	lookup := func(corporateID string) (string, error) {
+		if id, ok := userIDs[corporateID]; ok {
+			return id, nil
+		}
+
+		user, err := s.userRepo.GetUserByCorporateID(ctx, corporateID)
+		if err != nil {
+			return "", err
+		}
+
+		userIDs[corporateID] = user.ID
+
+		return user.ID, nil
+	}
+
+	for _, row := range rows {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		userID, err := lookup(row.entry.CorporateID)
+		if err == nil {
+			err = s.orgRepo.PlaceUser(ctx, userID, &row.entry)
+		}
+
+		if err != nil {
+			fail(row, "", err)
+		}
+	}
+
+	for _, row := range rows {
+		if failed[row.line] || row.entry.ManagerCorporateID == "" {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		managerID, err := lookup(row.entry.ManagerCorporateID)
+		if err != nil {
+			fail(row, "manager: ", err)
+			continue
+		}
+
+		if err := s.orgRepo.SetManager(ctx, userIDs[row.entry.CorporateID], &managerID); err != nil {
+			fail(row, "", err)
+		}
+	}
+
+	resp.Imported = clampInt32(len(rows) - len(failed))
+
+	return resp, nil
+}
+
+// orgChartRowMessage describes why an org chart row failed to import. Missing users, conflicts and
+// broken references are the row's own fault and are described; anything else is logged and
+// reported as an internal error, so that database error text does not reach the caller.
+func orgChartRowMessage(row orgChartRow, err error) string {
+	var (
+		notFound  *repository.NotFoundError
+		conflict  *repository.ConflictError
+		reference *repository.ReferenceError
+	)
+
+	switch err = repository.TranslateError(err); {
+	case errors.As(err, &notFound):
+		if notFound.ID != "" {
+			return fmt.Sprintf("unknown %s %s", notFound.Resource, notFound.ID)
+		}
+
+		return notFound.Error()
+	case errors.As(err, &conflict):
+		return conflict.Error()
+	case errors.As(err, &reference):
+		return reference.Error()
+	case errors.Is(err, repository.ErrManagerCycle):
+		return repository.ErrManagerCycle.Error()
+	}
+
+	log.Printf("org: failed to import org chart line %d for %s: %v", row.line, row.entry.CorporateID, err)
+
+	return "internal error"
+}
+
+// orgChartRow is an org chart entry and the CSV line it was read from.
+type orgChartRow struct {
+	line  int
+	entry models.OrgChartEntry
+}
+
+// parseOrgChart reads an org chart CSV. The header row names the columns in any order and case;
+// corporate_id is required and unknown columns are ignored.
+func parseOrgChart(data []byte) ([]orgChartRow, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("is empty")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	if _, ok := columns["corporate_id"]; !ok {
+		return nil, fmt.Errorf("has no corporate_id column")
+	}
+
+	var rows []orgChartRow
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+
+			return ""
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := orgChartRow{line: line, entry: models.OrgChartEntry{
+			CorporateID:        field("corporate_id"),
+			Department:         field("department"),
+			Team:               field("team"),
+			ManagerCorporateID: field("manager_corporate_id"),
+		}}
+
+		if row.entry.CorporateID == "" {
+			return nil, fmt.Errorf("line %d has no corporate_id", line)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// existingUser looks up the user a request field refers to.
+func (s *UserService) existingUser(ctx context.Context, field, id string) (*models.User, error) {
+	if id == "" {
+		return nil, invalidArgument(field, "is required")
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, id)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return nil, invalidArgument(field, "must be an existing user")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+func (s *UserService) team(ctx context.Context, id string) (*models.Team, error) {
+	if id == "" {
+		return nil, invalidArgument("team_id", "is required")
+	}
+
+	team, err := s.orgRepo.GetTeam(ctx, id)
+	if errors.Is(err, repository.ErrTeamNotFound) {
+		return nil, status.Error(codes.NotFound, "team not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	return team, nil
+}
+
+func departmentToPB(department *models.Department) *pb.Department {
+	pbDepartment := &pb.Department{
+		Id:      department.ID,
+		Name:    department.Name,
+		Members: clampInt32(department.Members),
+	}
+
+	if department.LeadID != nil {
+		pbDepartment.LeadId = *department.LeadID
+	}
+
+	if department.OSPOChampionID != nil {
+		pbDepartment.OspoChampionId = *department.OSPOChampionID
+	}
+
+	return pbDepartment
+}
+
+func teamToPB(team *models.Team) *pb.Team {
+	pbTeam := &pb.Team{
+		Id:         team.ID,
+		Name:       team.Name,
+		Department: team.DepartmentName,
+		MemberIds:  team.MemberIDs,
+	}
+
+	if team.DepartmentID != nil {
+		pbTeam.DepartmentId = *team.DepartmentID
+	}
+
+	return pbTeam
+}
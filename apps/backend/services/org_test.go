@@ -0,0 +1,60 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sourcestream/backend/models"
+	"sourcestream/backend/repository"
+)
+
+func TestParseOrgChart(t *testing.T) {
+	csv := "Employee Name,Corporate_ID,Department,Team,Manager_Corporate_ID\n" +
+		"Alice Smith,alice,Platform,Storage,carol\n" +
+		"\"Bob, Jr.\",bob, ,,\n" +
+		"Carol,carol,Platform\n"
+
+	rows, err := parseOrgChart([]byte(csv))
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, 2, rows[0].line)
+	assert.Equal(t, models.OrgChartEntry{
+		CorporateID: "alice", Department: "Platform", Team: "Storage", ManagerCorporateID: "carol",
+	}, rows[0].entry)
+	assert.Equal(t, models.OrgChartEntry{CorporateID: "bob"}, rows[1].entry)
+	assert.Equal(t, models.OrgChartEntry{CorporateID: "carol", Department: "Platform"}, rows[2].entry)
+}
+
+func TestParseOrgChart_Invalid(t *testing.T) {
+	for name, csv := range map[string]string{
+		"empty":                "",
+		"no corporate_id":      "department,team\nPlatform,Storage\n",
+		"missing corporate_id": "corporate_id,team\nalice,Storage\n,Storage\n",
+		"malformed":            "corporate_id,team\n\"alice,Storage\n",
+	} {
+		_, err := parseOrgChart([]byte(csv))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestOrgChartRowMessage(t *testing.T) {
+	row := orgChartRow{line: 2, entry: models.OrgChartEntry{CorporateID: "jdoe"}}
+
+	tests := map[string]error{
+		"unknown user mgr1": &repository.NotFoundError{Resource: "user", ID: "mgr1"},
+		"a team with this name already exists": &pq.Error{
+			Code: "23505", Table: "teams", Constraint: "teams_name_key", Detail: "Key (name)=(Platform) already exists.",
+		},
+		"manager would create a reporting cycle": fmt.Errorf("set manager: %w", repository.ErrManagerCycle),
+		"internal error":                         errors.New(`pq: relation "team_members" does not exist`),
+	}
+
+	for want, err := range tests {
+		assert.Equal(t, want, orgChartRowMessage(row, err))
+	}
+}
@@ -0,0 +1,24 @@
+package services
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// pageBounds converts 1-based page/limit request fields into a SQL limit and offset.
+func pageBounds(page, limit int32) (int, int) {
+	size := int(limit)
+	if size <= 0 {
+		size = defaultPageSize
+	}
+
+	if size > maxPageSize {
+		size = maxPageSize
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	return size, (int(page) - 1) * size
+}
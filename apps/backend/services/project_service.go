@@ -3,11 +3,16 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"sourcestream/backend/models"
 	pb "sourcestream/backend/pb"
+	"sourcestream/backend/provider"
 	"sourcestream/backend/repository"
 
 	"github.com/google/uuid"
@@ -16,18 +21,27 @@ import (
 // ProjectService implements the gRPC ProjectService server.
 type ProjectService struct {
 	pb.UnimplementedProjectServiceServer
-	projectRepo *repository.ProjectRepository
+	projectRepo         *repository.ProjectRepository
+	approvedProjectRepo *repository.ApprovedProjectRepository
+	gitProvider         provider.Provider
 }
 
-// NewProjectService creates a new ProjectService with the given database.
-func NewProjectService(db *sql.DB) *ProjectService {
+// NewProjectService creates a new ProjectService with the given database and Git hosting provider.
+func NewProjectService(db *sql.DB, gitProvider provider.Provider) *ProjectService {
 	return &ProjectService{
-		projectRepo: repository.NewProjectRepository(db),
+		projectRepo:         repository.NewProjectRepository(db),
+		approvedProjectRepo: repository.NewApprovedProjectRepository(db),
+		gitProvider:         gitProvider,
 	}
 }
 
 // GetAuthoredProjects returns projects authored by the specified user.
-func (s *ProjectService) GetAuthoredProjects(_ context.Context, req *pb.GetAuthoredProjectsRequest) (*pb.GetAuthoredProjectsResponse, error) {
+func (s *ProjectService) GetAuthoredProjects(ctx context.Context, req *pb.GetAuthoredProjectsRequest) (*pb.GetAuthoredProjectsResponse, error) {
+	userID, err := subjectUserID(ctx, "user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
 	// Mock data - in real app, fetch from database
 	projects := []*pb.Project{
 		{
@@ -38,7 +52,7 @@ func (s *ProjectService) GetAuthoredProjects(_ context.Context, req *pb.GetAutho
 			LastActivity: "2 days ago",
 			Url:          "https://github.com/company/react-components",
 			License:      "MIT",
-			OwnerId:      req.GetUserId(),
+			OwnerId:      userID,
 		},
 		{
 			Id:           "2",
@@ -48,7 +62,7 @@ func (s *ProjectService) GetAuthoredProjects(_ context.Context, req *pb.GetAutho
 			LastActivity: "1 week ago",
 			Url:          "https://github.com/company/api-gateway",
 			License:      "Apache-2.0",
-			OwnerId:      req.GetUserId(),
+			OwnerId:      userID,
 		},
 		{
 			Id:           "3",
@@ -58,7 +72,7 @@ func (s *ProjectService) GetAuthoredProjects(_ context.Context, req *pb.GetAutho
 			LastActivity: "3 days ago",
 			Url:          "https://github.com/company/analytics-tool",
 			License:      "MIT",
-			OwnerId:      req.GetUserId(),
+			OwnerId:      userID,
 		},
 	}
 
@@ -74,7 +88,7 @@ func (s *ProjectService) GetAuthoredProjects(_ context.Context, req *pb.GetAutho
 }
 
 // GetContributedProjects returns projects the user contributes to.
-func (s *ProjectService) GetContributedProjects(_ context.Context, _ *pb.GetContributedProjectsRequest) (*pb.GetContributedProjectsResponse, error) {
+func (s *ProjectService) GetContributedProjects(ctx context.Context, _ *pb.GetContributedProjectsRequest) (*pb.GetContributedProjectsResponse, error) {
 	// Mock data - in real app, fetch from database
 	projects := []*pb.Project{
 		{
@@ -131,7 +145,7 @@ func (s *ProjectService) GetContributedProjects(_ context.Context, _ *pb.GetCont
 }
 
 // GetApprovedProjects returns approved projects relevant to the user.
-func (s *ProjectService) GetApprovedProjects(_ context.Context, _ *pb.GetApprovedProjectsRequest) (*pb.GetApprovedProjectsResponse, error) {
+func (s *ProjectService) GetApprovedProjects(ctx context.Context, _ *pb.GetApprovedProjectsRequest) (*pb.GetApprovedProjectsResponse, error) {
 	// Mock data - in real app, fetch from database
 	projects := []*pb.Project{
 		{
@@ -198,7 +212,12 @@ func (s *ProjectService) GetApprovedProjects(_ context.Context, _ *pb.GetApprove
 }
 
 // CreateProject creates a new project.
-func (s *ProjectService) CreateProject(_ context.Context, req *pb.CreateProjectRequest) (*pb.CreateProjectResponse, error) {
+func (s *ProjectService) CreateProject(ctx context.Context, req *pb.CreateProjectRequest) (*pb.CreateProjectResponse, error) {
+	ownerID, err := actingUserID(ctx, "owner_id", req.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
 	// Mock implementation - in real app, save to database
 	project := &models.Project{
 		ID:          uuid.New().String(),
@@ -206,7 +225,7 @@ func (s *ProjectService) CreateProject(_ context.Context, req *pb.CreateProjectR
 		Description: req.GetDescription(),
 		URL:         req.GetUrl(),
 		License:     req.GetLicense(),
-		OwnerID:     req.GetOwnerId(),
+		OwnerID:     ownerID,
 		Status:      "pending",
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
@@ -231,122 +250,268 @@ func (s *ProjectService) CreateProject(_ context.Context, req *pb.CreateProjectR
 	}, nil
 }
 
-// GetApprovedProjectsList returns the catalog of pre-approved projects.
-func (s *ProjectService) GetApprovedProjectsList(_ context.Context, req *pb.GetApprovedProjectsListRequest) (*pb.GetApprovedProjectsListResponse, error) {
-	// Mock data - in real app, fetch from approved_projects table
-	approvedProjects := []*pb.ApprovedProject{
-		{
-			Id:                       "1",
-			Name:                     "React",
-			Description:              "A JavaScript library for building user interfaces",
-			RepositoryUrl:            "https://github.com/facebook/react",
-			License:                  "MIT",
-			ContributionType:         "CLA",
-			MaintainerContact:        "react-team@meta.com",
-			ApprovalDate:             "2024-01-15T00:00:00Z",
-			IsActive:                 true,
-			AllowedContributionTypes: []string{"bug-fix", "feature", "documentation", "testing"},
-		},
-		{
-			Id:                       "2",
-			Name:                     "Vue.js",
-			Description:              "The Progressive JavaScript Framework",
-			RepositoryUrl:            "https://github.com/vuejs/vue",
-			License:                  "MIT",
-			ContributionType:         "DCO",
-			MaintainerContact:        "team@vuejs.org",
-			ApprovalDate:             "2024-01-10T00:00:00Z",
-			IsActive:                 true,
-			AllowedContributionTypes: []string{"bug-fix", "feature", "documentation", "testing", "maintenance"},
-		},
-		{
-			Id:                       "3",
-			Name:                     "Angular",
-			Description:              "Deliver web apps with confidence",
-			RepositoryUrl:            "https://github.com/angular/angular",
-			License:                  "MIT",
-			ContributionType:         "CLA",
-			MaintainerContact:        "angular-team@google.com",
-			ApprovalDate:             "2024-01-20T00:00:00Z",
-			IsActive:                 true,
-			AllowedContributionTypes: []string{"bug-fix", "feature", "documentation", "testing"},
-		},
-		{
-			Id:                       "4",
-			Name:                     "Node.js",
-			Description:              "Node.js JavaScript runtime",
-			RepositoryUrl:            "https://github.com/nodejs/node",
-			License:                  "MIT",
-			ContributionType:         "DCO",
-			MaintainerContact:        "nodejs-team@nodejs.org",
-			ApprovalDate:             "2024-01-25T00:00:00Z",
-			IsActive:                 true,
-			AllowedContributionTypes: []string{"bug-fix", "feature", "documentation", "testing", "maintenance"},
-		},
-		{
-			Id:                       "5",
-			Name:                     "TypeScript",
-			Description:              "TypeScript is a superset of JavaScript",
-			RepositoryUrl:            "https://github.com/microsoft/TypeScript",
-			License:                  "Apache-2.0",
-			ContributionType:         "CLA",
-			MaintainerContact:        "typescript@microsoft.com",
-			ApprovalDate:             "2024-02-01T00:00:00Z",
-			IsActive:                 true,
-			AllowedContributionTypes: []string{"bug-fix", "feature", "documentation", "testing"},
-		},
-		{
-			Id:                       "6",
-			Name:                     "Kubernetes",
-			Description:              "Production-Grade Container Scheduling and Management",
-			RepositoryUrl:            "https://github.com/kubernetes/kubernetes",
-			License:                  "Apache-2.0",
-			ContributionType:         "CLA",
-			MaintainerContact:        "kubernetes-dev@googlegroups.com",
-			ApprovalDate:             "2024-02-05T00:00:00Z",
-			IsActive:                 true,
-			AllowedContributionTypes: []string{"bug-fix", "feature", "documentation", "testing", "maintenance"},
-		},
-		{
-			Id:                       "7",
-			Name:                     "Docker",
-			Description:              "Docker container platform",
-			RepositoryUrl:            "https://github.com/docker/docker-ce",
-			License:                  "Apache-2.0",
-			ContributionType:         "DCO",
-			MaintainerContact:        "docker-maintainers@docker.com",
-			ApprovalDate:             "2024-02-10T00:00:00Z",
-			IsActive:                 true,
-			AllowedContributionTypes: []string{"bug-fix", "feature", "documentation", "testing"},
-		},
-		{
-			Id:                       "8",
-			Name:                     "Webpack",
-			Description:              "A bundler for javascript and friends",
-			RepositoryUrl:            "https://github.com/webpack/webpack",
-			License:                  "MIT",
-			ContributionType:         "DCO",
-			MaintainerContact:        "webpack-team@webpack.js.org",
-			ApprovalDate:             "2024-02-15T00:00:00Z",
-			IsActive:                 true,
-			AllowedContributionTypes: []string{"bug-fix", "feature", "documentation", "testing", "maintenance"},
-		},
+// GetApprovedProjectsList returns a filtered, sorted page of the catalog of pre-approved projects.
+func (s *ProjectService) GetApprovedProjectsList(ctx context.Context, req *pb.GetApprovedProjectsListRequest) (*pb.GetApprovedProjectsListResponse, error) {
+	sortBy := req.GetSortBy()
+	if sortBy == "" {
+		sortBy = repository.ApprovedProjectSortName
 	}
 
-	// Filter by active status if requested
-	if req.GetActiveOnly() {
-		var activeProjects []*pb.ApprovedProject
+	if sortBy != repository.ApprovedProjectSortName && sortBy != repository.ApprovedProjectSortApprovalDate {
+		return nil, invalidArgument("sort_by", "must be name or approval_date")
+	}
 
-		for _, project := range approvedProjects {
-			if project.GetIsActive() {
-				activeProjects = append(activeProjects, project)
-			}
+	pageSize, _ := pageBounds(1, req.GetPageSize())
+
+	filter := repository.ApprovedProjectFilter{
+		ActiveOnly:              req.GetActiveOnly(),
+		License:                 req.GetLicense(),
+		ContributionType:        req.GetContributionType(),
+		AllowedContributionType: req.GetAllowedContributionType(),
+		Query:                   req.GetQuery(),
+		SortBy:                  sortBy,
+		Descending:              req.GetDescending(),
+		Limit:                   pageSize + 1, // fetch one extra row to detect a following page
+	}
+
+	query := approvedProjectQueryKey(filter)
+
+	if req.GetPageToken() != "" {
+		cursor, err := decodeApprovedProjectCursor(req.GetPageToken(), query)
+		if err != nil {
+			return nil, invalidArgument("page_token", err.Error())
 		}
 
-		approvedProjects = activeProjects
+		filter.After = cursor
+	}
+
+	projects, err := s.approvedProjectRepo.ListApprovedProjects(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list approved projects: %w", err)
+	}
+
+	var nextPageToken string
+
+	if len(projects) > pageSize {
+		projects = projects[:pageSize]
+		last := projects[pageSize-1]
+
+		nextPageToken, err = encodeApprovedProjectCursor(&repository.ApprovedProjectCursor{
+			Name:         last.Name,
+			ApprovalDate: last.ApprovalDate,
+			ID:           last.ID,
+		}, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %w", err)
+		}
+	}
+
+	approvedProjects := make([]*pb.ApprovedProject, len(projects))
+	for i, project := range projects {
+		approvedProjects[i] = approvedProjectToPB(project)
 	}
 
 	return &pb.GetApprovedProjectsListResponse{
-		Projects: approvedProjects,
+		Projects:      approvedProjects,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ListRecertificationTasks returns re-certification tasks for approved projects, optionally filtered by status.
+func (s *ProjectService) ListRecertificationTasks(ctx context.Context, req *pb.ListRecertificationTasksRequest) (*pb.ListRecertificationTasksResponse, error) {
+	limit, offset := pageBounds(req.GetPage(), req.GetLimit())
+
+	tasks, err := s.approvedProjectRepo.ListRecertificationTasks(ctx, req.GetStatus(), limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recertification tasks: %w", err)
+	}
+
+	pbTasks := make([]*pb.RecertificationTask, len(tasks))
+	for i, task := range tasks {
+		pbTasks[i] = recertificationTaskToPB(task)
+	}
+
+	return &pb.ListRecertificationTasksResponse{
+		Tasks: pbTasks,
+	}, nil
+}
+
+// CompleteRecertification records an OSPO admin's re-certification decision for an approved project.
+func (s *ProjectService) CompleteRecertification(ctx context.Context, req *pb.CompleteRecertificationRequest) (*pb.CompleteRecertificationResponse, error) {
+	reviewerID, err := actingUserID(ctx, "reviewer_id", req.GetReviewerId())
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.GetDecision() {
+	case "recertify":
+		err := s.approvedProjectRepo.RecertifyApprovedProject(ctx, req.GetTaskId(), reviewerID, req.GetNotes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to recertify approved project: %w", err)
+		}
+
+		return &pb.CompleteRecertificationResponse{
+			Message: "Approved project recertified successfully",
+		}, nil
+	case "retire":
+		flagged, err := s.approvedProjectRepo.RetireApprovedProject(ctx, req.GetTaskId(), reviewerID, req.GetNotes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to retire approved project: %w", err)
+		}
+
+		return &pb.CompleteRecertificationResponse{
+			Message:         "Approved project retired successfully",
+			FlaggedRequests: clampInt32(int(flagged)),
+		}, nil
+	default:
+		return nil, invalidArgument("decision", "must be recertify or retire")
+	}
+}
+
+// AddProjectContributor adds a user to a project as a contributor or maintainer. Re-adding an
+// existing contributor changes their role.
+func (s *ProjectService) AddProjectContributor(ctx context.Context, req *pb.AddProjectContributorRequest) (*pb.AddProjectContributorResponse, error) {
+	role := req.GetRole()
+	if role == "" {
+		role = "contributor"
+	}
+
+	if role != "contributor" && role != "maintainer" {
+		return nil, invalidArgument("role", "must be contributor or maintainer")
+	}
+
+	project, err := s.projectRepo.GetProjectByID(ctx, req.GetProjectId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if project.OwnerID == req.GetUserId() {
+		return nil, invalidArgument("user_id", "is the project owner")
+	}
+
+	if err := s.projectRepo.AddContributor(ctx, project.ID, req.GetUserId(), role, nil); err != nil {
+		return nil, fmt.Errorf("failed to add project contributor: %w", err)
+	}
+
+	return &pb.AddProjectContributorResponse{
+		Message: fmt.Sprintf("Added %s to %s as %s", req.GetUserId(), project.Name, role),
 	}, nil
 }
+
+// RemoveProjectContributor removes a user from a project. The project owner cannot be removed.
+func (s *ProjectService) RemoveProjectContributor(ctx context.Context, req *pb.RemoveProjectContributorRequest) (*pb.RemoveProjectContributorResponse, error) {
+	project, err := s.projectRepo.GetProjectByID(ctx, req.GetProjectId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if project.OwnerID == req.GetUserId() {
+		return nil, invalidArgument("user_id", "is the project owner")
+	}
+
+	if err := s.projectRepo.RemoveContributor(ctx, project.ID, req.GetUserId()); err != nil {
+		return nil, fmt.Errorf("failed to remove project contributor: %w", err)
+	}
+
+	return &pb.RemoveProjectContributorResponse{
+		Message: fmt.Sprintf("Removed %s from %s", req.GetUserId(), project.Name),
+	}, nil
+}
+
+func recertificationTaskToPB(task *models.RecertificationTask) *pb.RecertificationTask {
+	pbTask := &pb.RecertificationTask{
+		Id:                task.ID,
+		ApprovedProjectId: task.ApprovedProjectID,
+		ProjectName:       task.ProjectName,
+		Status:            task.Status,
+		DueDate:           task.DueDate.Format(time.RFC3339),
+	}
+
+	if task.ReviewerID != nil {
+		pbTask.ReviewerId = *task.ReviewerID
+	}
+
+	if task.Notes != nil {
+		pbTask.Notes = *task.Notes
+	}
+
+	if task.CompletedAt != nil {
+		pbTask.CompletedAt = task.CompletedAt.Format(time.RFC3339)
+	}
+
+	return pbTask
+}
+
+func approvedProjectToPB(project *models.ApprovedProject) *pb.ApprovedProject {
+	pbProject := &pb.ApprovedProject{
+		Id:                       project.ID,
+		Name:                     project.Name,
+		Description:              project.Description,
+		RepositoryUrl:            project.RepositoryURL,
+		License:                  project.License,
+		ContributionType:         project.ContributionType,
+		MaintainerContact:        project.MaintainerContact,
+		ApprovalDate:             project.ApprovalDate.Format(time.RFC3339),
+		IsActive:                 project.IsActive,
+		AllowedContributionTypes: project.AllowedContributionTypes,
+		ReviewIntervalDays:       clampInt32(project.ReviewIntervalDays),
+		NextReviewDate:           project.NextReviewDate.Format(time.RFC3339),
+	}
+
+	if project.LastReviewedAt != nil {
+		pbProject.LastReviewedAt = project.LastReviewedAt.Format(time.RFC3339)
+	}
+
+	return pbProject
+}
+
+// approvedProjectPageToken is the content of a catalog page token: the keyset cursor and the key
+// of the query it belongs to.
+type approvedProjectPageToken struct {
+	repository.ApprovedProjectCursor
+	Query string `json:"q"`
+}
+
+// approvedProjectQueryKey identifies the sort order and filters of a catalog query, so that a page
+// token is only accepted for the query that produced it.
+func approvedProjectQueryKey(filter repository.ApprovedProjectFilter) string {
+	key, _ := json.Marshal([]interface{}{
+		filter.SortBy, filter.Descending, filter.ActiveOnly, filter.License,
+		filter.ContributionType, filter.AllowedContributionType, filter.Query,
+	})
+	sum := sha256.Sum256(key)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+// encodeApprovedProjectCursor serializes a keyset cursor for the query with the given key into an
+// opaque page token.
+func encodeApprovedProjectCursor(cursor *repository.ApprovedProjectCursor, query string) (string, error) {
+	data, err := json.Marshal(&approvedProjectPageToken{ApprovedProjectCursor: *cursor, Query: query})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeApprovedProjectCursor parses a page token produced by encodeApprovedProjectCursor. A token
+// produced for a different sort order or different filters is rejected, since continuing from it
+// would skip or repeat rows.
+func decodeApprovedProjectCursor(token, query string) (*repository.ApprovedProjectCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("malformed page token")
+	}
+
+	pageToken := &approvedProjectPageToken{}
+	if err := json.Unmarshal(data, pageToken); err != nil || pageToken.ID == "" {
+		return nil, fmt.Errorf("malformed page token")
+	}
+
+	if pageToken.Query != query {
+		return nil, fmt.Errorf("the page token was issued for a different sort order or filters")
+	}
+
+	return &pageToken.ApprovedProjectCursor, nil
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"sourcestream/backend/repository"
+)
+
+func TestApprovedProjectCursorRoundTrip(t *testing.T) {
+	cursor := &repository.ApprovedProjectCursor{
+		Name:         "Kubernetes",
+		ApprovalDate: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC),
+		ID:           "660e8400-e29b-41d4-a716-446655440006",
+	}
+
+	filter := repository.ApprovedProjectFilter{SortBy: repository.ApprovedProjectSortName, License: "MIT"}
+	query := approvedProjectQueryKey(filter)
+
+	token, err := encodeApprovedProjectCursor(cursor, query)
+	assert.NoError(t, err)
+
+	decoded, err := decodeApprovedProjectCursor(token, query)
+	assert.NoError(t, err)
+	assert.Equal(t, cursor.Name, decoded.Name)
+	assert.True(t, cursor.ApprovalDate.Equal(decoded.ApprovalDate))
+	assert.Equal(t, cursor.ID, decoded.ID)
+
+	// A token is only accepted for the sort order and filters it was issued for
+	filter.Descending = true
+	_, err = decodeApprovedProjectCursor(token, approvedProjectQueryKey(filter))
+	assert.Error(t, err)
+
+	filter.Descending, filter.License = false, "Apache-2.0"
+	_, err = decodeApprovedProjectCursor(token, approvedProjectQueryKey(filter))
+	assert.Error(t, err)
+
+	// The page bounds are not part of the query
+	filter.License, filter.Limit = "MIT", 51
+	_, err = decodeApprovedProjectCursor(token, approvedProjectQueryKey(filter))
+	assert.NoError(t, err)
+}
+
+func TestDecodeApprovedProjectCursor_Invalid(t *testing.T) {
+	for _, token := range []string{"not base64!", "e30"} { // "e30" is base64 for "{}"
+		_, err := decodeApprovedProjectCursor(token, "")
+		assert.Error(t, err, token)
+	}
+}
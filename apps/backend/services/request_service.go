@@ -5,11 +5,15 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"sourcestream/backend/models"
 	pb "sourcestream/backend/pb"
+	"sourcestream/backend/provider"
 	"sourcestream/backend/repository"
+	"sourcestream/backend/validation"
 
 	"github.com/google/uuid"
 )
@@ -17,33 +21,67 @@ import (
 // RequestService implements the gRPC RequestService server.
 type RequestService struct {
 	pb.UnimplementedRequestServiceServer
-	requestRepo *repository.RequestRepository
+	requestRepo         *repository.RequestRepository
+	contributionRepo    *repository.ContributionRepository
+	pullRequestRepo     *repository.PullRequestRepository
+	approvalRuleRepo    *repository.ApprovalRuleRepository
+	userRepo            *repository.UserRepository
+	projectRepo         *repository.ProjectRepository
+	approvedProjectRepo *repository.ApprovedProjectRepository
+	orgRepo             *repository.OrgRepository
+	gitProvider         provider.Provider
+	// entryRules validates the entries of a contribution import, which the interceptor leaves
+	// to the service so that one bad entry does not fail the batch
+	entryRules validation.Rules
+	// requireVerifiedGithub makes requests that depend on the requester's GitHub identity require
+	// a verified GitHub username
+	requireVerifiedGithub bool
 }
 
-// NewRequestService creates a new RequestService with the given database.
-func NewRequestService(db *sql.DB) *RequestService {
+// NewRequestService creates a new RequestService with the given database and Git hosting provider.
+// With requireVerifiedGithub, pull request approvals and contribution permissions can only be
+// requested by users who verified their GitHub username.
+func NewRequestService(db *sql.DB, gitProvider provider.Provider, requireVerifiedGithub bool) *RequestService {
 	return &RequestService{
-		requestRepo: repository.NewRequestRepository(db),
+		requestRepo:           repository.NewRequestRepository(db),
+		contributionRepo:      repository.NewContributionRepository(db),
+		pullRequestRepo:       repository.NewPullRequestRepository(db),
+		approvalRuleRepo:      repository.NewApprovalRuleRepository(db),
+		userRepo:              repository.NewUserRepository(db),
+		projectRepo:           repository.NewProjectRepository(db),
+		approvedProjectRepo:   repository.NewApprovedProjectRepository(db),
+		orgRepo:               repository.NewOrgRepository(db),
+		gitProvider:           gitProvider,
+		entryRules:            validation.DefaultRules(),
+		requireVerifiedGithub: requireVerifiedGithub,
 	}
 }
 
 // SubmitProjectRequest handles submission of a project request.
-func (s *RequestService) SubmitProjectRequest(_ context.Context, req *pb.SubmitProjectRequestRequest) (*pb.SubmitProjectRequestResponse, error) {
-	// Mock implementation - in real app, save to database
+func (s *RequestService) SubmitProjectRequest(ctx context.Context, req *pb.SubmitProjectRequestRequest) (*pb.SubmitProjectRequestResponse, error) {
+	requesterID, err := actingUserID(ctx, "requester_id", req.GetRequesterId())
+	if err != nil {
+		return nil, err
+	}
+
 	request := &models.Request{
 		ID:          uuid.New().String(),
 		Type:        "project",
 		Title:       req.GetTitle(),
 		Status:      "pending",
-		RequesterID: req.GetRequesterId(),
+		RequesterID: requesterID,
 		ProjectURL:  req.GetProjectUrl(),
 		License:     req.GetLicense(),
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
 
-	// TODO: Save request to database
-	_ = request
+	err = s.requestRepo.CreateRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project request: %w", err)
+	}
+
+	s.applyApprovalRules(ctx, request)
 
 	return &pb.SubmitProjectRequestResponse{
 		RequestId: request.ID,
@@ -52,22 +90,40 @@ func (s *RequestService) SubmitProjectRequest(_ context.Context, req *pb.SubmitP
 }
 
 // SubmitPullRequestApproval handles submission of a pull request approval request.
-func (s *RequestService) SubmitPullRequestApproval(_ context.Context, req *pb.SubmitPullRequestApprovalRequest) (*pb.SubmitPullRequestApprovalResponse, error) {
-	// Mock implementation - in real app, save to database
+// The upstream pull request is tracked by the pull request status poller once submitted.
+func (s *RequestService) SubmitPullRequestApproval(ctx context.Context, req *pb.SubmitPullRequestApprovalRequest) (*pb.SubmitPullRequestApprovalResponse, error) {
+	requesterID, err := actingUserID(ctx, "requester_id", req.GetRequesterId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := provider.ParsePullRequestURL(req.GetPrUrl()); err != nil {
+		return nil, invalidArgument("pr_url", "must be a GitHub, GitLab or Gitea pull request URL")
+	}
+
+	if err := s.checkVerifiedGithub(ctx, requesterID); err != nil {
+		return nil, err
+	}
+
 	request := &models.Request{
 		ID:          uuid.New().String(),
 		Type:        "pullrequest",
 		Title:       req.GetTitle(),
 		Status:      "pending",
-		RequesterID: req.GetRequesterId(),
+		RequesterID: requesterID,
 		ProjectName: req.GetProjectName(),
 		ProjectURL:  req.GetPrUrl(),
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
 
-	// TODO: Save request to database
-	_ = request
+	// Save request to database using repository
+	err = s.requestRepo.CreateRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request approval: %w", err)
+	}
+
+	s.applyApprovalRules(ctx, request)
 
 	return &pb.SubmitPullRequestApprovalResponse{
 		RequestId: request.ID,
@@ -76,13 +132,18 @@ func (s *RequestService) SubmitPullRequestApproval(_ context.Context, req *pb.Su
 }
 
 // SubmitAccessRequest handles submission of an access request.
-func (s *RequestService) SubmitAccessRequest(_ context.Context, req *pb.SubmitAccessRequestRequest) (*pb.SubmitAccessRequestResponse, error) {
+func (s *RequestService) SubmitAccessRequest(ctx context.Context, req *pb.SubmitAccessRequestRequest) (*pb.SubmitAccessRequestResponse, error) {
+	requesterID, err := actingUserID(ctx, "requester_id", req.GetRequesterId())
+	if err != nil {
+		return nil, err
+	}
+
 	request := &models.Request{
 		ID:          uuid.New().String(),
 		Type:        "access",
 		Title:       req.GetTitle(),
 		Status:      "pending",
-		RequesterID: req.GetRequesterId(),
+		RequesterID: requesterID,
 		ProjectName: req.GetProjectName(),
 		Role:        req.GetRole(),
 		CreatedAt:   time.Now(),
@@ -90,11 +151,13 @@ func (s *RequestService) SubmitAccessRequest(_ context.Context, req *pb.SubmitAc
 	}
 
 	// Save request to database using repository
-	err := s.requestRepo.CreateRequest(request)
+	err = s.requestRepo.CreateRequest(ctx, request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create access request: %w", err)
 	}
 
+	s.applyApprovalRules(ctx, request)
+
 	return &pb.SubmitAccessRequestResponse{
 		RequestId: request.ID,
 		Message:   "Access request submitted successfully",
@@ -102,33 +165,109 @@ func (s *RequestService) SubmitAccessRequest(_ context.Context, req *pb.SubmitAc
 }
 
 // SubmitContributionPermissionRequest handles submission of a contribution permission request.
-func (s *RequestService) SubmitContributionPermissionRequest(_ context.Context, req *pb.SubmitContributionPermissionRequestRequest) (*pb.SubmitContributionPermissionRequestResponse, error) {
-	// Mock implementation - in real app, save to database
+func (s *RequestService) SubmitContributionPermissionRequest(ctx context.Context, req *pb.SubmitContributionPermissionRequestRequest) (*pb.SubmitContributionPermissionRequestResponse, error) {
+	requesterID, err := actingUserID(ctx, "requester_id", req.GetRequesterId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(req.GetApprovedProjectId()); err != nil {
+		return nil, invalidArgument("approved_project_id", "must be the ID of an approved project")
+	}
+
+	if err := s.checkVerifiedGithub(ctx, requesterID); err != nil {
+		return nil, err
+	}
+
+	approvedProject, err := s.approvedProjectRepo.GetApprovedProjectByID(ctx, req.GetApprovedProjectId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get approved project: %w", err)
+	}
+
+	if !approvedProject.IsActive {
+		return nil, invalidArgument("approved_project_id", "the approved project is no longer active")
+	}
+
+	kind := req.GetContributionKind()
+	if kind != "" && !slices.Contains(approvedProject.AllowedContributionTypes, kind) {
+		return nil, invalidArgument("contribution_kind",
+			fmt.Sprintf("must be one of %s", strings.Join(approvedProject.AllowedContributionTypes, ", ")))
+	}
+
 	request := &models.Request{
 		ID:                    uuid.New().String(),
 		Type:                  "contribution_permission",
 		Title:                 req.GetTitle(),
 		Status:                "pending",
-		RequesterID:           req.GetRequesterId(),
-		ApprovedProjectID:     func() *string { s := req.GetApprovedProjectId(); return &s }(),
+		RequesterID:           requesterID,
+		ApprovedProjectID:     &approvedProject.ID,
 		BusinessJustification: func() *string { s := req.GetBusinessJustification(); return &s }(),
+		ContributionKind:      kind,
 		CreatedAt:             time.Now(),
 		UpdatedAt:             time.Now(),
 	}
 
-	// TODO: Save request to database
-	_ = request
+	err = s.requestRepo.CreateRequest(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create contribution permission request: %w", err)
+	}
+
+	s.applyApprovalRules(ctx, request)
 
-	return &pb.SubmitContributionPermissionRequestResponse{
+	resp := &pb.SubmitContributionPermissionRequestResponse{
 		RequestId: request.ID,
 		Message:   "Contribution permission request submitted successfully",
-	}, nil
+		Status:    request.Status,
+	}
+
+	if request.DecisionReason != nil {
+		resp.Message = "Contribution permission request approved automatically"
+		resp.DecisionReason = *request.DecisionReason
+	}
+
+	return resp, nil
+}
+
+// checkVerifiedGithub refuses requests that depend on the requester's GitHub identity unless
+// they verified their GitHub username, when verification is required. The GitHub usernames of
+// service accounts are set by admins and need no verification.
+func (s *RequestService) checkVerifiedGithub(ctx context.Context, requesterID string) error {
+	if !s.requireVerifiedGithub {
+		return nil
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, requesterID)
+	if err != nil {
+		return fmt.Errorf("failed to get requester: %w", err)
+	}
+
+	return requireVerifiedGithub(user)
+}
+
+func requireVerifiedGithub(user *models.User) error {
+	switch {
+	case user.IsServiceAccount:
+		return nil
+	case user.GithubUsername == "":
+		return failedPrecondition("GITHUB_USERNAME_MISSING", "github_username",
+			"a verified GitHub username is required; set one and verify it first")
+	case user.GithubVerifiedAt == nil:
+		return failedPrecondition("GITHUB_UNVERIFIED", "github_username",
+			fmt.Sprintf("GitHub username %s must be verified first", user.GithubUsername))
+	}
+
+	return nil
 }
 
 // GetRequests returns requests for a user, optionally filtered by status.
-func (s *RequestService) GetRequests(_ context.Context, req *pb.GetRequestsRequest) (*pb.GetRequestsResponse, error) {
+func (s *RequestService) GetRequests(ctx context.Context, req *pb.GetRequestsRequest) (*pb.GetRequestsResponse, error) {
+	userID, err := subjectUserID(ctx, "user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
 	// Get requests from database using repository
-	requests, err := s.requestRepo.GetRequestsByUser(req.GetUserId())
+	requests, err := s.requestRepo.GetRequestsByUser(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get requests: %w", err)
 	}
@@ -145,6 +284,10 @@ func (s *RequestService) GetRequests(_ context.Context, req *pb.GetRequestsReque
 			ProjectName: request.ProjectName,
 			CreatedAt:   request.CreatedAt.Format("2006-01-02T15:04:05Z"),
 		}
+
+		if request.ReviewerID != nil {
+			pbRequests[i].ReviewerId = *request.ReviewerID
+		}
 	}
 
 	// Filter by status if provided
@@ -170,3 +313,29 @@ func (s *RequestService) GetRequests(_ context.Context, req *pb.GetRequestsReque
 		Total:    int32(total), // #nosec G115 -- total is clamped to int32 range above
 	}, nil
 }
+
+// ListPullRequestEvents returns the upstream pull request events recorded against a pull request approval request.
+func (s *RequestService) ListPullRequestEvents(ctx context.Context, req *pb.ListPullRequestEventsRequest) (*pb.ListPullRequestEventsResponse, error) {
+	events, err := s.pullRequestRepo.GetPullRequestEvents(ctx, req.GetRequestId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request events: %w", err)
+	}
+
+	pbEvents := make([]*pb.PullRequestEvent, len(events))
+	for i, event := range events {
+		pbEvents[i] = &pb.PullRequestEvent{
+			Id:         event.ID,
+			RequestId:  event.RequestID,
+			EventType:  event.EventType,
+			OccurredAt: event.OccurredAt.Format(time.RFC3339),
+		}
+
+		if event.HeadSHA != nil {
+			pbEvents[i].HeadSha = *event.HeadSHA
+		}
+	}
+
+	return &pb.ListPullRequestEventsResponse{
+		Events: pbEvents,
+	}, nil
+}
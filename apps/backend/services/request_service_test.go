@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "sourcestream/backend/pb"
+)
+
+func TestSubmitPullRequestApproval_RejectsRepositoryURL(t *testing.T) {
+	s := &RequestService{}
+
+	_, err := s.SubmitPullRequestApproval(context.Background(), &pb.SubmitPullRequestApprovalRequest{
+		Title:       "Fix typo",
+		PrUrl:       "https://github.com/o/r",
+		RequesterId: "6f1c2d3e-4b5a-4c6d-8e7f-901a2b3c4d5e",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "invalid pr_url: must be a GitHub, GitLab or Gitea pull request URL", status.Convert(err).Message())
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"fmt"
+
+	"sourcestream/backend/rpcerror"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain identifies SourceStream in ErrorInfo details.
+const errorDomain = rpcerror.Domain
+
+// invalidArgument returns an InvalidArgument status carrying a BadRequest field violation.
+func invalidArgument(field, description string) error {
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", field, description))
+
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// alreadyExists returns an AlreadyExists status whose ErrorInfo names the conflicting field.
+func alreadyExists(field, message string) error {
+	st := status.New(codes.AlreadyExists, message)
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "ALREADY_EXISTS",
+		Domain:   errorDomain,
+		Metadata: map[string]string{"field": field},
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// failedPrecondition returns a FailedPrecondition status carrying a PreconditionFailure violation
+// of the given type, e.g. GITHUB_UNVERIFIED, about subject.
+func failedPrecondition(violationType, subject, description string) error {
+	st := status.New(codes.FailedPrecondition, description)
+
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: violationType, Subject: subject, Description: description},
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sourcestream/backend/authz"
+	"sourcestream/backend/models"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/repository"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListUsers returns a page of the user directory, by name, optionally filtered by role,
+// department, whether users are active and whether they are service accounts.
+func (s *UserService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	limit, offset := pageBounds(req.GetPage(), req.GetLimit())
+
+	users, total, err := s.userRepo.ListUsers(ctx, repository.UserFilter{
+		Role:           req.GetRole(),
+		Department:     strings.TrimSpace(req.GetDepartment()),
+		Active:         req.IsActive,
+		ServiceAccount: req.IsServiceAccount,
+		Limit:          limit,
+		Offset:         offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	return &pb.ListUsersResponse{Users: usersToPB(users), Total: clampInt32(total)}, nil
+}
+
+// SearchUsers returns a page of the users whose name, email, GitHub username, department or
+// corporate ID contain every word of the query.
+func (s *UserService) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	query := strings.TrimSpace(req.GetQuery())
+	if query == "" {
+		return nil, invalidArgument("query", "is required")
+	}
+
+	limit, offset := pageBounds(req.GetPage(), req.GetLimit())
+
+	users, total, err := s.userRepo.ListUsers(ctx, repository.UserFilter{
+		Query:      query,
+		Role:       req.GetRole(),
+		Department: strings.TrimSpace(req.GetDepartment()),
+		Active:     req.IsActive,
+		Limit:      limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	return &pb.SearchUsersResponse{Users: usersToPB(users), Total: clampInt32(total)}, nil
+}
+
+// UpdateUser changes a user's profile fields that are set in the request.
+func (s *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	user, err := s.directoryUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	applyUserUpdate(user, req)
+
+	if req.GithubUsername != nil && user.GithubUsername != "" {
+		// GitHub usernames are case-insensitive, which the unique constraint does not cover
+		existing, err := s.userRepo.GetUserByGithubUsername(ctx, user.GithubUsername)
+		if err == nil && existing.ID != user.ID {
+			return nil, alreadyExists("github_username", "github_username is already registered to another contributor")
+		} else if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+			return nil, fmt.Errorf("failed to look up contributor: %w", err)
+		}
+	}
+
+	if err := s.saveDirectoryUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateUserResponse{User: userToPB(user)}, nil
+}
+
+// SetUserRole changes a user's role. Admins cannot change their own role, so that the last admin
+// cannot lock everyone out by accident.
+func (s *UserService) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.SetUserRoleResponse, error) {
+	if !authz.IsRole(req.GetRole()) {
+		return nil, invalidArgument("role", fmt.Sprintf("must be %s, %s, %s or %s",
+			authz.RoleContributor, authz.RoleUser, authz.RoleOSPOAdmin, authz.RoleAdmin))
+	}
+
+	user, err := s.directoryUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if isCaller(ctx, user.ID) {
+		return nil, status.Error(codes.FailedPrecondition, "you cannot change your own role")
+	}
+
+	user.Role = req.GetRole()
+
+	if err := s.saveDirectoryUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &pb.SetUserRoleResponse{User: userToPB(user)}, nil
+}
+
+// DeactivateUser deactivates a user by offboarding them: their projects and reviews go to the
+// successor, or their department lead, and their access and pending requests are revoked.
+func (s *UserService) DeactivateUser(ctx context.Context, req *pb.DeactivateUserRequest) (*pb.DeactivateUserResponse, error) {
+	user, err := s.directoryUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if isCaller(ctx, user.ID) {
+		return nil, status.Error(codes.FailedPrecondition, "you cannot deactivate yourself")
+	}
+
+	if !user.IsActive {
+		return nil, status.Error(codes.FailedPrecondition, "user is already deactivated")
+	}
+
+	resp, err := s.OffboardUser(ctx, &pb.OffboardUserRequest{
+		UserId:      user.ID,
+		SuccessorId: req.GetSuccessorId(),
+		Reason:      req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	user.IsActive = false
+
+	return &pb.DeactivateUserResponse{User: userToPB(user), Report: resp.GetReport()}, nil
+}
+
+// ReactivateUser lets a deactivated user sign in again. Access revoked when they were offboarded
+// is not restored; they request it again.
+func (s *UserService) ReactivateUser(ctx context.Context, req *pb.ReactivateUserRequest) (*pb.ReactivateUserResponse, error) {
+	user, err := s.directoryUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsActive {
+		return nil, status.Error(codes.FailedPrecondition, "user is already active")
+	}
+
+	user.IsActive = true
+
+	if err := s.saveDirectoryUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &pb.ReactivateUserResponse{User: userToPB(user)}, nil
+}
+
+// applyUserUpdate applies the fields set in an UpdateUser request to user.
+func applyUserUpdate(user *models.User, req *pb.UpdateUserRequest) {
+	if req.FullName != nil {
+		user.FullName = strings.TrimSpace(req.GetFullName())
+	}
+
+	if req.Email != nil {
+		user.Email = req.GetEmail()
+	}
+
+	if req.Department != nil {
+		user.Department = strings.TrimSpace(req.GetDepartment())
+	}
+
+	if req.GithubUsername != nil {
+		if !strings.EqualFold(user.GithubUsername, req.GetGithubUsername()) {
+			user.GithubVerifiedAt = nil
+		}
+
+		user.GithubUsername = req.GetGithubUsername()
+	}
+}
+
+// directoryUser looks up the user an admin directory RPC acts on.
+func (s *UserService) directoryUser(ctx context.Context, id string) (*models.User, error) {
+	if id == "" {
+		return nil, invalidArgument("user_id", "is required")
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, id)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+func (s *UserService) saveDirectoryUser(ctx context.Context, user *models.User) error {
+	err := s.userRepo.UpdateUser(ctx, user)
+
+	var duplicate *repository.DuplicateUserError
+	if errors.As(err, &duplicate) {
+		return alreadyExists(duplicate.Field,
+			fmt.Sprintf("%s is already registered to another contributor", duplicate.Field))
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return nil
+}
+
+// isCaller reports whether the authenticated caller is the user with the given ID.
+func isCaller(ctx context.Context, userID string) bool {
+	callerID, err := actingUserID(ctx, "user_id", "")
+	return err == nil && callerID == userID
+}
+
+func usersToPB(users []*models.User) []*pb.User {
+	pbUsers := make([]*pb.User, len(users))
+	for i, user := range users {
+		pbUsers[i] = userToPB(user)
+	}
+
+	return pbUsers
+}
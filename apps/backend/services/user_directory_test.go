@@ -0,0 +1,28 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"sourcestream/backend/models"
+	pb "sourcestream/backend/pb"
+)
+
+func TestApplyUserUpdate(t *testing.T) {
+	user := &models.User{
+		CorporateID: "alice", FullName: "Alice Smith", Email: "alice@example.com",
+		Department: "Platform", GithubUsername: "alice-gh",
+	}
+
+	applyUserUpdate(user, &pb.UpdateUserRequest{
+		FullName:       proto.String("  Alice Jones "),
+		Department:     proto.String(""),
+		GithubUsername: proto.String(""),
+	})
+
+	assert.Equal(t, "Alice Jones", user.FullName)
+	assert.Equal(t, "alice@example.com", user.Email, "unset fields are unchanged")
+	assert.Empty(t, user.Department)
+	assert.Empty(t, user.GithubUsername)
+}
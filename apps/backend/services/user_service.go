@@ -4,11 +4,15 @@ package services
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"sourcestream/backend/models"
+	"sourcestream/backend/offboarding"
 	pb "sourcestream/backend/pb"
+	"sourcestream/backend/provider"
 	"sourcestream/backend/repository"
 
 	"github.com/google/uuid"
@@ -17,50 +21,153 @@ import (
 // UserService implements the gRPC UserService server.
 type UserService struct {
 	pb.UnimplementedUserServiceServer
-	userRepo *repository.UserRepository
+	userRepo         *repository.UserRepository
+	requestRepo      *repository.RequestRepository
+	offboardingRepo  *repository.OffboardingRepository
+	orgRepo          *repository.OrgRepository
+	tokenRepo        *repository.APITokenRepository
+	verificationRepo *repository.GithubVerificationRepository
+	offboarder       *offboarding.Offboarder
+	gitProvider      provider.Provider
+	accountVerifier  provider.AccountVerifier
 }
 
-// NewUserService creates a new UserService with the given database.
-func NewUserService(db *sql.DB) *UserService {
+// NewUserService creates a new UserService with the given database and Git hosting provider.
+// accountVerifier verifies GitHub usernames; it is nil when verification is disabled.
+func NewUserService(db *sql.DB, gitProvider provider.Provider, accountVerifier provider.AccountVerifier) *UserService {
 	return &UserService{
-		userRepo: repository.NewUserRepository(db),
+		userRepo:         repository.NewUserRepository(db),
+		requestRepo:      repository.NewRequestRepository(db),
+		offboardingRepo:  repository.NewOffboardingRepository(db),
+		orgRepo:          repository.NewOrgRepository(db),
+		tokenRepo:        repository.NewAPITokenRepository(db),
+		verificationRepo: repository.NewGithubVerificationRepository(db),
+		offboarder:       offboarding.NewOffboarder(db),
+		gitProvider:      gitProvider,
+		accountVerifier:  accountVerifier,
 	}
 }
 
-// RegisterContributor registers a new contributor in the system.
-func (s *UserService) RegisterContributor(_ context.Context, req *pb.RegisterContributorRequest) (*pb.RegisterContributorResponse, error) {
+// RegisterContributor registers a new contributor in the system. Registering an identity that
+// already exists with the same corporate ID and GitHub username returns the existing user; an
+// identity that clashes with another user on any unique field returns AlreadyExists. A user
+// provisioned by the identity provider completes registration by linking their GitHub username;
+// their provisioned profile is kept.
+func (s *UserService) RegisterContributor(ctx context.Context, req *pb.RegisterContributorRequest) (*pb.RegisterContributorResponse, error) {
+	// An authenticated caller can only register their own identity
+	corporateID, err := actingCorporateID(ctx, "corporate_id", req.GetCorporateId())
+	if err != nil {
+		return nil, err
+	}
+
+	req.CorporateId = corporateID
+
+	existing, err := s.userRepo.GetUserByCorporateID(ctx, req.GetCorporateId())
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return nil, fmt.Errorf("failed to look up contributor: %w", err)
+	}
+
+	if existing != nil && existing.GithubUsername == "" {
+		return s.linkGithubUsername(ctx, existing, req.GetGithubUsername())
+	}
+
+	if existing != nil {
+		if !strings.EqualFold(existing.GithubUsername, req.GetGithubUsername()) {
+			return nil, alreadyExists("corporate_id",
+				fmt.Sprintf("corporate_id %s is already registered with a different GitHub username", req.GetCorporateId()))
+		}
+
+		return &pb.RegisterContributorResponse{
+			Message: fmt.Sprintf("Contributor %s is already registered", existing.CorporateID),
+			User:    userToPB(existing),
+		}, nil
+	}
+
+	// GitHub usernames are case-insensitive, which the unique constraint does not cover
+	if _, err := s.userRepo.GetUserByGithubUsername(ctx, req.GetGithubUsername()); err == nil {
+		return nil, alreadyExists("github_username", "github_username is already registered to another contributor")
+	} else if !errors.Is(err, repository.ErrUserNotFound) {
+		return nil, fmt.Errorf("failed to look up contributor: %w", err)
+	}
+
 	user := &models.User{
 		ID:             uuid.New().String(),
 		CorporateID:    req.GetCorporateId(),
 		GithubUsername: req.GetGithubUsername(),
-		Email:          req.GetCorporateId() + "@company.com", // Default email
-		FullName:       req.GetGithubUsername(),               // Use GitHub username as fallback
-		Department:     "Engineering",                         // Default department
+		Email:          req.GetEmail(),
+		FullName:       strings.TrimSpace(req.GetFullName()),
+		Department:     strings.TrimSpace(req.GetDepartment()),
 		Role:           "contributor",
 		IsActive:       true,
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
 
-	err := s.userRepo.CreateUser(user)
+	err = s.userRepo.CreateUser(ctx, user)
+
+	var duplicate *repository.DuplicateUserError
+	if errors.As(err, &duplicate) {
+		return nil, alreadyExists(duplicate.Field,
+			fmt.Sprintf("%s is already registered to another contributor", duplicate.Field))
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to register contributor: %w", err)
 	}
 
 	return &pb.RegisterContributorResponse{
-		Message: "Contributor registered successfully",
+		Message: fmt.Sprintf("Contributor %s registered successfully", user.CorporateID),
+		User:    userToPB(user),
+		Created: true,
+	}, nil
+}
+
+// linkGithubUsername sets the GitHub username of a user provisioned without one.
+func (s *UserService) linkGithubUsername(ctx context.Context, user *models.User, githubUsername string) (*pb.RegisterContributorResponse, error) {
+	// GitHub usernames are case-insensitive, which the unique constraint does not cover
+	if _, err := s.userRepo.GetUserByGithubUsername(ctx, githubUsername); err == nil {
+		return nil, alreadyExists("github_username", "github_username is already registered to another contributor")
+	} else if !errors.Is(err, repository.ErrUserNotFound) {
+		return nil, fmt.Errorf("failed to look up contributor: %w", err)
+	}
+
+	user.GithubUsername = githubUsername
+
+	err := s.userRepo.UpdateUser(ctx, user)
+
+	var duplicate *repository.DuplicateUserError
+	if errors.As(err, &duplicate) {
+		return nil, alreadyExists(duplicate.Field,
+			fmt.Sprintf("%s is already registered to another contributor", duplicate.Field))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to link GitHub username: %w", err)
+	}
+
+	return &pb.RegisterContributorResponse{
+		Message: fmt.Sprintf("Contributor %s registered successfully", user.CorporateID),
+		User:    userToPB(user),
 	}, nil
 }
 
-// GetContributor returns contributor details by corporate ID.
-func (s *UserService) GetContributor(_ context.Context, req *pb.GetContributorRequest) (*pb.GetContributorResponse, error) {
-	user, err := s.userRepo.GetUserByCorporateID(req.GetCorporateId())
+// GetContributor returns contributor details by corporate ID, including the contribution
+// permissions and access grants the contributor currently holds.
+func (s *UserService) GetContributor(ctx context.Context, req *pb.GetContributorRequest) (*pb.GetContributorResponse, error) {
+	user, err := s.userRepo.GetUserByCorporateID(ctx, req.GetCorporateId())
 	if err != nil {
 		return nil, fmt.Errorf("contributor not found: %w", err)
 	}
 
-	// TODO: Get approved projects from project repository
-	approvedProjects := []string{"React Component Library", "API Gateway Service"}
+	grants, err := s.requestRepo.GetContributorGrants(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get approved projects: %w", err)
+	}
+
+	approvedProjects := make([]*pb.ContributorGrant, 0, len(grants))
+	for _, grant := range grants {
+		approvedProjects = append(approvedProjects, contributorGrantToPB(grant))
+	}
 
 	return &pb.GetContributorResponse{
 		CorporateId:      user.CorporateID,
@@ -69,8 +176,94 @@ func (s *UserService) GetContributor(_ context.Context, req *pb.GetContributorRe
 	}, nil
 }
 
-// GetUserProfile returns a user's profile information.
-func (s *UserService) GetUserProfile(_ context.Context, _ *pb.GetUserProfileRequest) (*pb.GetUserProfileResponse, error) {
-	// Return empty response for now - protobuf needs to be updated
-	return &pb.GetUserProfileResponse{}, nil
+// GetUserProfile returns a user's profile together with their request and project activity.
+func (s *UserService) GetUserProfile(ctx context.Context, req *pb.GetUserProfileRequest) (*pb.GetUserProfileResponse, error) {
+	if req.GetCorporateId() == "" {
+		return nil, invalidArgument("corporate_id", "is required")
+	}
+
+	user, err := s.userRepo.GetUserByCorporateID(ctx, req.GetCorporateId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+
+	requestStats, err := s.requestRepo.GetRequestStats(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get request stats: %w", err)
+	}
+
+	activity, err := s.userRepo.GetUserActivityStats(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activity stats: %w", err)
+	}
+
+	stats := &pb.UserStats{
+		RequestsByStatus:              make(map[string]int32, len(requestStats)),
+		OwnedProjects:                 clampInt32(activity.OwnedProjects),
+		ContributedProjects:           clampInt32(activity.ContributedProjects),
+		ActiveContributionPermissions: clampInt32(activity.ActiveContributionPermissions),
+	}
+
+	var total int
+
+	for status, count := range requestStats {
+		stats.RequestsByStatus[status] = clampInt32(count)
+		total += count
+	}
+
+	stats.TotalRequests = clampInt32(total)
+
+	return &pb.GetUserProfileResponse{
+		User:  userToPB(user),
+		Stats: stats,
+	}, nil
+}
+
+func userToPB(user *models.User) *pb.User {
+	pbUser := &pb.User{
+		Id:               user.ID,
+		CorporateId:      user.CorporateID,
+		GithubUsername:   user.GithubUsername,
+		Name:             user.FullName,
+		Department:       user.Department,
+		Email:            user.Email,
+		Role:             user.Role,
+		IsActive:         user.IsActive,
+		IsServiceAccount: user.IsServiceAccount,
+	}
+
+	if !user.CreatedAt.IsZero() {
+		pbUser.CreatedAt = user.CreatedAt.Format(time.RFC3339)
+	}
+
+	if user.ManagerID != nil {
+		pbUser.ManagerId = *user.ManagerID
+	}
+
+	if user.GithubVerifiedAt != nil {
+		pbUser.GithubVerified = true
+		pbUser.GithubVerifiedAt = user.GithubVerifiedAt.Format(time.RFC3339)
+	}
+
+	return pbUser
+}
+
+func contributorGrantToPB(grant *models.ContributorGrant) *pb.ContributorGrant {
+	pbGrant := &pb.ContributorGrant{
+		Id:          grant.RequestID,
+		Type:        grant.Type,
+		ProjectId:   grant.ProjectID,
+		ProjectName: grant.ProjectName,
+		Role:        grant.Role,
+	}
+
+	if grant.ApprovedAt != nil {
+		pbGrant.ApprovedAt = grant.ApprovedAt.Format(time.RFC3339)
+	}
+
+	if grant.ExpiresAt != nil {
+		pbGrant.ExpiresAt = grant.ExpiresAt.Format(time.RFC3339)
+	}
+
+	return pbGrant
 }
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sourcestream/backend/auth"
+	"sourcestream/backend/models"
+)
+
+func TestAlreadyExistsNamesField(t *testing.T) {
+	st := status.Convert(alreadyExists("email", "email is already registered to another contributor"))
+
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "email", info.GetMetadata()["field"])
+}
+
+func TestActingUserID(t *testing.T) {
+	// Without authentication the request field is trusted
+	id, err := actingUserID(context.Background(), "requester_id", "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", id)
+
+	_, err = actingUserID(context.Background(), "requester_id", "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ctx := auth.NewContext(context.Background(), &auth.Actor{User: &models.User{ID: "user-1"}})
+
+	id, err = actingUserID(ctx, "requester_id", "")
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", id)
+
+	_, err = actingUserID(ctx, "requester_id", "user-2")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,284 @@
+package validation
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"buf.build/go/spdx"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Rule constrains the value of a field. Rules apply to fields that are set; an unset field only
+// fails Required.
+type Rule interface {
+	// Check returns how v, a value of field fd, breaks the rule, or "" when it does not. The
+	// value is one element of a repeated field.
+	Check(fd protoreflect.FieldDescriptor, v protoreflect.Value) string
+}
+
+type required struct{}
+
+// Required refuses an unset field: an empty string or list, or zero. A string of only whitespace
+// counts as unset.
+func Required() Rule { return required{} }
+
+func (required) Check(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if fd.Kind() == protoreflect.StringKind && strings.TrimSpace(v.String()) == "" {
+		return "is required"
+	}
+
+	return ""
+}
+
+type notBlank struct{}
+
+// NotBlank refuses a string of only whitespace, including the empty string. It suits optional
+// fields that may be left out but not cleared.
+func NotBlank() Rule { return notBlank{} }
+
+func (notBlank) Check(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if fd.Kind() == protoreflect.StringKind && strings.TrimSpace(v.String()) == "" {
+		return "must not be blank"
+	}
+
+	return ""
+}
+
+type maxLen struct {
+	n int
+}
+
+// MaxLen limits a string to n characters, as a VARCHAR(n) column does.
+func MaxLen(n int) Rule { return maxLen{n: n} }
+
+func (r maxLen) Check(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if fd.Kind() == protoreflect.StringKind && utf8.RuneCountInString(v.String()) > r.n {
+		return fmt.Sprintf("must be at most %d characters", r.n)
+	}
+
+	return ""
+}
+
+type pattern struct {
+	re          *regexp.Regexp
+	description string
+}
+
+// Pattern requires a non-empty string to match the regular expression expr, and describes a
+// mismatch with description. The empty string is left to Required, so that an optional field can
+// be cleared.
+func Pattern(expr, description string) Rule {
+	return pattern{re: regexp.MustCompile(expr), description: description}
+}
+
+func (r pattern) Check(_ protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if v.String() != "" && !r.re.MatchString(v.String()) {
+		return r.description
+	}
+
+	return ""
+}
+
+type uuidRule struct{}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// UUID requires a string to be a UUID in its canonical hyphenated form.
+func UUID() Rule { return uuidRule{} }
+
+func (uuidRule) Check(_ protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if !uuidPattern.MatchString(v.String()) {
+		return "must be a UUID"
+	}
+
+	return ""
+}
+
+type urlRule struct{}
+
+// URL requires a string to be an absolute http or https URL with a host.
+func URL() Rule { return urlRule{} }
+
+func (urlRule) Check(_ protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	u, err := url.Parse(v.String())
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "must be an http or https URL"
+	}
+
+	return ""
+}
+
+type emailRule struct{}
+
+// Email requires a string to be a bare email address, such as jane@example.com.
+func Email() Rule { return emailRule{} }
+
+func (emailRule) Check(_ protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if addr, err := mail.ParseAddress(v.String()); err != nil || addr.Address != v.String() {
+		return "is not a valid email address"
+	}
+
+	return ""
+}
+
+type timestamp struct{}
+
+// Timestamp requires a string to be an RFC 3339 timestamp.
+func Timestamp() Rule { return timestamp{} }
+
+func (timestamp) Check(_ protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if _, err := time.Parse(time.RFC3339, v.String()); err != nil {
+		return "must be an RFC 3339 timestamp"
+	}
+
+	return ""
+}
+
+type oneOf struct {
+	values []string
+}
+
+// OneOf restricts a string to the given values.
+func OneOf(values ...string) Rule { return oneOf{values: values} }
+
+func (r oneOf) Check(_ protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	for _, value := range r.values {
+		if v.String() == value {
+			return ""
+		}
+	}
+
+	return "must be " + joinOr(r.values)
+}
+
+type between struct {
+	min, max int64
+}
+
+// Between restricts an integer to the range min to max, inclusive.
+func Between(min, max int64) Rule { return between{min: min, max: max} }
+
+func (r between) Check(_ protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if n := v.Int(); n < r.min || n > r.max {
+		return fmt.Sprintf("must be between %d and %d", r.min, r.max)
+	}
+
+	return ""
+}
+
+type spdxRule struct{}
+
+// SPDX requires a string to be an SPDX license identifier, such as Apache-2.0, or an SPDX license
+// expression combining identifiers, such as "MIT OR GPL-2.0-or-later WITH Classpath-exception-2.0".
+// Identifiers are those of the SPDX license list, or LicenseRef- references to other licenses.
+func SPDX() Rule { return spdxRule{} }
+
+func (spdxRule) Check(_ protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if !isLicenseExpression(v.String()) {
+		return "must be an SPDX license identifier or expression"
+	}
+
+	return ""
+}
+
+// licenseToken splits an SPDX expression into parentheses and words.
+var licenseToken = regexp.MustCompile(`[()]|[^\s()]+`)
+
+// exceptionID matches the identifier of a license exception following WITH.
+var exceptionID = regexp.MustCompile(`^[A-Za-z0-9.-]+$`)
+
+// isLicenseExpression parses an SPDX license expression:
+//
+//	expression = term { ("AND" | "OR") term }
+//	term       = "(" expression ")" | license [ "WITH" exception ]
+func isLicenseExpression(s string) bool {
+	p := &licenseParser{tokens: licenseToken.FindAllString(s, -1)}
+	return p.expression() && p.pos == len(p.tokens)
+}
+
+type licenseParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *licenseParser) next() string {
+	if p.pos == len(p.tokens) {
+		return ""
+	}
+
+	token := p.tokens[p.pos]
+	p.pos++
+
+	return token
+}
+
+func (p *licenseParser) peek() string {
+	if p.pos == len(p.tokens) {
+		return ""
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *licenseParser) expression() bool {
+	if !p.term() {
+		return false
+	}
+
+	for p.peek() == "AND" || p.peek() == "OR" {
+		p.next()
+
+		if !p.term() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *licenseParser) term() bool {
+	token := p.next()
+
+	if token == "(" {
+		return p.expression() && p.next() == ")"
+	}
+
+	if !isLicenseID(token) {
+		return false
+	}
+
+	if p.peek() == "WITH" {
+		p.next()
+		return exceptionID.MatchString(p.next())
+	}
+
+	return true
+}
+
+// isLicenseID reports whether id names a listed license, optionally followed by + for "or any
+// later version", or is a LicenseRef- reference.
+func isLicenseID(id string) bool {
+	if ref, ok := strings.CutPrefix(id, "LicenseRef-"); ok {
+		return exceptionID.MatchString(ref)
+	}
+
+	_, ok := spdx.LicenseForID(strings.TrimSuffix(id, "+"))
+
+	return ok
+}
+
+func joinOr(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	}
+
+	return strings.Join(items[:len(items)-1], ", ") + " or " + items[len(items)-1]
+}
@@ -0,0 +1,332 @@
+// Package validation checks gRPC requests against declarative, per-message field rules, so that
+// malformed input is refused with InvalidArgument before it reaches a service or the database.
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sourcestream/backend/auth"
+	"sourcestream/backend/authz"
+	pb "sourcestream/backend/pb"
+	"sourcestream/backend/repository"
+	"sourcestream/backend/rules"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Fields maps proto field names to the rules for their values.
+type Fields map[protoreflect.Name][]Rule
+
+// Rules maps full proto message names to the rules for their fields. Messages nested in a request
+// are not checked; a service that accepts them, such as a batch import, validates each itself so
+// that one bad entry does not fail the rest.
+type Rules map[protoreflect.FullName]Fields
+
+// Request types, as stored in requests.type.
+var requestTypes = []string{"project", "pullrequest", "access", "contribution_permission"}
+
+// Request statuses, as stored in requests.status. Offboarding cancels the requester's pending
+// requests, and the pull request status tracker moves approved pull requests to merged or closed.
+var requestStatuses = []string{"pending", "in_review", "approved", "rejected", "cancelled", "merged", "closed"}
+
+// DefaultRules are the rules for the requests of the SourceStream services. String lengths match
+// the VARCHAR columns the values are stored in.
+func DefaultRules() Rules {
+	id := []Rule{UUID()}
+	requiredID := []Rule{Required(), UUID()}
+	department := []Rule{MaxLen(100)}
+	corporateID := []Rule{Required(), MaxLen(100),
+		Pattern(`^[A-Za-z0-9][A-Za-z0-9._-]*$`, "must be letters, digits, dots, underscores or hyphens")}
+	// GitHub usernames are at most 39 alphanumeric characters or single hyphens, and cannot begin
+	// or end with a hyphen
+	githubUsername := []Rule{MaxLen(39), Pattern(`^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$`, "is not a valid GitHub username")}
+	userRole := []Rule{OneOf(authz.RoleContributor, authz.RoleUser, authz.RoleOSPOAdmin, authz.RoleAdmin)}
+	approvalRule := Fields{
+		"name":         {Required(), MaxLen(100)},
+		"request_type": {OneOf(requestTypes...)},
+		"expression":   {Required()},
+		"action":       {Required(), OneOf(rules.ActionRequireReview, rules.ActionRoute, rules.ActionAutoApprove)},
+		"target":       {MaxLen(100)},
+	}
+	contributionOutcome := []Rule{OneOf(repository.ContributionOutcomeOpen, repository.ContributionOutcomeMerged, repository.ContributionOutcomeClosed)}
+
+	return Rules{
+		// Users
+		name(&pb.RegisterContributorRequest{}): {
+			"corporate_id":    corporateID,
+			"github_username": append([]Rule{Required()}, githubUsername...),
+			"email":           {Required(), MaxLen(255), Email()},
+			"full_name":       {Required(), MaxLen(255)},
+			"department":      department,
+		},
+		name(&pb.GetContributorRequest{}):       {"corporate_id": {Required(), MaxLen(100)}},
+		name(&pb.GetUserProfileRequest{}):       {"corporate_id": {Required(), MaxLen(100)}},
+		name(&pb.OffboardUserRequest{}):         {"user_id": requiredID, "successor_id": id},
+		name(&pb.GetOffboardingReportRequest{}): {"user_id": requiredID},
+		name(&pb.SetDepartmentLeadRequest{}):    {"department": {Required(), MaxLen(100)}, "user_id": requiredID},
+
+		// Departments, teams and managers
+		name(&pb.SetDepartmentChampionRequest{}): {"department": {Required(), MaxLen(100)}, "user_id": id},
+		name(&pb.ListDepartmentsRequest{}):       {},
+		name(&pb.CreateTeamRequest{}):            {"name": {Required(), MaxLen(100)}, "department": department},
+		name(&pb.GetTeamRequest{}):               {"team_id": requiredID},
+		name(&pb.ListTeamsRequest{}):             {"department": department, "user_id": id},
+		name(&pb.AddTeamMemberRequest{}):         {"team_id": requiredID, "user_id": requiredID},
+		name(&pb.RemoveTeamMemberRequest{}):      {"team_id": requiredID, "user_id": requiredID},
+		name(&pb.SetManagerRequest{}):            {"user_id": requiredID, "manager_id": id},
+		name(&pb.ImportOrgChartRequest{}):        {"csv": {Required()}},
+
+		// Admin user directory
+		name(&pb.ListUsersRequest{}):   {"role": userRole, "department": department},
+		name(&pb.SearchUsersRequest{}): {"query": {Required(), MaxLen(255)}, "role": userRole, "department": department},
+		name(&pb.UpdateUserRequest{}): {
+			"user_id":         requiredID,
+			"full_name":       {NotBlank(), MaxLen(255)},
+			"email":           {MaxLen(255), Email()},
+			"department":      department,
+			"github_username": githubUsername,
+		},
+		name(&pb.SetUserRoleRequest{}):    {"user_id": requiredID, "role": append([]Rule{Required()}, userRole...)},
+		name(&pb.DeactivateUserRequest{}): {"user_id": requiredID, "successor_id": id},
+		name(&pb.ReactivateUserRequest{}): {"user_id": requiredID},
+
+		// Service accounts and API tokens
+		name(&pb.CreateServiceAccountRequest{}): {
+			"corporate_id":    corporateID,
+			"full_name":       {Required(), MaxLen(255)},
+			"department":      department,
+			"email":           {MaxLen(255), Email()},
+			"github_username": githubUsername,
+		},
+		name(&pb.CreateAPITokenRequest{}): {
+			"user_id":         requiredID,
+			"name":            {Required(), MaxLen(100)},
+			"scopes":          {Required(), OneOf(auth.Scopes()...)},
+			"expires_in_days": {Between(1, 365)},
+		},
+		name(&pb.ListAPITokensRequest{}):  {"user_id": requiredID},
+		name(&pb.RevokeAPITokenRequest{}): {"user_id": requiredID, "token_id": requiredID},
+
+		// GitHub account verification
+		name(&pb.StartGithubVerificationRequest{}):    {"user_id": id},
+		name(&pb.CompleteGithubVerificationRequest{}): {"user_id": id, "verification_id": requiredID},
+
+		// Projects and the approved projects catalog
+		name(&pb.GetAuthoredProjectsRequest{}):    {"user_id": id},
+		name(&pb.GetContributedProjectsRequest{}): {"user_id": id},
+		name(&pb.GetApprovedProjectsRequest{}):    {"user_id": id},
+		name(&pb.CreateProjectRequest{}): {
+			"name":     {Required(), MaxLen(255)},
+			"url":      {Required(), MaxLen(500), URL()},
+			"license":  {Required(), MaxLen(50), SPDX()},
+			"owner_id": id,
+		},
+		name(&pb.GetApprovedProjectsListRequest{}): {
+			"license":                   {MaxLen(50), SPDX()},
+			"contribution_type":         {OneOf("CLA", "CCLA", "DCO")},
+			"allowed_contribution_type": {MaxLen(30)},
+			"sort_by":                   {OneOf(repository.ApprovedProjectSortName, repository.ApprovedProjectSortApprovalDate)},
+		},
+		name(&pb.ListRecertificationTasksRequest{}): {
+			"status": {OneOf(repository.RecertificationStatusOpen, repository.RecertificationStatusRecertified,
+				repository.RecertificationStatusRetired, repository.RecertificationStatusLapsed)},
+		},
+		name(&pb.CompleteRecertificationRequest{}): {
+			"task_id":     requiredID,
+			"reviewer_id": id,
+			"decision":    {Required(), OneOf("recertify", "retire")},
+		},
+		name(&pb.AddProjectContributorRequest{}): {
+			"project_id": requiredID,
+			"user_id":    requiredID,
+			"role":       {OneOf(authz.ProjectRoleContributor, authz.ProjectRoleMaintainer)},
+		},
+		name(&pb.RemoveProjectContributorRequest{}): {"project_id": requiredID, "user_id": requiredID},
+
+		// Requests and the contribution ledger
+		name(&pb.SubmitProjectRequestRequest{}): {
+			"title":        {Required(), MaxLen(255)},
+			"project_url":  {Required(), MaxLen(500), URL()},
+			"license":      {Required(), MaxLen(50), SPDX()},
+			"requester_id": id,
+		},
+		name(&pb.SubmitPullRequestApprovalRequest{}): {
+			"title":        {Required(), MaxLen(255)},
+			"project_name": {Required(), MaxLen(255)},
+			"pr_url":       {Required(), MaxLen(500), URL()},
+			"requester_id": id,
+		},
+		name(&pb.SubmitAccessRequestRequest{}): {
+			"title":        {Required(), MaxLen(255)},
+			"project_name": {Required(), MaxLen(255)},
+			"role":         {Required(), OneOf(authz.ProjectRoleContributor, authz.ProjectRoleMaintainer)},
+			"requester_id": id,
+		},
+		name(&pb.SubmitContributionPermissionRequestRequest{}): {
+			"title":               {Required(), MaxLen(255)},
+			"approved_project_id": requiredID,
+			"requester_id":        id,
+			"contribution_kind":   {MaxLen(30)},
+		},
+		name(&pb.GetRequestsRequest{}): {"user_id": id, "status": {OneOf(requestStatuses...)}},
+		name(&pb.RecordContributionRequest{}): {
+			"request_id":     requiredID,
+			"kind":           {OneOf(repository.ContributionKindPullRequest, repository.ContributionKindCommit)},
+			"url":            {Required(), MaxLen(500), URL()},
+			"title":          {MaxLen(255)},
+			"outcome":        contributionOutcome,
+			"contributed_at": {Timestamp()},
+			"resolved_at":    {Timestamp()},
+		},
+		name(&pb.ImportContributionsRequest{}): {"entries": {Required()}},
+		name(&pb.ListContributionsRequest{}): {
+			"user_id":             id,
+			"approved_project_id": id,
+			"request_id":          id,
+			"outcome":             contributionOutcome,
+			"from":                {Timestamp()},
+			"to":                  {Timestamp()},
+		},
+		name(&pb.GetContributionReportRequest{}): {"group_by": {OneOf("project", "team")}},
+		name(&pb.ListPullRequestEventsRequest{}): {"request_id": requiredID},
+
+		// Approval rules
+		name(&pb.CreateApprovalRuleRequest{}): approvalRule,
+		name(&pb.UpdateApprovalRuleRequest{}): with(approvalRule, "rule_id", requiredID),
+		name(&pb.ListApprovalRulesRequest{}):  {},
+		name(&pb.DryRunPolicyRequest{}): {
+			"rule_id":      id,
+			"request_type": {OneOf(requestTypes...)},
+			"from":         {Timestamp()},
+			"to":           {Timestamp()},
+		},
+		name(&pb.ListAutoApprovedRequestsRequest{}): {"rule_id": id, "from": {Timestamp()}, "to": {Timestamp()}},
+	}
+}
+
+func name(msg proto.Message) protoreflect.FullName {
+	return proto.MessageName(msg)
+}
+
+// with returns a copy of fields with rules added for field.
+func with(fields Fields, field protoreflect.Name, fieldRules []Rule) Fields {
+	extended := make(Fields, len(fields)+1)
+	for name, fieldRules := range fields {
+		extended[name] = fieldRules
+	}
+
+	extended[field] = fieldRules
+
+	return extended
+}
+
+// Validate checks msg against the rules. It returns an InvalidArgument status listing every
+// violation as a BadRequest field violation, or nil. Messages without rules are not checked.
+func (r Rules) Validate(msg proto.Message) error {
+	violations := r.check(msg.ProtoReflect())
+	if len(violations) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(violations))
+	for i, v := range violations {
+		messages[i] = fmt.Sprintf("invalid %s: %s", v.GetField(), v.GetDescription())
+	}
+
+	st := status.New(codes.InvalidArgument, strings.Join(messages, "; "))
+
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// check returns the violations of the fields of m.
+func (r Rules) check(m protoreflect.Message) []*errdetails.BadRequest_FieldViolation {
+	fields, ok := r[m.Descriptor().FullName()]
+	if !ok {
+		return nil
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	fds := m.Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		fieldRules := fields[fd.Name()]
+
+		if !m.Has(fd) {
+			if isRequired(fieldRules) {
+				violations = append(violations, violation(string(fd.Name()), "is required"))
+			}
+
+			continue
+		}
+
+		// Only Required applies to message and map fields
+		if fd.Message() != nil {
+			continue
+		}
+
+		if fd.IsList() {
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				violations = checkValue(fd, list.Get(j), fmt.Sprintf("%s[%d]", fd.Name(), j), fieldRules, violations)
+			}
+
+			continue
+		}
+
+		violations = checkValue(fd, m.Get(fd), string(fd.Name()), fieldRules, violations)
+	}
+
+	return violations
+}
+
+func checkValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string, fieldRules []Rule, violations []*errdetails.BadRequest_FieldViolation) []*errdetails.BadRequest_FieldViolation {
+	for _, rule := range fieldRules {
+		if description := rule.Check(fd, v); description != "" {
+			violations = append(violations, violation(path, description))
+		}
+	}
+
+	return violations
+}
+
+func isRequired(fieldRules []Rule) bool {
+	for _, rule := range fieldRules {
+		if _, ok := rule.(required); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func violation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: description}
+}
+
+// UnaryInterceptor returns a unary server interceptor refusing requests that break the rules. It
+// should run before the authorization interceptor, so that policy lookups only see well-formed
+// IDs.
+func (r Rules) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := r.Validate(msg); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
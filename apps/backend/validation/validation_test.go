@@ -0,0 +1,230 @@
+package validation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	pb "sourcestream/backend/pb"
+)
+
+const requesterID = "6f1c2d3e-4b5a-4c6d-8e7f-901a2b3c4d5e"
+
+// violations returns the field violations of a Validate error, by field.
+func violations(t *testing.T, err error) map[string]string {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+
+	fields := make(map[string]string)
+
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.GetFieldViolations() {
+				fields[v.GetField()] = v.GetDescription()
+			}
+		}
+	}
+
+	return fields
+}
+
+func TestDefaultRules_CoverEveryRequest(t *testing.T) {
+	rules := DefaultRules()
+	services := pb.File_user_service_proto.Services()
+
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			assert.Contains(t, rules, methods.Get(j).Input().FullName())
+		}
+	}
+}
+
+func TestDefaultRules_NameFields(t *testing.T) {
+	for message, fields := range DefaultRules() {
+		desc := pb.File_user_service_proto.Messages().ByName(message.Name())
+		require.NotNil(t, desc, message)
+
+		for field := range fields {
+			assert.NotNil(t, desc.Fields().ByName(field), "%s has no field %s", message, field)
+		}
+	}
+}
+
+func TestValidate_SubmitAccessRequest(t *testing.T) {
+	rules := DefaultRules()
+
+	err := rules.Validate(&pb.SubmitAccessRequestRequest{
+		Title: "", ProjectName: "kubernetes", Role: "overlord", RequesterId: "42",
+	})
+
+	assert.Equal(t, map[string]string{
+		"title":        "is required",
+		"role":         "must be contributor or maintainer",
+		"requester_id": "must be a UUID",
+	}, violations(t, err))
+	assert.Contains(t, status.Convert(err).Message(), "invalid title: is required")
+
+	assert.NoError(t, rules.Validate(&pb.SubmitAccessRequestRequest{
+		Title: "Maintain kubernetes", ProjectName: "kubernetes", Role: "maintainer", RequesterId: requesterID,
+	}))
+
+	// requester_id defaults to the caller
+	assert.NoError(t, rules.Validate(&pb.SubmitAccessRequestRequest{
+		Title: "Contribute", ProjectName: "kubernetes", Role: "contributor",
+	}))
+}
+
+func TestValidate_LookupsRequireKey(t *testing.T) {
+	rules := DefaultRules()
+
+	assert.Equal(t, map[string]string{"corporate_id": "is required"}, violations(t, rules.Validate(&pb.GetUserProfileRequest{})))
+	assert.Equal(t, map[string]string{"corporate_id": "is required"}, violations(t, rules.Validate(&pb.GetContributorRequest{})))
+	assert.NoError(t, rules.Validate(&pb.GetUserProfileRequest{CorporateId: "EMP001"}))
+}
+
+func TestValidate_RegisterContributor(t *testing.T) {
+	valid := func() *pb.RegisterContributorRequest {
+		return &pb.RegisterContributorRequest{
+			CorporateId:    "jdoe",
+			GithubUsername: "jane-doe",
+			Email:          "jane.doe@example.com",
+			FullName:       "Jane Doe",
+			Department:     "Platform",
+		}
+	}
+
+	rules := DefaultRules()
+	assert.NoError(t, rules.Validate(valid()))
+
+	tests := []struct {
+		field  string
+		mutate func(*pb.RegisterContributorRequest)
+	}{
+		{"corporate_id", func(r *pb.RegisterContributorRequest) { r.CorporateId = "" }},
+		{"corporate_id", func(r *pb.RegisterContributorRequest) { r.CorporateId = "j doe" }},
+		{"github_username", func(r *pb.RegisterContributorRequest) { r.GithubUsername = "" }},
+		{"github_username", func(r *pb.RegisterContributorRequest) { r.GithubUsername = "-jane" }},
+		{"github_username", func(r *pb.RegisterContributorRequest) { r.GithubUsername = "jane--doe" }},
+		{"github_username", func(r *pb.RegisterContributorRequest) { r.GithubUsername = "jane_doe" }},
+		{"github_username", func(r *pb.RegisterContributorRequest) { r.GithubUsername = "a123456789012345678901234567890123456789" }},
+		{"email", func(r *pb.RegisterContributorRequest) { r.Email = "" }},
+		{"email", func(r *pb.RegisterContributorRequest) { r.Email = "Jane <jane@example.com>" }},
+		{"full_name", func(r *pb.RegisterContributorRequest) { r.FullName = "  " }},
+	}
+
+	for _, tt := range tests {
+		req := valid()
+		tt.mutate(req)
+
+		assert.Contains(t, violations(t, rules.Validate(req)), tt.field)
+	}
+}
+
+func TestValidate_UpdateUser(t *testing.T) {
+	rules := DefaultRules()
+
+	// A GitHub username or department can be cleared, but not a name
+	assert.NoError(t, rules.Validate(&pb.UpdateUserRequest{
+		UserId: requesterID, GithubUsername: proto.String(""), Department: proto.String(""),
+	}))
+
+	assert.Equal(t, map[string]string{
+		"full_name":       "must not be blank",
+		"email":           "is not a valid email address",
+		"github_username": "is not a valid GitHub username",
+	}, violations(t, rules.Validate(&pb.UpdateUserRequest{
+		UserId:         requesterID,
+		FullName:       proto.String(" "),
+		Email:          proto.String("not an email"),
+		GithubUsername: proto.String("-alice"),
+	})))
+}
+
+func TestValidate_LengthsAndURLs(t *testing.T) {
+	err := DefaultRules().Validate(&pb.SubmitProjectRequestRequest{
+		Title:      strings.Repeat("é", 256),
+		ProjectUrl: "ftp://example.com/repo",
+		License:    "Apache 2.0",
+	})
+
+	assert.Equal(t, map[string]string{
+		"title":       "must be at most 255 characters",
+		"project_url": "must be an http or https URL",
+		"license":     "must be an SPDX license identifier or expression",
+	}, violations(t, err))
+}
+
+func TestValidate_RepeatedFields(t *testing.T) {
+	rules := DefaultRules()
+
+	err := rules.Validate(&pb.CreateAPITokenRequest{
+		UserId: requesterID, Name: "ci", Scopes: []string{"catalog:read", "root"}, ExpiresInDays: 400,
+	})
+	fields := violations(t, err)
+	assert.Len(t, fields, 2)
+	assert.Contains(t, fields["scopes[1]"], "must be catalog:read, catalog:write")
+	assert.Equal(t, "must be between 1 and 365", fields["expires_in_days"])
+
+	// Import entries are validated one by one by the service, not in place
+	entries := []*pb.RecordContributionRequest{
+		{RequestId: requesterID, Url: "https://github.com/o/r/pull/1", ContributedAt: "2025-03-01T10:00:00Z"},
+		{RequestId: requesterID, Url: "not a url", Outcome: "abandoned", ResolvedAt: "yesterday"},
+	}
+	assert.NoError(t, rules.Validate(&pb.ImportContributionsRequest{Entries: entries}))
+	assert.NoError(t, rules.Validate(entries[0]))
+	assert.Equal(t, map[string]string{
+		"url":         "must be an http or https URL",
+		"outcome":     "must be open, merged or closed",
+		"resolved_at": "must be an RFC 3339 timestamp",
+	}, violations(t, rules.Validate(entries[1])))
+
+	assert.Equal(t, map[string]string{"entries": "is required"},
+		violations(t, rules.Validate(&pb.ImportContributionsRequest{})))
+}
+
+func TestSPDX(t *testing.T) {
+	valid := []string{
+		"MIT", "apache-2.0", "GPL-2.0+", "LicenseRef-Internal-1",
+		"MIT OR Apache-2.0", "(MIT AND BSD-3-Clause) OR GPL-2.0-or-later WITH Classpath-exception-2.0",
+	}
+	invalid := []string{"", "Apache 2.0", "Proprietary", "MIT OR", "(MIT", "MIT)", "MIT WITH", "LicenseRef-"}
+
+	for _, license := range valid {
+		assert.True(t, isLicenseExpression(license), license)
+	}
+
+	for _, license := range invalid {
+		assert.False(t, isLicenseExpression(license), license)
+	}
+}
+
+func TestRules_UnaryInterceptor(t *testing.T) {
+	interceptor := DefaultRules().UnaryInterceptor()
+	called := false
+	handler := func(context.Context, any) (any, error) {
+		called = true
+		return &pb.GetTeamResponse{}, nil
+	}
+
+	_, err := interceptor(context.Background(), &pb.GetTeamRequest{TeamId: "team-1"}, &grpc.UnaryServerInfo{}, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.False(t, called)
+
+	_, err = interceptor(context.Background(), &pb.GetTeamRequest{TeamId: requesterID}, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.True(t, called)
+
+	// Messages without rules pass
+	assert.NoError(t, Rules{}.Validate(&pb.GetTeamRequest{}))
+}
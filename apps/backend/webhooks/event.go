@@ -0,0 +1,300 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"sourcestream/backend/provider"
+)
+
+// Repository actions carried by repository events, normalized across providers.
+const (
+	ActionArchived   = "archived"
+	ActionUnarchived = "unarchived"
+	ActionDeleted    = "deleted"
+)
+
+// Member actions carried by member events, normalized across providers.
+const (
+	ActionAdded   = "added"
+	ActionRemoved = "removed"
+)
+
+// Event is an inbound webhook delivery normalized across providers. Name is one of the
+// provider.Event* constants, or empty for events SourceStream does not act on.
+type Event struct {
+	Source        string
+	DeliveryID    string
+	Name          string
+	Action        string
+	RepositoryURL string
+	// PullRequest is set for pull_request events.
+	PullRequest *provider.PullRequest
+	// Member is the login of the user added or removed by member events.
+	Member string
+}
+
+type hookUser struct {
+	Login    string `json:"login"`
+	Username string `json:"username"`
+}
+
+type hookRepository struct {
+	Name    string   `json:"name"`
+	HTMLURL string   `json:"html_url"`
+	Owner   hookUser `json:"owner"`
+}
+
+type hookPullRequest struct {
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	HTMLURL   string     `json:"html_url"`
+	State     string     `json:"state"`
+	Merged    bool       `json:"merged"`
+	User      hookUser   `json:"user"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	MergedAt  *time.Time `json:"merged_at"`
+	ClosedAt  *time.Time `json:"closed_at"`
+	Head      struct {
+		SHA string `json:"sha"`
+	} `json:"head"`
+}
+
+// githubPayload covers the GitHub event payloads handled here. Gitea sends the same shape.
+type githubPayload struct {
+	Action      string           `json:"action"`
+	Repository  hookRepository   `json:"repository"`
+	PullRequest *hookPullRequest `json:"pull_request"`
+	Member      *hookUser        `json:"member"`
+}
+
+type gitlabPayload struct {
+	ObjectKind string   `json:"object_kind"`
+	User       hookUser `json:"user"`
+	Project    struct {
+		WebURL            string `json:"web_url"`
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID        int        `json:"iid"`
+		Title      string     `json:"title"`
+		URL        string     `json:"url"`
+		State      string     `json:"state"`
+		Action     string     `json:"action"`
+		CreatedAt  gitlabTime `json:"created_at"`
+		UpdatedAt  gitlabTime `json:"updated_at"`
+		LastCommit struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+	} `json:"object_attributes"`
+}
+
+// gitlabSystemPayload covers the GitLab system hook events handled here. Unlike project hooks,
+// they name the project by its path rather than its URL.
+type gitlabSystemPayload struct {
+	EventName  string `json:"event_name"`
+	ObjectKind string `json:"object_kind"`
+	// ProjectPathWithNamespace is set by project member events.
+	ProjectPathWithNamespace string `json:"project_path_with_namespace"`
+	// PathWithNamespace is set by project events.
+	PathWithNamespace string `json:"path_with_namespace"`
+	UserUsername      string `json:"user_username"`
+}
+
+// gitlabTime accepts both the ISO 8601 and the older "2006-01-02 15:04:05 UTC" timestamps
+// found in GitLab webhook payloads.
+type gitlabTime struct {
+	time.Time
+}
+
+func (t *gitlabTime) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		return nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05 MST", "2006-01-02 15:04:05 -0700"} {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid timestamp %q", s)
+}
+
+// parseEvent decodes a delivery body according to its source and provider event name. gitlabURL
+// is the web address of the GitLab instance, which system hooks do not include.
+func parseEvent(source, deliveryID, name string, body []byte, gitlabURL string) (*Event, error) {
+	event := &Event{Source: source, DeliveryID: deliveryID}
+
+	if source == provider.KindGitLab {
+		return event, parseGitLabEvent(event, name, body, gitlabURL)
+	}
+
+	return event, parseGitHubEvent(event, name, body)
+}
+
+func parseGitHubEvent(event *Event, name string, body []byte) error {
+	switch name {
+	case provider.EventPullRequest, provider.EventPush, provider.EventMember, provider.EventRepository:
+	default:
+		return nil
+	}
+
+	var payload githubPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", name, err)
+	}
+
+	event.Name = name
+	event.Action = payload.Action
+	event.RepositoryURL = payload.Repository.HTMLURL
+
+	switch name {
+	case provider.EventPullRequest:
+		pr := payload.PullRequest
+		if pr == nil {
+			return fmt.Errorf("pull_request payload is missing the pull request")
+		}
+
+		state := pr.State
+		if pr.Merged || pr.MergedAt != nil {
+			state = provider.StateMerged
+		}
+
+		event.PullRequest = &provider.PullRequest{
+			Owner:     payload.Repository.Owner.Login,
+			Repo:      payload.Repository.Name,
+			Number:    pr.Number,
+			Title:     pr.Title,
+			URL:       pr.HTMLURL,
+			State:     state,
+			Author:    pr.User.Login,
+			HeadSHA:   pr.Head.SHA,
+			CreatedAt: pr.CreatedAt,
+			UpdatedAt: pr.UpdatedAt,
+			MergedAt:  pr.MergedAt,
+			ClosedAt:  pr.ClosedAt,
+		}
+	case provider.EventMember:
+		if payload.Member == nil {
+			return fmt.Errorf("member payload is missing the member")
+		}
+
+		event.Member = payload.Member.Login
+		if event.Member == "" {
+			event.Member = payload.Member.Username
+		}
+	}
+
+	return nil
+}
+
+func parseGitLabEvent(event *Event, name string, body []byte, gitlabURL string) error {
+	switch name {
+	case "Merge Request Hook":
+		event.Name = provider.EventPullRequest
+	case "Push Hook":
+		event.Name = provider.EventPush
+	case "System Hook":
+		return parseGitLabSystemEvent(event, body, gitlabURL)
+	default:
+		return nil
+	}
+
+	var payload gitlabPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", name, err)
+	}
+
+	event.RepositoryURL = payload.Project.WebURL
+
+	if event.Name != provider.EventPullRequest {
+		return nil
+	}
+
+	attrs := payload.ObjectAttributes
+	event.Action = attrs.Action
+
+	state := provider.StateOpen
+
+	var mergedAt, closedAt *time.Time
+
+	switch attrs.State {
+	case "merged":
+		state = provider.StateMerged
+		at := attrs.UpdatedAt.Time
+		mergedAt, closedAt = &at, &at
+	case "closed":
+		state = provider.StateClosed
+		at := attrs.UpdatedAt.Time
+		closedAt = &at
+	}
+
+	owner, repo := payload.Project.PathWithNamespace, ""
+	if i := strings.LastIndex(owner, "/"); i >= 0 {
+		owner, repo = owner[:i], owner[i+1:]
+	}
+
+	event.PullRequest = &provider.PullRequest{
+		Owner:     owner,
+		Repo:      repo,
+		Number:    attrs.IID,
+		Title:     attrs.Title,
+		URL:       attrs.URL,
+		State:     state,
+		Author:    payload.User.Username,
+		HeadSHA:   attrs.LastCommit.ID,
+		CreatedAt: attrs.CreatedAt.Time,
+		UpdatedAt: attrs.UpdatedAt.Time,
+		MergedAt:  mergedAt,
+		ClosedAt:  closedAt,
+	}
+
+	return nil
+}
+
+// parseGitLabSystemEvent decodes a GitLab system hook delivery. Project member and project
+// deletion events are only delivered as system hooks; merge request and push events delivered
+// this way have the same payload as project hooks. GitLab sends no event when a project is
+// archived.
+func parseGitLabSystemEvent(event *Event, body []byte, gitlabURL string) error {
+	var payload gitlabSystemPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return fmt.Errorf("invalid System Hook payload: %w", err)
+	}
+
+	projectURL := func(path string) string {
+		return strings.TrimRight(gitlabURL, "/") + "/" + path
+	}
+
+	switch {
+	case payload.ObjectKind == "merge_request":
+		return parseGitLabEvent(event, "Merge Request Hook", body, gitlabURL)
+	case payload.ObjectKind == "push":
+		return parseGitLabEvent(event, "Push Hook", body, gitlabURL)
+	case payload.EventName == "user_add_to_team" || payload.EventName == "user_remove_from_team":
+		event.Name = provider.EventMember
+		event.Action = ActionAdded
+		if payload.EventName == "user_remove_from_team" {
+			event.Action = ActionRemoved
+		}
+
+		event.RepositoryURL = projectURL(payload.ProjectPathWithNamespace)
+		event.Member = payload.UserUsername
+	case payload.EventName == "project_destroy":
+		event.Name = provider.EventRepository
+		event.Action = ActionDeleted
+		event.RepositoryURL = projectURL(payload.PathWithNamespace)
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"sourcestream/backend/models"
+	"sourcestream/backend/provider"
+	"sourcestream/backend/repository"
+	"sourcestream/backend/workers"
+)
+
+// ErrIgnored is returned by handlers for events that do not concern anything SourceStream tracks.
+var ErrIgnored = errors.New("webhook event ignored")
+
+// Project statuses set from repository events.
+const (
+	projectStatusActive   = "active"
+	projectStatusArchived = "archived"
+)
+
+type pullRequestStore interface {
+	GetTrackedPullRequestsByURL(ctx context.Context, pullRequestURL string) ([]*models.TrackedPullRequest, error)
+	RecordPullRequestObservation(ctx context.Context, obs *repository.PullRequestObservation) error
+}
+
+type projectStore interface {
+	GetProjectsByURL(ctx context.Context, url string) ([]*models.Project, error)
+	UpdateProjectStatus(ctx context.Context, id, status string) error
+	TouchProject(ctx context.Context, id string) error
+	GetProjectContributors(ctx context.Context, projectID string) ([]*models.ProjectContributor, error)
+	AddContributor(ctx context.Context, projectID, userID, role string, permissions []string) error
+	RemoveContributor(ctx context.Context, projectID, userID string) error
+}
+
+type contributionStore interface {
+	RecordContribution(ctx context.Context, contribution *models.Contribution) error
+}
+
+type userStore interface {
+	GetUserByGithubUsername(ctx context.Context, username string) (*models.User, error)
+}
+
+// Dispatcher routes events to the handler for their type.
+type Dispatcher struct {
+	pullRequests  pullRequestStore
+	projects      projectStore
+	contributions contributionStore
+	users         userStore
+}
+
+// NewDispatcher creates a Dispatcher backed by the given database.
+func NewDispatcher(db *sql.DB) *Dispatcher {
+	return &Dispatcher{
+		pullRequests:  repository.NewPullRequestRepository(db),
+		projects:      repository.NewProjectRepository(db),
+		contributions: repository.NewContributionRepository(db),
+		users:         repository.NewUserRepository(db),
+	}
+}
+
+// Handle implements Handler.
+func (d *Dispatcher) Handle(ctx context.Context, event *Event) error {
+	switch event.Name {
+	case provider.EventPullRequest:
+		return d.handlePullRequest(ctx, event)
+	case provider.EventMember:
+		return d.handleMember(ctx, event)
+	case provider.EventRepository:
+		return d.handleRepository(ctx, event)
+	case provider.EventPush:
+		return d.handlePush(ctx, event)
+	default:
+		return ErrIgnored
+	}
+}
+
+// handlePullRequest records the upstream state of every request tracking the pull request, the
+// same way the status poller does, and keeps the contribution ledger in step for approved requests.
+// Deliveries older than the last recorded state, such as late redeliveries, are skipped.
+func (d *Dispatcher) handlePullRequest(ctx context.Context, event *Event) error {
+	pr := event.PullRequest
+
+	tracked, err := d.pullRequests.GetTrackedPullRequestsByURL(ctx, pr.URL)
+	if err != nil {
+		return fmt.Errorf("failed to look up requests for %s: %w", pr.URL, err)
+	}
+
+	if len(tracked) == 0 {
+		return ErrIgnored
+	}
+
+	for _, t := range tracked {
+		if t.UpstreamUpdatedAt != nil && pr.UpdatedAt.Before(*t.UpstreamUpdatedAt) {
+			continue
+		}
+
+		obs := workers.NewPullRequestObservation(t, pr, event.Source)
+		if err := d.pullRequests.RecordPullRequestObservation(ctx, obs); err != nil {
+			return fmt.Errorf("failed to record pull request status for request %s: %w", t.RequestID, err)
+		}
+
+		if t.RequestStatus != "approved" {
+			continue
+		}
+
+		contribution := &models.Contribution{
+			RequestID:     t.RequestID,
+			Kind:          repository.ContributionKindPullRequest,
+			URL:           pr.URL,
+			Title:         pr.Title,
+			Outcome:       pr.State,
+			ContributedAt: pr.CreatedAt,
+			ResolvedAt:    pr.ClosedAt,
+			Source:        repository.ContributionSourceWebhook,
+		}
+		if pr.MergedAt != nil {
+			contribution.ResolvedAt = pr.MergedAt
+		}
+
+		if err := d.contributions.RecordContribution(ctx, contribution); err != nil {
+			return fmt.Errorf("failed to record contribution for request %s: %w", t.RequestID, err)
+		}
+	}
+
+	return nil
+}
+
+// handleMember mirrors collaborator changes on an upstream repository into project_contributors.
+func (d *Dispatcher) handleMember(ctx context.Context, event *Event) error {
+	if event.Action != ActionAdded && event.Action != ActionRemoved {
+		return ErrIgnored
+	}
+
+	projects, err := d.projects.GetProjectsByURL(ctx, event.RepositoryURL)
+	if err != nil {
+		return fmt.Errorf("failed to look up projects for %s: %w", event.RepositoryURL, err)
+	}
+
+	if len(projects) == 0 {
+		return ErrIgnored
+	}
+
+	user, err := d.users.GetUserByGithubUsername(ctx, event.Member)
+	if err != nil {
+		// Upstream collaborators without a SourceStream account are not tracked
+		return ErrIgnored
+	}
+
+	for _, project := range projects {
+		if event.Action == ActionRemoved {
+			if err := d.projects.RemoveContributor(ctx, project.ID, user.ID); err != nil {
+				return fmt.Errorf("failed to remove contributor from project %s: %w", project.ID, err)
+			}
+
+			continue
+		}
+
+		contributors, err := d.projects.GetProjectContributors(ctx, project.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list contributors of project %s: %w", project.ID, err)
+		}
+
+		if isContributor(contributors, user.ID) {
+			// Keep the existing role; owners and maintainers are not demoted
+			continue
+		}
+
+		if err := d.projects.AddContributor(ctx, project.ID, user.ID, "contributor", nil); err != nil {
+			return fmt.Errorf("failed to add contributor to project %s: %w", project.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// handleRepository archives projects whose upstream repository was archived or deleted and
+// reactivates them when it is unarchived.
+func (d *Dispatcher) handleRepository(ctx context.Context, event *Event) error {
+	var status string
+
+	switch event.Action {
+	case ActionArchived, ActionDeleted:
+		status = projectStatusArchived
+	case ActionUnarchived:
+		status = projectStatusActive
+	default:
+		return ErrIgnored
+	}
+
+	projects, err := d.projects.GetProjectsByURL(ctx, event.RepositoryURL)
+	if err != nil {
+		return fmt.Errorf("failed to look up projects for %s: %w", event.RepositoryURL, err)
+	}
+
+	if len(projects) == 0 {
+		return ErrIgnored
+	}
+
+	for _, project := range projects {
+		if err := d.projects.UpdateProjectStatus(ctx, project.ID, status); err != nil {
+			return fmt.Errorf("failed to update status of project %s: %w", project.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// handlePush records upstream activity on the matching projects.
+func (d *Dispatcher) handlePush(ctx context.Context, event *Event) error {
+	projects, err := d.projects.GetProjectsByURL(ctx, event.RepositoryURL)
+	if err != nil {
+		return fmt.Errorf("failed to look up projects for %s: %w", event.RepositoryURL, err)
+	}
+
+	if len(projects) == 0 {
+		return ErrIgnored
+	}
+
+	for _, project := range projects {
+		if err := d.projects.TouchProject(ctx, project.ID); err != nil {
+			return fmt.Errorf("failed to update project %s: %w", project.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func isContributor(contributors []*models.ProjectContributor, userID string) bool {
+	for _, c := range contributors {
+		if c.UserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
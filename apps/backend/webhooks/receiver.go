@@ -0,0 +1,193 @@
+// Package webhooks receives webhook deliveries from Git hosting providers, verifies their
+// signatures, deduplicates redeliveries and routes the events to handlers that update
+// SourceStream state.
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"sourcestream/backend/config"
+	"sourcestream/backend/provider"
+	"sourcestream/backend/repository"
+)
+
+// PathPrefix is where the receiver is mounted; the source follows it, e.g. /webhooks/github.
+const PathPrefix = "/webhooks/"
+
+// maxPayloadBytes matches the largest payload GitHub delivers.
+const maxPayloadBytes = 25 << 20
+
+// DeliveryStore records deliveries so that each one is processed at most once.
+type DeliveryStore interface {
+	BeginDelivery(ctx context.Context, source, deliveryID, event string) (bool, error)
+	FinishDelivery(ctx context.Context, source, deliveryID, status, lastError string) error
+}
+
+// Handler acts on a verified, normalized event.
+type Handler interface {
+	Handle(ctx context.Context, event *Event) error
+}
+
+// Receiver is the http.Handler for inbound webhook deliveries.
+type Receiver struct {
+	store     DeliveryStore
+	handler   Handler
+	secrets   map[string]string
+	gitlabURL string
+}
+
+// NewReceiver creates a Receiver that records deliveries in the database and dispatches events
+// to the default handlers.
+func NewReceiver(db *sql.DB, cfg *config.WebhookConfig) *Receiver {
+	return newReceiver(repository.NewWebhookRepository(db), NewDispatcher(db), cfg)
+}
+
+func newReceiver(store DeliveryStore, handler Handler, cfg *config.WebhookConfig) *Receiver {
+	return &Receiver{
+		store:     store,
+		handler:   handler,
+		secrets:   cfg.Secrets,
+		gitlabURL: cfg.GitLabURL,
+	}
+}
+
+// ServeHTTP verifies and processes a single delivery. Sources without a configured secret are
+// not accepted. Failed deliveries answer 500 so that the provider retries them.
+func (rc *Receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	source := strings.Trim(strings.TrimPrefix(r.URL.Path, PathPrefix), "/")
+
+	secret, ok := rc.secrets[source]
+	if !ok || secret == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadBytes))
+	if err != nil {
+		http.Error(w, "failed to read payload", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if !verifySignature(source, secret, r.Header, body) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	name, deliveryID := deliveryHeaders(source, r.Header)
+	if deliveryID == "" {
+		http.Error(w, "missing delivery ID", http.StatusBadRequest)
+		return
+	}
+
+	if name == "ping" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	begun, err := rc.store.BeginDelivery(r.Context(), source, deliveryID, name)
+	if err != nil {
+		log.Printf("webhook %s/%s: failed to record delivery: %v", source, deliveryID, err)
+		http.Error(w, "failed to record delivery", http.StatusInternalServerError)
+
+		return
+	}
+
+	if !begun {
+		// Duplicate delivery; it was already handled or is being handled
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	status, code, lastError := rc.process(r.Context(), source, deliveryID, name, body)
+
+	// Record the outcome even if the sender hung up, so that the delivery is not left in progress
+	if err := rc.store.FinishDelivery(context.WithoutCancel(r.Context()), source, deliveryID, status, lastError); err != nil {
+		log.Printf("webhook %s/%s: failed to record delivery outcome: %v", source, deliveryID, err)
+	}
+
+	w.WriteHeader(code)
+}
+
+// process parses and dispatches a delivery, returning its delivery status, the HTTP status
+// code to answer with and the error to record, if any.
+func (rc *Receiver) process(ctx context.Context, source, deliveryID, name string, body []byte) (string, int, string) {
+	event, err := parseEvent(source, deliveryID, name, body, rc.gitlabURL)
+	if err != nil {
+		// A malformed payload will not improve on retry
+		return repository.WebhookDeliveryIgnored, http.StatusBadRequest, err.Error()
+	}
+
+	if event.Name == "" {
+		return repository.WebhookDeliveryIgnored, http.StatusAccepted, ""
+	}
+
+	if err := rc.handler.Handle(ctx, event); err != nil {
+		if errors.Is(err, ErrIgnored) {
+			return repository.WebhookDeliveryIgnored, http.StatusAccepted, ""
+		}
+
+		log.Printf("webhook %s/%s: failed to handle %s event: %v", source, deliveryID, event.Name, err)
+
+		return repository.WebhookDeliveryFailed, http.StatusInternalServerError, err.Error()
+	}
+
+	return repository.WebhookDeliveryProcessed, http.StatusOK, ""
+}
+
+// deliveryHeaders returns the provider event name and delivery ID of a request.
+func deliveryHeaders(source string, header http.Header) (string, string) {
+	switch source {
+	case provider.KindGitLab:
+		return header.Get("X-Gitlab-Event"), header.Get("X-Gitlab-Event-UUID")
+	case provider.KindGitea:
+		return header.Get("X-Gitea-Event"), header.Get("X-Gitea-Delivery")
+	default:
+		return header.Get("X-GitHub-Event"), header.Get("X-GitHub-Delivery")
+	}
+}
+
+// verifySignature checks the delivery against the source's secret. GitHub and Gitea sign the
+// body with HMAC-SHA256; GitLab sends the secret token itself.
+func verifySignature(source, secret string, header http.Header, body []byte) bool {
+	var signature string
+
+	switch source {
+	case provider.KindGitLab:
+		token := header.Get("X-Gitlab-Token")
+		return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+	case provider.KindGitea:
+		signature = header.Get("X-Gitea-Signature")
+	default:
+		var found bool
+
+		signature, found = strings.CutPrefix(header.Get("X-Hub-Signature-256"), "sha256=")
+		if !found {
+			return false
+		}
+	}
+
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(got, mac.Sum(nil))
+}
@@ -0,0 +1,390 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sourcestream/backend/config"
+	"sourcestream/backend/models"
+	"sourcestream/backend/provider"
+	"sourcestream/backend/repository"
+)
+
+type fakeDeliveryStore struct {
+	status map[string]string
+}
+
+func (f *fakeDeliveryStore) BeginDelivery(_ context.Context, source, deliveryID, _ string) (bool, error) {
+	key := source + "/" + deliveryID
+	if status, ok := f.status[key]; ok && status != repository.WebhookDeliveryFailed {
+		return false, nil
+	}
+
+	f.status[key] = repository.WebhookDeliveryProcessing
+
+	return true, nil
+}
+
+func (f *fakeDeliveryStore) FinishDelivery(_ context.Context, source, deliveryID, status, _ string) error {
+	f.status[source+"/"+deliveryID] = status
+	return nil
+}
+
+type recordingHandler struct {
+	events []*Event
+	err    error
+}
+
+func (h *recordingHandler) Handle(_ context.Context, event *Event) error {
+	h.events = append(h.events, event)
+	return h.err
+}
+
+const pullRequestPayload = `{
+	"action": "closed",
+	"repository": {"name": "widgets", "html_url": "https://github.com/acme/widgets", "owner": {"login": "acme"}},
+	"pull_request": {
+		"number": 7, "title": "Fix widgets", "html_url": "https://github.com/acme/widgets/pull/7",
+		"state": "closed", "merged": true, "user": {"login": "alice"}, "head": {"sha": "abc123"},
+		"created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-02T00:00:00Z",
+		"merged_at": "2025-01-02T00:00:00Z", "closed_at": "2025-01-02T00:00:00Z"
+	}
+}`
+
+func newTestReceiver(handler Handler) (*Receiver, *fakeDeliveryStore) {
+	store := &fakeDeliveryStore{status: map[string]string{}}
+	cfg := &config.WebhookConfig{
+		Secrets:   map[string]string{"github": "s3cret", "gitlab": "t0ken", "gitea": ""},
+		GitLabURL: "https://gitlab.example.com/",
+	}
+
+	return newReceiver(store, handler, cfg), store
+}
+
+func githubDelivery(t *testing.T, secret, event, deliveryID, body string) *http.Request {
+	t.Helper()
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/github", strings.NewReader(body))
+	req.Header.Set("X-GitHub-Event", event)
+	req.Header.Set("X-GitHub-Delivery", deliveryID)
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	return req
+}
+
+func TestReceiver_PullRequestDelivery(t *testing.T) {
+	handler := &recordingHandler{}
+	receiver, store := newTestReceiver(handler)
+
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, githubDelivery(t, "s3cret", "pull_request", "d-1", pullRequestPayload))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, repository.WebhookDeliveryProcessed, store.status["github/d-1"])
+	require.Len(t, handler.events, 1)
+
+	pr := handler.events[0].PullRequest
+	require.NotNil(t, pr)
+	assert.Equal(t, provider.StateMerged, pr.State)
+	assert.Equal(t, "acme", pr.Owner)
+	assert.Equal(t, "widgets", pr.Repo)
+	assert.Equal(t, 7, pr.Number)
+	assert.Equal(t, "abc123", pr.HeadSHA)
+
+	// A redelivery of the same ID is acknowledged without being handled again
+	rec = httptest.NewRecorder()
+	receiver.ServeHTTP(rec, githubDelivery(t, "s3cret", "pull_request", "d-1", pullRequestPayload))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, handler.events, 1)
+}
+
+func TestReceiver_RejectsBadSignature(t *testing.T) {
+	handler := &recordingHandler{}
+	receiver, store := newTestReceiver(handler)
+
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, githubDelivery(t, "wrong", "pull_request", "d-1", pullRequestPayload))
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, handler.events)
+	assert.Empty(t, store.status)
+}
+
+func TestReceiver_UnconfiguredSource(t *testing.T) {
+	receiver, _ := newTestReceiver(&recordingHandler{})
+
+	for _, path := range []string{"/webhooks/gitea", "/webhooks/bitbucket"} {
+		rec := httptest.NewRecorder()
+		receiver.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, strings.NewReader("{}")))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code, path)
+	}
+}
+
+func TestReceiver_FailedDeliveryIsRetried(t *testing.T) {
+	handler := &recordingHandler{err: fmt.Errorf("database unavailable")}
+	receiver, store := newTestReceiver(handler)
+
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, githubDelivery(t, "s3cret", "pull_request", "d-2", pullRequestPayload))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, repository.WebhookDeliveryFailed, store.status["github/d-2"])
+
+	handler.err = nil
+	rec = httptest.NewRecorder()
+	receiver.ServeHTTP(rec, githubDelivery(t, "s3cret", "pull_request", "d-2", pullRequestPayload))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, handler.events, 2)
+}
+
+func TestReceiver_GitLabMergeRequest(t *testing.T) {
+	handler := &recordingHandler{}
+	receiver, _ := newTestReceiver(handler)
+
+	body := `{
+		"object_kind": "merge_request",
+		"user": {"username": "bob"},
+		"project": {"web_url": "https://gitlab.com/acme/tools/widgets", "path_with_namespace": "acme/tools/widgets"},
+		"object_attributes": {
+			"iid": 3, "title": "Add gadget", "url": "https://gitlab.com/acme/tools/widgets/-/merge_requests/3",
+			"state": "opened", "action": "open", "last_commit": {"id": "def456"},
+			"created_at": "2025-01-01 10:00:00 UTC", "updated_at": "2025-01-01T11:00:00Z"
+		}
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/gitlab", strings.NewReader(body))
+	req.Header.Set("X-Gitlab-Event", "Merge Request Hook")
+	req.Header.Set("X-Gitlab-Event-UUID", "u-1")
+	req.Header.Set("X-Gitlab-Token", "t0ken")
+
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, handler.events, 1)
+
+	pr := handler.events[0].PullRequest
+	assert.Equal(t, provider.EventPullRequest, handler.events[0].Name)
+	assert.Equal(t, provider.StateOpen, pr.State)
+	assert.Equal(t, "acme/tools", pr.Owner)
+	assert.Equal(t, "widgets", pr.Repo)
+	assert.Equal(t, time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC), pr.CreatedAt.UTC())
+}
+
+func TestReceiver_GitLabSystemHooks(t *testing.T) {
+	handler := &recordingHandler{}
+	receiver, _ := newTestReceiver(handler)
+
+	bodies := []string{
+		`{"event_name": "user_add_to_team", "project_path_with_namespace": "acme/widgets", "user_username": "alice"}`,
+		`{"event_name": "user_remove_from_team", "project_path_with_namespace": "acme/widgets", "user_username": "alice"}`,
+		`{"event_name": "project_destroy", "path_with_namespace": "acme/widgets"}`,
+	}
+
+	for i, body := range bodies {
+		req := httptest.NewRequest(http.MethodPost, "/webhooks/gitlab", strings.NewReader(body))
+		req.Header.Set("X-Gitlab-Event", "System Hook")
+		req.Header.Set("X-Gitlab-Event-UUID", fmt.Sprintf("s-%d", i))
+		req.Header.Set("X-Gitlab-Token", "t0ken")
+
+		rec := httptest.NewRecorder()
+		receiver.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	require.Len(t, handler.events, 3)
+	assert.Equal(t, &Event{
+		Name: provider.EventMember, Source: "gitlab", DeliveryID: "s-0", Action: ActionAdded,
+		RepositoryURL: "https://gitlab.example.com/acme/widgets", Member: "alice",
+	}, handler.events[0])
+	assert.Equal(t, ActionRemoved, handler.events[1].Action)
+	assert.Equal(t, provider.EventRepository, handler.events[2].Name)
+	assert.Equal(t, ActionDeleted, handler.events[2].Action)
+	assert.Equal(t, "https://gitlab.example.com/acme/widgets", handler.events[2].RepositoryURL)
+}
+
+func TestReceiver_IgnoresUnhandledEvents(t *testing.T) {
+	handler := &recordingHandler{}
+	receiver, store := newTestReceiver(handler)
+
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, githubDelivery(t, "s3cret", "issues", "d-3", `{"action":"opened"}`))
+
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Equal(t, repository.WebhookDeliveryIgnored, store.status["github/d-3"])
+	assert.Empty(t, handler.events)
+}
+
+type fakeDispatchStore struct {
+	tracked       []*models.TrackedPullRequest
+	observations  []*repository.PullRequestObservation
+	contributions []*models.Contribution
+	projects      []*models.Project
+	contributors  map[string][]*models.ProjectContributor
+	statuses      map[string]string
+}
+
+func (f *fakeDispatchStore) GetTrackedPullRequestsByURL(context.Context, string) ([]*models.TrackedPullRequest, error) {
+	return f.tracked, nil
+}
+
+func (f *fakeDispatchStore) RecordPullRequestObservation(_ context.Context, obs *repository.PullRequestObservation) error {
+	f.observations = append(f.observations, obs)
+	return nil
+}
+
+func (f *fakeDispatchStore) RecordContribution(_ context.Context, contribution *models.Contribution) error {
+	f.contributions = append(f.contributions, contribution)
+	return nil
+}
+
+func (f *fakeDispatchStore) GetProjectsByURL(context.Context, string) ([]*models.Project, error) {
+	return f.projects, nil
+}
+
+func (f *fakeDispatchStore) UpdateProjectStatus(_ context.Context, id, status string) error {
+	f.statuses[id] = status
+	return nil
+}
+
+func (f *fakeDispatchStore) TouchProject(context.Context, string) error { return nil }
+
+func (f *fakeDispatchStore) GetProjectContributors(_ context.Context, projectID string) ([]*models.ProjectContributor, error) {
+	return f.contributors[projectID], nil
+}
+
+func (f *fakeDispatchStore) AddContributor(_ context.Context, projectID, userID, role string, _ []string) error {
+	f.contributors[projectID] = append(f.contributors[projectID], &models.ProjectContributor{ProjectID: projectID, UserID: userID, Role: role})
+	return nil
+}
+
+func (f *fakeDispatchStore) RemoveContributor(_ context.Context, projectID, userID string) error {
+	var kept []*models.ProjectContributor
+
+	for _, c := range f.contributors[projectID] {
+		if c.UserID != userID {
+			kept = append(kept, c)
+		}
+	}
+
+	f.contributors[projectID] = kept
+
+	return nil
+}
+
+func (f *fakeDispatchStore) GetUserByGithubUsername(_ context.Context, username string) (*models.User, error) {
+	if username == "alice" {
+		return &models.User{ID: "user-alice", GithubUsername: "alice"}, nil
+	}
+
+	return nil, fmt.Errorf("user not found")
+}
+
+func newTestDispatcher(store *fakeDispatchStore) *Dispatcher {
+	return &Dispatcher{pullRequests: store, projects: store, contributions: store, users: store}
+}
+
+func TestDispatcher_MergedPullRequest(t *testing.T) {
+	approvedAt := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	open := provider.StateOpen
+	store := &fakeDispatchStore{
+		tracked: []*models.TrackedPullRequest{
+			{RequestID: "req-1", RequestStatus: "approved", ApprovedAt: &approvedAt, State: &open},
+		},
+	}
+
+	event, err := parseEvent("github", "d-1", "pull_request", []byte(pullRequestPayload), "")
+	require.NoError(t, err)
+	require.NoError(t, newTestDispatcher(store).Handle(context.Background(), event))
+
+	require.Len(t, store.observations, 1)
+	assert.Equal(t, provider.StateMerged, store.observations[0].State)
+	assert.Empty(t, store.observations[0].PolicyViolation)
+	require.Len(t, store.observations[0].Events, 1)
+	assert.Equal(t, repository.PullRequestEventMerged, store.observations[0].Events[0].EventType)
+
+	assert.Equal(t, repository.RequestStatusMerged, store.observations[0].RequestStatus)
+
+	require.Len(t, store.contributions, 1)
+	assert.Equal(t, repository.ContributionOutcomeMerged, store.contributions[0].Outcome)
+	assert.Equal(t, repository.ContributionSourceWebhook, store.contributions[0].Source)
+
+	// A redelivery, seen against the stored merged state, emits nothing again
+	merged := provider.StateMerged
+	updatedAt := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	store.tracked[0].State, store.tracked[0].RequestStatus, store.tracked[0].UpstreamUpdatedAt = &merged, "merged", &updatedAt
+	require.NoError(t, newTestDispatcher(store).Handle(context.Background(), event))
+
+	require.Len(t, store.observations, 2)
+	assert.Empty(t, store.observations[1].Events)
+	assert.Empty(t, store.observations[1].RequestStatus)
+}
+
+func TestDispatcher_StaleDeliveryIsSkipped(t *testing.T) {
+	open := provider.StateOpen
+	updatedAt := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+	store := &fakeDispatchStore{
+		tracked: []*models.TrackedPullRequest{
+			{RequestID: "req-1", RequestStatus: "approved", State: &open, UpstreamUpdatedAt: &updatedAt},
+		},
+	}
+
+	event, err := parseEvent("github", "d-1", "pull_request", []byte(pullRequestPayload), "")
+	require.NoError(t, err)
+	require.NoError(t, newTestDispatcher(store).Handle(context.Background(), event))
+
+	assert.Empty(t, store.observations)
+	assert.Empty(t, store.contributions)
+}
+
+func TestDispatcher_UntrackedPullRequestIsIgnored(t *testing.T) {
+	event, err := parseEvent("github", "d-1", "pull_request", []byte(pullRequestPayload), "")
+	require.NoError(t, err)
+
+	err = newTestDispatcher(&fakeDispatchStore{}).Handle(context.Background(), event)
+	assert.ErrorIs(t, err, ErrIgnored)
+}
+
+func TestDispatcher_MemberAndRepositoryEvents(t *testing.T) {
+	store := &fakeDispatchStore{
+		projects: []*models.Project{{ID: "proj-1", URL: "https://github.com/acme/widgets"}},
+		contributors: map[string][]*models.ProjectContributor{
+			"proj-1": {{ProjectID: "proj-1", UserID: "owner", Role: "owner"}},
+		},
+		statuses: map[string]string{},
+	}
+	d := newTestDispatcher(store)
+	ctx := context.Background()
+
+	added := &Event{Name: provider.EventMember, Action: ActionAdded, RepositoryURL: "https://github.com/acme/widgets", Member: "alice"}
+	require.NoError(t, d.Handle(ctx, added))
+	require.NoError(t, d.Handle(ctx, added))
+	assert.Len(t, store.contributors["proj-1"], 2)
+
+	unknown := &Event{Name: provider.EventMember, Action: ActionAdded, RepositoryURL: "https://github.com/acme/widgets", Member: "mallory"}
+	assert.ErrorIs(t, d.Handle(ctx, unknown), ErrIgnored)
+
+	removed := &Event{Name: provider.EventMember, Action: ActionRemoved, RepositoryURL: "https://github.com/acme/widgets", Member: "alice"}
+	require.NoError(t, d.Handle(ctx, removed))
+	assert.Len(t, store.contributors["proj-1"], 1)
+
+	archived := &Event{Name: provider.EventRepository, Action: ActionArchived, RepositoryURL: "https://github.com/acme/widgets"}
+	require.NoError(t, d.Handle(ctx, archived))
+	assert.Equal(t, "archived", store.statuses["proj-1"])
+}
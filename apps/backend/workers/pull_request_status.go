@@ -0,0 +1,282 @@
+package workers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"sourcestream/backend/config"
+	"sourcestream/backend/models"
+	"sourcestream/backend/provider"
+	"sourcestream/backend/repository"
+)
+
+// PullRequestStore is the persistence used by PullRequestStatusWorker.
+type PullRequestStore interface {
+	GetTrackablePullRequests(ctx context.Context, limit int) ([]*models.TrackedPullRequest, error)
+	RecordPullRequestObservation(ctx context.Context, obs *repository.PullRequestObservation) error
+}
+
+// PullRequestStatusResult summarizes a single pass of the pull request status poller.
+type PullRequestStatusResult struct {
+	Checked          int
+	Events           int
+	PolicyViolations int
+	Errors           int
+}
+
+// PullRequestStatusWorker polls the hosting provider for the upstream state of pull request
+// approval requests, records opened, updated, merged and closed events, and flags pull requests
+// that were merged without an approved request.
+type PullRequestStatusWorker struct {
+	store     PullRequestStore
+	provider  provider.Provider
+	interval  time.Duration
+	batchSize int
+	state     stateTracker
+}
+
+// NewPullRequestStatusWorker creates a PullRequestStatusWorker with the given database, provider and configuration.
+func NewPullRequestStatusWorker(db *sql.DB, gitProvider provider.Provider, cfg *config.PullRequestPollConfig) *PullRequestStatusWorker {
+	return newPullRequestStatusWorker(repository.NewPullRequestRepository(db), gitProvider, cfg)
+}
+
+func newPullRequestStatusWorker(store PullRequestStore, gitProvider provider.Provider, cfg *config.PullRequestPollConfig) *PullRequestStatusWorker {
+	return &PullRequestStatusWorker{
+		store:     store,
+		provider:  gitProvider,
+		interval:  cfg.Interval,
+		batchSize: cfg.BatchSize,
+	}
+}
+
+// Run executes the poller immediately and then on every interval until ctx is cancelled.
+func (w *PullRequestStatusWorker) Run(ctx context.Context) {
+	w.state.setRunning(true)
+	defer w.state.setRunning(false)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := w.RunOnce(ctx)
+		w.state.record(err)
+
+		if err != nil {
+			log.Printf("pull request status poll failed: %v", err)
+		} else if result.Events > 0 || result.Errors > 0 {
+			log.Printf("pull request status poll: checked %d, recorded %d events, %d policy violations, %d errors",
+				result.Checked, result.Events, result.PolicyViolations, result.Errors)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// State reports whether the worker is running and how its last pass went.
+func (w *PullRequestStatusWorker) State() State {
+	return w.state.get()
+}
+
+// RunOnce checks one batch of tracked pull requests. Failures for individual pull requests are
+// recorded against them and counted rather than aborting the pass.
+func (w *PullRequestStatusWorker) RunOnce(ctx context.Context) (PullRequestStatusResult, error) {
+	var result PullRequestStatusResult
+
+	tracked, err := w.store.GetTrackablePullRequests(ctx, w.batchSize)
+	if err != nil {
+		return result, fmt.Errorf("failed to list tracked pull requests: %w", err)
+	}
+
+	for _, pr := range tracked {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		obs := w.observe(ctx, pr)
+		if err := w.store.RecordPullRequestObservation(ctx, obs); err != nil {
+			return result, fmt.Errorf("failed to record pull request status for request %s: %w", pr.RequestID, err)
+		}
+
+		result.Checked++
+		result.Events += len(obs.Events)
+
+		if obs.PolicyViolation != "" {
+			result.PolicyViolations++
+		}
+
+		if obs.LastError != "" {
+			result.Errors++
+		}
+	}
+
+	return result, nil
+}
+
+// observe fetches the upstream state of a tracked pull request and derives the events since the last check.
+func (w *PullRequestStatusWorker) observe(ctx context.Context, pr *models.TrackedPullRequest) *repository.PullRequestObservation {
+	obs := &repository.PullRequestObservation{
+		RequestID: pr.RequestID,
+		Provider:  w.provider.Kind(),
+	}
+
+	ref, err := provider.ParsePullRequestURL(pr.PullRequestURL)
+	if err != nil {
+		obs.State = repository.PullRequestStateInvalid
+		obs.LastError = err.Error()
+
+		return obs
+	}
+
+	obs.Owner, obs.Repo, obs.Number = ref.Owner, ref.Repo, ref.Number
+
+	if host := w.provider.Host(); host != "" && !strings.EqualFold(ref.Host, host) {
+		return unobserved(obs, pr, fmt.Sprintf("pull request host %s is not served by the %s provider", ref.Host, w.provider.Kind()))
+	}
+
+	upstream, err := w.provider.GetPullRequest(ctx, ref.Owner, ref.Repo, ref.Number)
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return unobserved(obs, pr, "pull request not found upstream")
+		}
+
+		return unobserved(obs, pr, err.Error())
+	}
+
+	return NewPullRequestObservation(pr, upstream, w.provider.Kind())
+}
+
+// unobserved records a failed check. The last known state is kept, and left empty when the pull
+// request was never observed, so that a failure produces no events and the first successful check
+// still records the pull request as opened.
+func unobserved(obs *repository.PullRequestObservation, pr *models.TrackedPullRequest, lastError string) *repository.PullRequestObservation {
+	if pr.State != nil {
+		obs.State = *pr.State
+	}
+
+	if pr.HeadSHA != nil {
+		obs.HeadSHA = *pr.HeadSHA
+	}
+
+	obs.UpstreamUpdatedAt = pr.UpstreamUpdatedAt
+	obs.LastError = lastError
+
+	return obs
+}
+
+// NewPullRequestObservation derives the observation to record for a tracked pull request from its
+// current upstream state: the new state, the events since the last observation, the request status
+// that follows from a merge or close and, when the pull request was merged without an approved
+// request, a policy violation. Observing the same state again derives no events, so redelivered
+// webhooks and repeated polls are harmless.
+func NewPullRequestObservation(pr *models.TrackedPullRequest, upstream *provider.PullRequest, providerKind string) *repository.PullRequestObservation {
+	updatedAt := upstream.UpdatedAt
+
+	obs := &repository.PullRequestObservation{
+		RequestID:         pr.RequestID,
+		Provider:          providerKind,
+		Owner:             upstream.Owner,
+		Repo:              upstream.Repo,
+		Number:            upstream.Number,
+		State:             upstream.State,
+		HeadSHA:           upstream.HeadSHA,
+		UpstreamUpdatedAt: &updatedAt,
+		Events:            pullRequestEvents(pr, upstream),
+		RequestStatus:     requestStatusAfter(pr, upstream),
+	}
+
+	if stateChanged(pr, upstream) && upstream.State == provider.StateMerged && mergedWithoutApproval(pr, upstream) {
+		obs.PolicyViolation = fmt.Sprintf("pull request %s was merged upstream without an approved request", upstream.URL)
+	}
+
+	return obs
+}
+
+// pullRequestEvents compares the last observed state with the current upstream pull request.
+func pullRequestEvents(prev *models.TrackedPullRequest, cur *provider.PullRequest) []*models.PullRequestEvent {
+	var events []*models.PullRequestEvent
+
+	event := func(eventType string, at *time.Time) {
+		occurredAt := cur.UpdatedAt
+		if at != nil {
+			occurredAt = *at
+		}
+
+		headSHA := cur.HeadSHA
+		events = append(events, &models.PullRequestEvent{
+			RequestID:  prev.RequestID,
+			EventType:  eventType,
+			HeadSHA:    &headSHA,
+			OccurredAt: occurredAt,
+		})
+	}
+
+	if prev.State == nil {
+		event(repository.PullRequestEventOpened, &cur.CreatedAt)
+	} else if cur.State == provider.StateOpen && pullRequestChanged(prev, cur) {
+		event(repository.PullRequestEventUpdated, nil)
+	}
+
+	if !stateChanged(prev, cur) {
+		return events
+	}
+
+	switch cur.State {
+	case provider.StateMerged:
+		event(repository.PullRequestEventMerged, cur.MergedAt)
+	case provider.StateClosed:
+		event(repository.PullRequestEventClosed, cur.ClosedAt)
+	}
+
+	return events
+}
+
+// stateChanged reports whether the upstream state differs from the last observed one.
+func stateChanged(prev *models.TrackedPullRequest, cur *provider.PullRequest) bool {
+	return prev.State == nil || *prev.State != cur.State
+}
+
+// requestStatusAfter returns the status the request moves to when its pull request is merged or
+// closed, or "" to leave it. An approved request is complete once merged; a request whose pull
+// request closed unmerged no longer needs a decision. A merge without approval leaves the request
+// for review alongside its policy violation flag.
+func requestStatusAfter(prev *models.TrackedPullRequest, cur *provider.PullRequest) string {
+	if !stateChanged(prev, cur) {
+		return ""
+	}
+
+	switch {
+	case cur.State == provider.StateMerged && prev.RequestStatus == "approved":
+		return repository.RequestStatusMerged
+	case cur.State == provider.StateClosed && slices.Contains([]string{"pending", "in_review", "approved"}, prev.RequestStatus):
+		return repository.RequestStatusClosed
+	}
+
+	return ""
+}
+
+func pullRequestChanged(prev *models.TrackedPullRequest, cur *provider.PullRequest) bool {
+	if prev.HeadSHA == nil || *prev.HeadSHA != cur.HeadSHA {
+		return true
+	}
+
+	return prev.UpstreamUpdatedAt == nil || cur.UpdatedAt.After(*prev.UpstreamUpdatedAt)
+}
+
+// mergedWithoutApproval reports whether the request was not approved before the pull request merged.
+func mergedWithoutApproval(pr *models.TrackedPullRequest, upstream *provider.PullRequest) bool {
+	if pr.ApprovedAt == nil {
+		return true
+	}
+
+	return upstream.MergedAt != nil && pr.ApprovedAt.After(*upstream.MergedAt)
+}
@@ -0,0 +1,141 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sourcestream/backend/config"
+	"sourcestream/backend/models"
+	"sourcestream/backend/provider"
+	"sourcestream/backend/repository"
+)
+
+type fakePullRequestStore struct {
+	tracked      []*models.TrackedPullRequest
+	observations map[string]*repository.PullRequestObservation
+}
+
+func (f *fakePullRequestStore) GetTrackablePullRequests(_ context.Context, limit int) ([]*models.TrackedPullRequest, error) {
+	var trackable []*models.TrackedPullRequest
+
+	for _, pr := range f.tracked {
+		if pr.State == nil || *pr.State == provider.StateOpen {
+			trackable = append(trackable, pr)
+		}
+	}
+
+	if len(trackable) > limit {
+		trackable = trackable[:limit]
+	}
+
+	return trackable, nil
+}
+
+func (f *fakePullRequestStore) RecordPullRequestObservation(_ context.Context, obs *repository.PullRequestObservation) error {
+	f.observations[obs.RequestID] = obs
+
+	for _, pr := range f.tracked {
+		if pr.RequestID == obs.RequestID {
+			state, sha := obs.State, obs.HeadSHA
+			pr.HeadSHA, pr.UpstreamUpdatedAt = &sha, obs.UpstreamUpdatedAt
+
+			if state != "" {
+				pr.State = &state
+			}
+		}
+	}
+
+	return nil
+}
+
+func eventTypes(obs *repository.PullRequestObservation) []string {
+	var types []string
+	for _, e := range obs.Events {
+		types = append(types, e.EventType)
+	}
+
+	return types
+}
+
+func TestPullRequestStatusWorker_RunOnce(t *testing.T) {
+	created := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	merged := created.Add(48 * time.Hour)
+	approved := created.Add(24 * time.Hour)
+
+	fake := provider.NewFake()
+	fake.AddPullRequest(&provider.PullRequest{Owner: "vuejs", Repo: "vue", Number: 1, State: provider.StateOpen, HeadSHA: "a1", CreatedAt: created, UpdatedAt: created})
+	fake.AddPullRequest(&provider.PullRequest{Owner: "nodejs", Repo: "node", Number: 2, State: provider.StateMerged, HeadSHA: "b1", CreatedAt: created, UpdatedAt: merged, MergedAt: &merged})
+	fake.AddPullRequest(&provider.PullRequest{Owner: "webpack", Repo: "webpack", Number: 3, State: provider.StateMerged, HeadSHA: "c1", CreatedAt: created, UpdatedAt: merged, MergedAt: &merged})
+
+	store := &fakePullRequestStore{
+		tracked: []*models.TrackedPullRequest{
+			{RequestID: "open", PullRequestURL: "https://github.com/vuejs/vue/pull/1", RequestStatus: "pending"},
+			{RequestID: "approved", PullRequestURL: "https://github.com/nodejs/node/pull/2", RequestStatus: "approved", ApprovedAt: &approved},
+			{RequestID: "unapproved", PullRequestURL: "https://github.com/webpack/webpack/pull/3", RequestStatus: "pending"},
+			{RequestID: "invalid", PullRequestURL: "https://github.com/webpack/webpack", RequestStatus: "pending"},
+			{RequestID: "missing", PullRequestURL: "https://github.com/webpack/webpack/pull/99", RequestStatus: "pending"},
+		},
+		observations: map[string]*repository.PullRequestObservation{},
+	}
+
+	w := newPullRequestStatusWorker(store, fake, &config.PullRequestPollConfig{Interval: time.Minute, BatchSize: 10})
+
+	result, err := w.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.Checked)
+	assert.Equal(t, 1, result.PolicyViolations)
+	assert.Equal(t, 2, result.Errors)
+
+	assert.Equal(t, []string{"opened"}, eventTypes(store.observations["open"]))
+	assert.Equal(t, []string{"opened", "merged"}, eventTypes(store.observations["approved"]))
+	assert.Empty(t, store.observations["approved"].PolicyViolation)
+	assert.Equal(t, repository.RequestStatusMerged, store.observations["approved"].RequestStatus)
+	assert.Empty(t, store.observations["unapproved"].RequestStatus)
+	assert.NotEmpty(t, store.observations["unapproved"].PolicyViolation)
+	assert.Equal(t, repository.PullRequestStateInvalid, store.observations["invalid"].State)
+	assert.Empty(t, store.observations["missing"].Events)
+	assert.Empty(t, store.observations["missing"].State)
+
+	// A new commit on the open pull request is recorded as an update
+	pushed := created.Add(time.Hour)
+	fake.AddPullRequest(&provider.PullRequest{Owner: "vuejs", Repo: "vue", Number: 1, State: provider.StateOpen, HeadSHA: "a2", CreatedAt: created, UpdatedAt: pushed})
+
+	result, err = w.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Checked) // open and missing remain trackable
+	assert.Equal(t, []string{"updated"}, eventTypes(store.observations["open"]))
+
+	// A pull request first found after a failed check is still recorded as opened
+	fake.AddPullRequest(&provider.PullRequest{Owner: "webpack", Repo: "webpack", Number: 99, State: provider.StateOpen, HeadSHA: "d1", CreatedAt: created, UpdatedAt: created})
+
+	_, err = w.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"opened"}, eventTypes(store.observations["missing"]))
+}
+
+func TestPullRequestStatusWorker_SkipsOtherHosts(t *testing.T) {
+	created := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	fake := provider.NewFake()
+	fake.SetHost("github.com")
+	fake.AddPullRequest(&provider.PullRequest{Owner: "org", Repo: "tool", Number: 9, State: provider.StateOpen, HeadSHA: "a1", CreatedAt: created, UpdatedAt: created})
+
+	store := &fakePullRequestStore{
+		tracked: []*models.TrackedPullRequest{
+			{RequestID: "gitea", PullRequestURL: "https://gitea.example.com/org/tool/pulls/9", RequestStatus: "pending"},
+		},
+		observations: map[string]*repository.PullRequestObservation{},
+	}
+
+	w := newPullRequestStatusWorker(store, fake, &config.PullRequestPollConfig{Interval: time.Minute, BatchSize: 10})
+
+	result, err := w.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Errors)
+	assert.Empty(t, store.observations["gitea"].State)
+	assert.Empty(t, store.observations["gitea"].Events)
+	assert.Equal(t, "pull request host gitea.example.com is not served by the fake provider", store.observations["gitea"].LastError)
+}
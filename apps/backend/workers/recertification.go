@@ -0,0 +1,138 @@
+// Package workers contains background jobs that run alongside the gRPC and REST servers.
+package workers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"sourcestream/backend/config"
+	"sourcestream/backend/models"
+	"sourcestream/backend/repository"
+)
+
+// RecertificationStore is the persistence used by RecertificationWorker.
+type RecertificationStore interface {
+	GetApprovedProjectsDueForReview(ctx context.Context, before time.Time) ([]*models.ApprovedProject, error)
+	GetOverdueApprovedProjects(ctx context.Context, asOf time.Time) ([]*models.ApprovedProject, error)
+	CreateRecertificationTask(ctx context.Context, approvedProjectID string, dueDate time.Time) error
+	LapseApprovedProject(ctx context.Context, approvedProjectID string) (int64, error)
+}
+
+// RecertificationResult summarizes a single pass of the re-certification job.
+type RecertificationResult struct {
+	TasksOpened     int
+	ProjectsLapsed  int
+	RequestsFlagged int64
+}
+
+// RecertificationWorker opens re-certification tasks for approved projects approaching their
+// next review date and deactivates entries whose task is overdue.
+type RecertificationWorker struct {
+	store    RecertificationStore
+	interval time.Duration
+	leadTime time.Duration
+	now      func() time.Time
+	state    stateTracker
+}
+
+// NewRecertificationWorker creates a RecertificationWorker with the given database and configuration.
+func NewRecertificationWorker(db *sql.DB, cfg *config.RecertificationConfig) *RecertificationWorker {
+	return newRecertificationWorker(repository.NewApprovedProjectRepository(db), cfg)
+}
+
+func newRecertificationWorker(store RecertificationStore, cfg *config.RecertificationConfig) *RecertificationWorker {
+	return &RecertificationWorker{
+		store:    store,
+		interval: cfg.Interval,
+		leadTime: cfg.LeadTime,
+		now:      time.Now,
+	}
+}
+
+// Run executes the job immediately and then on every interval until ctx is cancelled.
+func (w *RecertificationWorker) Run(ctx context.Context) {
+	w.state.setRunning(true)
+	defer w.state.setRunning(false)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := w.RunOnce(ctx)
+		w.state.record(err)
+
+		if err != nil {
+			log.Printf("recertification job failed: %v", err)
+		} else if result.TasksOpened > 0 || result.ProjectsLapsed > 0 {
+			log.Printf("recertification job: opened %d tasks, lapsed %d projects, flagged %d requests",
+				result.TasksOpened, result.ProjectsLapsed, result.RequestsFlagged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// State reports whether the worker is running and how its last pass went.
+func (w *RecertificationWorker) State() State {
+	return w.state.get()
+}
+
+// RunOnce performs a single pass: projects whose task is overdue are lapsed first, then tasks are
+// opened for active projects whose review falls within the lead time. A task is due on the
+// project's next review date, except that an entry already past it when first seen, such as one
+// backfilled by migration 005, is given the lead time from now before it lapses.
+func (w *RecertificationWorker) RunOnce(ctx context.Context) (RecertificationResult, error) {
+	var result RecertificationResult
+
+	now := w.now()
+
+	overdue, err := w.store.GetOverdueApprovedProjects(ctx, now)
+	if err != nil {
+		return result, fmt.Errorf("failed to list overdue approved projects: %w", err)
+	}
+
+	for _, project := range overdue {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		flagged, err := w.store.LapseApprovedProject(ctx, project.ID)
+		if err != nil {
+			return result, fmt.Errorf("failed to lapse approved project %s: %w", project.ID, err)
+		}
+
+		result.ProjectsLapsed++
+		result.RequestsFlagged += flagged
+	}
+
+	due, err := w.store.GetApprovedProjectsDueForReview(ctx, now.Add(w.leadTime))
+	if err != nil {
+		return result, fmt.Errorf("failed to list approved projects due for review: %w", err)
+	}
+
+	for _, project := range due {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		dueDate := project.NextReviewDate
+		if dueDate.Before(now) {
+			dueDate = now.Add(w.leadTime)
+		}
+
+		if err := w.store.CreateRecertificationTask(ctx, project.ID, dueDate); err != nil {
+			return result, fmt.Errorf("failed to open recertification task for %s: %w", project.ID, err)
+		}
+
+		result.TasksOpened++
+	}
+
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"sourcestream/backend/config"
+	"sourcestream/backend/models"
+)
+
+type fakeRecertificationStore struct {
+	projects []*models.ApprovedProject
+	openTask map[string]time.Time
+	flags    map[string]int64
+}
+
+func (f *fakeRecertificationStore) GetApprovedProjectsDueForReview(_ context.Context, before time.Time) ([]*models.ApprovedProject, error) {
+	var due []*models.ApprovedProject
+
+	for _, p := range f.projects {
+		if _, open := f.openTask[p.ID]; p.IsActive && !open && !p.NextReviewDate.After(before) {
+			due = append(due, p)
+		}
+	}
+
+	return due, nil
+}
+
+func (f *fakeRecertificationStore) GetOverdueApprovedProjects(_ context.Context, asOf time.Time) ([]*models.ApprovedProject, error) {
+	var overdue []*models.ApprovedProject
+
+	for _, p := range f.projects {
+		if due, open := f.openTask[p.ID]; p.IsActive && open && due.Before(asOf) {
+			overdue = append(overdue, p)
+		}
+	}
+
+	return overdue, nil
+}
+
+func (f *fakeRecertificationStore) CreateRecertificationTask(_ context.Context, approvedProjectID string, dueDate time.Time) error {
+	f.openTask[approvedProjectID] = dueDate
+	return nil
+}
+
+func (f *fakeRecertificationStore) LapseApprovedProject(_ context.Context, approvedProjectID string) (int64, error) {
+	for _, p := range f.projects {
+		if p.ID == approvedProjectID {
+			p.IsActive = false
+		}
+	}
+
+	delete(f.openTask, approvedProjectID)
+
+	return f.flags[approvedProjectID], nil
+}
+
+func TestRecertificationWorker_RunOnce(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	store := &fakeRecertificationStore{
+		projects: []*models.ApprovedProject{
+			{ID: "overdue", IsActive: true, NextReviewDate: now.AddDate(0, 0, -40)},
+			{ID: "due-soon", IsActive: true, NextReviewDate: now.AddDate(0, 0, 10)},
+			{ID: "not-due", IsActive: true, NextReviewDate: now.AddDate(0, 6, 0)},
+			{ID: "inactive", IsActive: false, NextReviewDate: now.AddDate(0, 0, -30)},
+			{ID: "backfilled", IsActive: true, NextReviewDate: now.AddDate(-2, 0, 0)},
+		},
+		openTask: map[string]time.Time{"overdue": now.AddDate(0, 0, -1)},
+		flags:    map[string]int64{"overdue": 3},
+	}
+
+	leadTime := 30 * 24 * time.Hour
+	w := newRecertificationWorker(store, &config.RecertificationConfig{Interval: time.Hour, LeadTime: leadTime})
+	w.now = func() time.Time { return now }
+
+	result, err := w.RunOnce(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.ProjectsLapsed)
+	assert.Equal(t, int64(3), result.RequestsFlagged)
+	assert.Equal(t, 2, result.TasksOpened)
+	assert.Equal(t, now.AddDate(0, 0, 10), store.openTask["due-soon"])
+	assert.NotContains(t, store.openTask, "overdue")
+	assert.False(t, store.projects[0].IsActive)
+
+	// A project never given a task is not lapsed, however old its review date; its task allows
+	// the lead time
+	assert.True(t, store.projects[4].IsActive)
+	assert.Equal(t, now.Add(leadTime), store.openTask["backfilled"])
+
+	// A second pass opens nothing new
+	result, err = w.RunOnce(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, RecertificationResult{}, result)
+}
@@ -0,0 +1,44 @@
+package workers
+
+import (
+	"sync"
+	"time"
+)
+
+// State is a snapshot of a worker's progress, reported by readiness checks.
+type State struct {
+	// Running is true from when Run starts until it returns.
+	Running bool
+	// LastRun is when the last pass finished, zero before the first.
+	LastRun time.Time
+	// LastError is the error of the last pass, if it failed.
+	LastError error
+}
+
+// stateTracker records a worker's State. It is safe for concurrent use.
+type stateTracker struct {
+	mu    sync.Mutex
+	state State
+}
+
+func (t *stateTracker) setRunning(running bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.state.Running = running
+}
+
+func (t *stateTracker) record(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.state.LastRun = time.Now()
+	t.state.LastError = err
+}
+
+func (t *stateTracker) get() State {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.state
+}
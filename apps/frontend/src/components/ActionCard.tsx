@@ -135,7 +135,7 @@ const ActionCard = ({ type, onSubmit }: ActionCardProps) => {
                 <option value="Apache-2.0">Apache 2.0</option>
                 <option value="GPL-3.0">GPL 3.0</option>
                 <option value="BSD-3-Clause">BSD 3-Clause</option>
-                <option value="other">Other</option>
+                <option value="LicenseRef-Other">Other</option>
               </select>
             </Box>
           </>
@@ -206,7 +206,6 @@ const ActionCard = ({ type, onSubmit }: ActionCardProps) => {
                 <option value="">Select your intended role</option>
                 <option value="contributor">Contributor</option>
                 <option value="maintainer">Maintainer</option>
-                <option value="reviewer">Reviewer</option>
               </select>
             </Box>
           </>
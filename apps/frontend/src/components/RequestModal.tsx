@@ -180,7 +180,7 @@ const RequestModal: React.FC<RequestModalProps> = ({
                 <option value="Apache-2.0">Apache License 2.0</option>
                 <option value="GPL-3.0">GNU General Public License v3.0</option>
                 <option value="BSD-3-Clause">BSD 3-Clause License</option>
-                <option value="Other">Other</option>
+                <option value="LicenseRef-Other">Other</option>
               </select>
             </Box>
           </VStack>
@@ -203,10 +203,8 @@ const RequestModal: React.FC<RequestModalProps> = ({
               onChange={(e) => handleSelectChange("role", e)}
             >
               <option value="">Select role</option>
-              <option value="viewer">Viewer</option>
               <option value="contributor">Contributor</option>
               <option value="maintainer">Maintainer</option>
-              <option value="admin">Admin</option>
             </select>
           </Box>
         );